@@ -7,11 +7,13 @@ import (
 
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/module"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage/cache"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage/postgres"
 	"go.uber.org/zap"
 )
 
-// Удаление записей, у которых прошло два дня с момента выдачи клиенту
+// Удаление записей о заказах, которые были возвращены курьеру дольше срока хранения назад,
+// и о заказах, возвращенных клиентом, у которых истек срок возврата
 func main() {
 	logger, err := zap.NewDevelopment()
 	if err != nil {
@@ -26,9 +28,15 @@ func main() {
 		log.Fatal(err)
 	}
 
-	orderService := module.New(storage, storage, storage, storage, logger)
+	orderCache := cache.NewOrderCache(cfg.CacheConfig.Capacity, cfg.CacheConfig.Type, cfg.CacheConfig.TTL)
 
-	count, err := orderService.DeleteIssuedOrders(context.Background())
+	policies := module.Policies{
+		ReturnWindow: cfg.ReturnPolicy.ReturnWindowPolicies(),
+	}
+
+	orderService := module.New(storage, storage, storage, storage, orderCache, policies, []byte(cfg.IntegritySecret), logger)
+
+	count, err := orderService.DeleteReturnedOrders(context.Background())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -7,6 +7,7 @@ import (
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/app"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/cli"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/middleware"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -15,7 +16,11 @@ import (
 func main() {
 	cfg := config.MustLoad()
 
-	conn, err := grpc.NewClient(fmt.Sprintf("localhost:%d", cfg.GRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("localhost:%d", cfg.GRPCPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(middleware.PickupPointClient(cfg.PickupPointID)),
+	)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/grpc"
 	infra "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/infrastructure/kafka"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/kafka"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/module"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/notifier"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage/cache"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage/postgres"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/tracer"
@@ -38,7 +42,32 @@ func main() {
 
 	orderCache := cache.NewOrderCache(cfg.CacheConfig.Capacity, cfg.CacheConfig.Type, cfg.CacheConfig.TTL)
 
-	orderService := module.New(storage, storage, storage, storage, orderCache, logger)
+	policies := module.Policies{
+		StorageFee: storageFeePolicies(cfg.StorageFee),
+		StorageExtension: domain.StorageExtensionPolicy{
+			MaxTotal: cfg.StorageExtension.MaxTotal,
+			MaxCount: cfg.StorageExtension.MaxCount,
+		},
+		PickupCode: domain.PickupCodePolicy{
+			MaxAttempts:          cfg.PickupCode.MaxAttempts,
+			RegenerationInterval: cfg.PickupCode.RegenerationInterval,
+		},
+		ReturnReasons: domain.ReturnReasonPolicy{
+			Reasons: cfg.ReturnReasons,
+		},
+		ReturnWindow: cfg.ReturnPolicy.ReturnWindowPolicies(),
+		Capacity:     capacityPolicies(cfg.Capacity),
+	}
+
+	if cfg.IntegritySecret == "" {
+		logger.Fatal("ORDER_INTEGRITY_SECRET is not set")
+	}
+
+	orderService := module.New(storage, storage, storage, storage, orderCache, policies, []byte(cfg.IntegritySecret), logger)
+
+	if err := orderService.LoadPackageTypes(ctx); err != nil {
+		logger.Fatal("Package types loading error", zap.Error(err))
+	}
 
 	kafkaProducer, err := infra.NewProducer(cfg.Kafka.Brokers)
 	if err != nil {
@@ -46,6 +75,23 @@ func main() {
 	}
 	defer kafkaProducer.Close()
 
+	if cfg.Notifier.Backend != config.NotifierBackendNone {
+		orderNotifier, err := notifier.New(cfg.Notifier, kafkaProducer)
+		if err != nil {
+			logger.Fatal("Notifier initializing error", zap.Error(err))
+		}
+
+		templates, err := notifier.NewTemplates(cfg.Notifier.Templates)
+		if err != nil {
+			logger.Fatal("Notification templates parsing error", zap.Error(err))
+		}
+
+		dispatcher := notifier.NewDispatcher(storage, orderNotifier, templates, cfg.Notifier, logger)
+		go dispatcher.Run(ctx)
+
+		go runExpiryNotices(ctx, storage, orderService, cfg.Notifier.ExpiryCheckInterval, logger)
+	}
+
 	var sender *kafka.Sender
 	var receiver *kafka.Receiver
 
@@ -60,7 +106,11 @@ func main() {
 	})
 	receiver.Subscribe(cfg.Kafka.Topic)
 
-	server := grpc.NewGRPCServer(orderService, sender)
+	go runExpiryScan(ctx, storage, orderService, sender, cfg.ExpiryScan, logger)
+
+	go runIdempotencyCleanup(ctx, storage, cfg.Idempotency.CleanupInterval, logger)
+
+	server := grpc.NewGRPCServer(orderService, sender, storage, storage, cfg.Idempotency)
 
 	wg := sync.WaitGroup{}
 	wg.Add(2)
@@ -77,3 +127,187 @@ func main() {
 
 	wg.Wait()
 }
+
+// defaultExpiryCheckInterval как часто ищутся заказы, срок хранения которых скоро закончится
+const defaultExpiryCheckInterval = time.Hour
+
+// runExpiryNotices периодически ставит в outbox предупреждения об окончании срока хранения заказов
+// всех зарегистрированных ПВЗ
+func runExpiryNotices(
+	ctx context.Context,
+	storage *postgres.Storage,
+	orderService *module.Module,
+	interval time.Duration,
+	logger *zap.Logger,
+) {
+	if interval <= 0 {
+		interval = defaultExpiryCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pickupPoints, err := storage.FindPickupPoints(ctx)
+			if err != nil {
+				logger.Error("Expiry notices pickup points loading error", zap.Error(err))
+				continue
+			}
+
+			for _, pickupPoint := range pickupPoints {
+				count, err := orderService.NotifyExpiringOrders(domain.WithPickupPointID(ctx, pickupPoint.ID), time.Now())
+				if err != nil {
+					logger.Error("Expiry notices error", zap.Error(err), zap.Int64("pvz_id", pickupPoint.ID))
+					continue
+				}
+
+				logger.Info("Expiry notices queued", zap.Int64("pvz_id", pickupPoint.ID), zap.Int("orders", count))
+			}
+		}
+	}
+}
+
+// defaultExpiryScanInterval как часто просроченные заказы отбираются для возврата курьеру
+const defaultExpiryScanInterval = 10 * time.Minute
+
+// runExpiryScan периодически переводит просроченные заказы всех зарегистрированных ПВЗ в ожидание возврата курьеру
+// и публикует по каждому ПВЗ событие со списком отобранных заказов
+func runExpiryScan(
+	ctx context.Context,
+	storage *postgres.Storage,
+	orderService *module.Module,
+	sender *kafka.Sender,
+	cfg config.ExpiryScanConfig,
+	logger *zap.Logger,
+) {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultExpiryScanInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pickupPoints, err := storage.FindPickupPoints(ctx)
+			if err != nil {
+				logger.Error("Expiry scan pickup points loading error", zap.Error(err))
+				continue
+			}
+
+			for _, pickupPoint := range pickupPoints {
+				scanExpiredOrders(ctx, pickupPoint.ID, orderService, sender, cfg.BatchSize, logger)
+			}
+		}
+	}
+}
+
+// scanExpiredOrders отбирает просроченные заказы одного ПВЗ для возврата курьеру
+func scanExpiredOrders(
+	ctx context.Context,
+	pickupPointID int64,
+	orderService *module.Module,
+	sender *kafka.Sender,
+	batchSize int32,
+	logger *zap.Logger,
+) {
+	flagged, err := orderService.FlagExpiredOrders(domain.WithPickupPointID(ctx, pickupPointID), time.Now(), batchSize)
+	if err != nil {
+		logger.Error("Expiry scan error", zap.Error(err), zap.Int64("pvz_id", pickupPointID), zap.Int("flagged", len(flagged)))
+	}
+
+	if len(flagged) == 0 {
+		return
+	}
+
+	logger.Info("Expired orders flagged for courier return", zap.Int64("pvz_id", pickupPointID), zap.Int("orders", len(flagged)))
+
+	err = sender.SendMessage(&kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/jobs/orders/flag-expired",
+		Arguments: map[string]any{"pvz_id": pickupPointID, "order_ids": flagged},
+	})
+	if err != nil {
+		logger.Error("Expiry scan event sending error", zap.Error(err), zap.Int64("pvz_id", pickupPointID))
+	}
+}
+
+// defaultIdempotencyCleanupInterval как часто удаляются истекшие ключи идемпотентности
+const defaultIdempotencyCleanupInterval = time.Hour
+
+// runIdempotencyCleanup периодически удаляет истекшие ключи идемпотентности всех ПВЗ
+func runIdempotencyCleanup(ctx context.Context, storage *postgres.Storage, interval time.Duration, logger *zap.Logger) {
+	if interval <= 0 {
+		interval = defaultIdempotencyCleanupInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			count, err := storage.DeleteExpiredIdempotencyKeys(ctx, time.Now().UTC())
+			if err != nil {
+				logger.Error("Idempotency keys cleanup error", zap.Error(err))
+				continue
+			}
+
+			logger.Info("Expired idempotency keys deleted", zap.Int64("keys", count))
+		}
+	}
+}
+
+// storageFeePolicies переводит настройки платы за хранение в правила домена
+func storageFeePolicies(cfg config.StorageFeeConfig) domain.StorageFeePolicies {
+	policies := domain.StorageFeePolicies{
+		Default:      storageFeePolicy(cfg.StorageFeeRule),
+		PackageTypes: make(map[string]domain.StorageFeePolicy, len(cfg.PackageTypes)),
+	}
+
+	for packageType, rule := range cfg.PackageTypes {
+		policies.PackageTypes[packageType] = storageFeePolicy(rule)
+	}
+
+	return policies
+}
+
+func storageFeePolicy(rule config.StorageFeeRule) domain.StorageFeePolicy {
+	return domain.StorageFeePolicy{
+		FreeDays:  rule.FreeDays,
+		DailyRate: rule.DailyRate,
+		Cap:       rule.Cap,
+	}
+}
+
+// capacityPolicies переводит настройки заполненности ПВЗ в правила домена
+func capacityPolicies(cfg config.CapacityConfig) domain.CapacityPolicies {
+	policies := domain.CapacityPolicies{
+		Default:      capacityPolicy(cfg.CapacityLimit),
+		PickupPoints: make(map[int64]domain.CapacityPolicy, len(cfg.PickupPoints)),
+	}
+
+	for pickupPointID, limit := range cfg.PickupPoints {
+		policies.PickupPoints[pickupPointID] = capacityPolicy(limit)
+	}
+
+	return policies
+}
+
+func capacityPolicy(limit config.CapacityLimit) domain.CapacityPolicy {
+	return domain.CapacityPolicy{
+		MaxOrders: limit.MaxOrders,
+		MaxWeight: limit.MaxWeight,
+	}
+}
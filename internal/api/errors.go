@@ -2,33 +2,161 @@ package api
 
 import (
 	"errors"
+	"fmt"
 
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/module"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-var errorMap = map[error]struct {
+// errorMapping код и сообщение ответа для ошибки модуля
+type errorMapping struct {
+	err     error
 	code    codes.Code
 	message string
-}{
-	module.ErrOrderNotFound:           {codes.NotFound, "order not found"},
-	module.ErrOrderExists:             {codes.AlreadyExists, "order already exists"},
-	module.ErrOrderStorageTimeExpired: {codes.InvalidArgument, "storage time expired"},
-	module.ErrRecipientNotFound:       {codes.NotFound, "recipient not found"},
-	module.ErrOrdersDifferentClients:  {codes.InvalidArgument, "order does not exists"},
-	module.ErrOrderNotIssuedOrExpired: {codes.InvalidArgument, "order not issued or expired"},
-	module.ErrOrderNotExpiredOrIssued: {codes.InvalidArgument, "order issued or not expired"},
-	domain.ErrPackageTypeUnsupported:  {codes.InvalidArgument, "invalid package type"},
-	domain.ErrWeightNegative:          {codes.InvalidArgument, "invalid weight"},
+}
+
+// errorMap соответствие ошибок модуля кодам ответа. Ошибка может оборачивать несколько ошибок из списка,
+// поэтому порядок важен: выбирается первое совпадение, и более конкретные ошибки идут раньше общих.
+var errorMap = []errorMapping{
+	{module.ErrOrderNotFound, codes.NotFound, "order not found"},
+	{module.ErrOrderExists, codes.AlreadyExists, "order already exists"},
+	{module.ErrOrderDuplicated, codes.InvalidArgument, "order is duplicated in batch"},
+	{module.ErrOrderConflict, codes.Aborted, "order was changed concurrently, retry the request"},
+	{module.ErrOrderStorageTimeExpired, codes.InvalidArgument, "storage time expired"},
+	{module.ErrRecipientNotFound, codes.NotFound, "recipient not found"},
+	{module.ErrOrdersDifferentClients, codes.InvalidArgument, "order does not exists"},
+	{module.ErrOrderNotIssuedOrExpired, codes.InvalidArgument, "order not issued or expired"},
+	{module.ErrOrderNotExpiredOrIssued, codes.InvalidArgument, "order issued or not expired"},
+	{module.ErrInvalidDateRange, codes.InvalidArgument, "invalid date range"},
+	{module.ErrOrderCourierMismatch, codes.InvalidArgument, "order belongs to another courier"},
+	{module.ErrPackageTypeExists, codes.AlreadyExists, "package type already exists"},
+	{module.ErrPackageTypeNotFound, codes.NotFound, "package type not found"},
+	{module.ErrPackageLayersConflict, codes.InvalidArgument, "invalid package layers"},
+	{domain.ErrPackageTypeUnsupported, codes.InvalidArgument, "invalid package type"},
+	{domain.ErrPackageTypeInactive, codes.InvalidArgument, "package type is deactivated"},
+	{domain.ErrPackageTypeInvalid, codes.InvalidArgument, "invalid package type parameters"},
+	{domain.ErrWeightNegative, codes.InvalidArgument, "invalid weight"},
+	{domain.ErrWeightLimit, codes.InvalidArgument, "weight exceeds package limit"},
+	{domain.ErrDimensionsNegative, codes.InvalidArgument, "invalid dimensions"},
+	{domain.ErrDimensionsExceedLimit, codes.InvalidArgument, "dimensions exceed package limit"},
+	{domain.ErrVolumetricWeightLimit, codes.InvalidArgument, "volumetric weight exceeds package limit"},
+	{domain.ErrCurrencyInvalid, codes.InvalidArgument, "invalid currency"},
+	{domain.ErrCurrencyMismatch, codes.InvalidArgument, "order and package costs are in different currencies"},
+	{domain.ErrMoneyNegative, codes.InvalidArgument, "invalid cost"},
+	{domain.ErrOrderStatusTransition, codes.InvalidArgument, "order status transition is not allowed"},
+	{domain.ErrPickupPointRequired, codes.InvalidArgument, "pickup point is not specified"},
+	{domain.ErrExtensionDaysInvalid, codes.InvalidArgument, "invalid storage extension"},
+	{domain.ErrExtensionNotAllowed, codes.FailedPrecondition, "order storage can not be extended"},
+	{domain.ErrExtensionCountLimit, codes.FailedPrecondition, "storage extension count limit reached"},
+	{domain.ErrExtensionTotalLimit, codes.FailedPrecondition, "total storage extension limit exceeded"},
+	{domain.ErrPickupCodeRequired, codes.InvalidArgument, "pickup code is required"},
+	{domain.ErrPickupCodeInvalid, codes.PermissionDenied, "invalid pickup code"},
+	{domain.ErrPickupCodeLocked, codes.FailedPrecondition, "pickup code is locked, regenerate it"},
+	{domain.ErrPickupCodeNotGenerated, codes.FailedPrecondition, "pickup code was not generated, regenerate it"},
+	{domain.ErrPickupCodeNotAllowed, codes.FailedPrecondition, "pickup code can not be regenerated"},
+	{domain.ErrPickupCodeUndeliverable, codes.FailedPrecondition, "recipient has no contact to send pickup code to"},
+	{domain.ErrPickupCodeRegeneratedRecently, codes.ResourceExhausted, "pickup code was regenerated recently, try again later"},
+	{domain.ErrReturnReasonUnknown, codes.InvalidArgument, "unknown return reason"},
+	{domain.ErrReturnConditionUnknown, codes.InvalidArgument, "invalid return condition"},
+	{domain.ErrReturnNoteTooLong, codes.InvalidArgument, "return note is too long"},
+	{domain.ErrRefundExceedsCost, codes.InvalidArgument, "refund exceeds order cost"},
+	{module.ErrRecipientNotRegistered, codes.NotFound, "recipient is not registered"},
+	{module.ErrRecipientExists, codes.AlreadyExists, "recipient already exists"},
+	{module.ErrRecipientPhoneExists, codes.AlreadyExists, "recipient with this phone already exists"},
+	{module.ErrRecipientHasOrders, codes.FailedPrecondition, "recipient has orders"},
+	{domain.ErrRecipientNameRequired, codes.InvalidArgument, "recipient name is required"},
+	{domain.ErrRecipientNameTooLong, codes.InvalidArgument, "recipient name is too long"},
+	{domain.ErrRecipientContactRequired, codes.InvalidArgument, "recipient phone or email is required"},
+	{domain.ErrRecipientPhoneInvalid, codes.InvalidArgument, "invalid phone"},
+	{domain.ErrRecipientEmailInvalid, codes.InvalidArgument, "invalid email"},
+	{domain.ErrRecipientChannelUnknown, codes.InvalidArgument, "invalid preferred channel"},
+	{domain.ErrRecipientChannelUnavailable, codes.InvalidArgument, "recipient has no contact for preferred channel"},
+	{module.ErrCellNotFound, codes.NotFound, "cell not found"},
+	{module.ErrCellExists, codes.AlreadyExists, "cell with this location already exists"},
+	{domain.ErrCellLocationRequired, codes.InvalidArgument, "cell zone, rack and shelf are required"},
+	{domain.ErrCellCapacityInvalid, codes.InvalidArgument, "invalid cell capacity"},
+	{domain.ErrCellPackageMismatch, codes.FailedPrecondition, "cell does not accept order package type"},
+	{domain.ErrCellFull, codes.FailedPrecondition, "cell is full"},
+	{domain.ErrCellNotFree, codes.ResourceExhausted, "no free cell for order"},
+	{domain.ErrCellOrderNotStored, codes.FailedPrecondition, "order is not stored in pickup point"},
+	{domain.ErrPickupPointFull, codes.ResourceExhausted, "pickup point is full"},
 }
 
 func handleOrderError(err error) error {
-	for key, value := range errorMap {
-		if errors.Is(err, key) {
-			return status.Errorf(value.code, "%s: %v", value.message, err)
+	for _, value := range errorMap {
+		if errors.Is(err, value.err) {
+			st := status.Newf(value.code, "%s: %v", value.message, err)
+
+			if violation := limitViolation(err); violation != nil {
+				detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequest_FieldViolation{violation},
+				})
+				if detailsErr == nil {
+					st = detailed
+				}
+			}
+
+			if quota := capacityViolation(err); quota != nil {
+				detailed, detailsErr := st.WithDetails(quota)
+				if detailsErr == nil {
+					st = detailed
+				}
+			}
+
+			return st.Err()
 		}
 	}
 	return status.Errorf(codes.Internal, "internal server error: %v", err)
 }
+
+// limitViolation описывает, какое ограничение упаковки нарушил заказ
+func limitViolation(err error) *errdetails.BadRequest_FieldViolation {
+	var (
+		dimensionErr  domain.ErrDimensionExceedsLimit
+		volumetricErr domain.ErrVolumetricWeightExceedsLimit
+		weightErr     domain.ErrWeightExceedsLimit
+	)
+
+	switch {
+	case errors.As(err, &dimensionErr):
+		return &errdetails.BadRequest_FieldViolation{Field: dimensionErr.Dimension, Description: dimensionErr.Error()}
+	case errors.As(err, &volumetricErr):
+		return &errdetails.BadRequest_FieldViolation{Field: "volumetric_weight", Description: volumetricErr.Error()}
+	case errors.As(err, &weightErr):
+		return &errdetails.BadRequest_FieldViolation{Field: "weight", Description: weightErr.Error()}
+	}
+
+	return nil
+}
+
+// capacityViolation описывает, какое ограничение заполненности ПВЗ нарушила приемка заказа, и текущую заполненность
+func capacityViolation(err error) *errdetails.QuotaFailure {
+	var capacityErr domain.ErrCapacityExceeded
+	if !errors.As(err, &capacityErr) {
+		return nil
+	}
+
+	subject := fmt.Sprintf("pvz:%d", capacityErr.PickupPointID)
+
+	var violations []*errdetails.QuotaFailure_Violation
+
+	if capacityErr.OrdersExceeded() {
+		violations = append(violations, &errdetails.QuotaFailure_Violation{
+			Subject:     subject,
+			Description: fmt.Sprintf("orders: %d of %d stored", capacityErr.OrderCount, capacityErr.MaxOrders),
+		})
+	}
+
+	if capacityErr.WeightExceeded() {
+		violations = append(violations, &errdetails.QuotaFailure_Violation{
+			Subject: subject,
+			Description: fmt.Sprintf("weight: %.3f of %.3f kg stored, order weighs %.3f kg",
+				capacityErr.Weight, capacityErr.MaxWeight, capacityErr.OrderWeight),
+		})
+	}
+
+	return &errdetails.QuotaFailure{Violations: violations}
+}
@@ -7,6 +7,7 @@ package mock_service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	dto "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
@@ -37,11 +38,12 @@ func (m *MockModule) EXPECT() *MockModuleMockRecorder {
 }
 
 // AcceptOrderCourier mocks base method.
-func (m *MockModule) AcceptOrderCourier(ctx context.Context, order *dto.Order) error {
+func (m *MockModule) AcceptOrderCourier(ctx context.Context, order *dto.Order) (*dto.Order, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AcceptOrderCourier", ctx, order)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AcceptOrderCourier indicates an expected call of AcceptOrderCourier.
@@ -50,6 +52,21 @@ func (mr *MockModuleMockRecorder) AcceptOrderCourier(ctx, order interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrderCourier", reflect.TypeOf((*MockModule)(nil).AcceptOrderCourier), ctx, order)
 }
 
+// AcceptOrdersBatch mocks base method.
+func (m *MockModule) AcceptOrdersBatch(ctx context.Context, request *dto.AcceptBatchRequest) ([]*dto.AcceptResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptOrdersBatch", ctx, request)
+	ret0, _ := ret[0].([]*dto.AcceptResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptOrdersBatch indicates an expected call of AcceptOrdersBatch.
+func (mr *MockModuleMockRecorder) AcceptOrdersBatch(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptOrdersBatch", reflect.TypeOf((*MockModule)(nil).AcceptOrdersBatch), ctx, request)
+}
+
 // AcceptReturnClient mocks base method.
 func (m *MockModule) AcceptReturnClient(ctx context.Context, order *dto.Order) error {
 	m.ctrl.T.Helper()
@@ -64,18 +81,241 @@ func (mr *MockModuleMockRecorder) AcceptReturnClient(ctx, order interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptReturnClient", reflect.TypeOf((*MockModule)(nil).AcceptReturnClient), ctx, order)
 }
 
-// IssueOrderClient mocks base method.
-func (m *MockModule) IssueOrderClient(ctx context.Context, orderIDs []int64) error {
+// ConfirmReturnManifest mocks base method.
+func (m *MockModule) ConfirmReturnManifest(ctx context.Context, courierID int64, orderIDs []int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IssueOrderClient", ctx, orderIDs)
+	ret := m.ctrl.Call(m, "ConfirmReturnManifest", ctx, courierID, orderIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// ConfirmReturnManifest indicates an expected call of ConfirmReturnManifest.
+func (mr *MockModuleMockRecorder) ConfirmReturnManifest(ctx, courierID, orderIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmReturnManifest", reflect.TypeOf((*MockModule)(nil).ConfirmReturnManifest), ctx, courierID, orderIDs)
+}
+
+// CreateCell mocks base method.
+func (m *MockModule) CreateCell(ctx context.Context, cell *dto.Cell) (*dto.Cell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCell", ctx, cell)
+	ret0, _ := ret[0].(*dto.Cell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCell indicates an expected call of CreateCell.
+func (mr *MockModuleMockRecorder) CreateCell(ctx, cell interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCell", reflect.TypeOf((*MockModule)(nil).CreateCell), ctx, cell)
+}
+
+// CreatePackageType mocks base method.
+func (m *MockModule) CreatePackageType(ctx context.Context, packageType *dto.PackageType) (*dto.PackageType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePackageType", ctx, packageType)
+	ret0, _ := ret[0].(*dto.PackageType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePackageType indicates an expected call of CreatePackageType.
+func (mr *MockModuleMockRecorder) CreatePackageType(ctx, packageType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackageType", reflect.TypeOf((*MockModule)(nil).CreatePackageType), ctx, packageType)
+}
+
+// CreateRecipient mocks base method.
+func (m *MockModule) CreateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecipient", ctx, recipient)
+	ret0, _ := ret[0].(*dto.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecipient indicates an expected call of CreateRecipient.
+func (mr *MockModuleMockRecorder) CreateRecipient(ctx, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecipient", reflect.TypeOf((*MockModule)(nil).CreateRecipient), ctx, recipient)
+}
+
+// DeactivatePackageType mocks base method.
+func (m *MockModule) DeactivatePackageType(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivatePackageType", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivatePackageType indicates an expected call of DeactivatePackageType.
+func (mr *MockModuleMockRecorder) DeactivatePackageType(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivatePackageType", reflect.TypeOf((*MockModule)(nil).DeactivatePackageType), ctx, name)
+}
+
+// DeleteRecipient mocks base method.
+func (m *MockModule) DeleteRecipient(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecipient", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecipient indicates an expected call of DeleteRecipient.
+func (mr *MockModuleMockRecorder) DeleteRecipient(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecipient", reflect.TypeOf((*MockModule)(nil).DeleteRecipient), ctx, id)
+}
+
+// ExtendStorage mocks base method.
+func (m *MockModule) ExtendStorage(ctx context.Context, orderID int64, days int) (*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendStorage", ctx, orderID, days)
+	ret0, _ := ret[0].(*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendStorage indicates an expected call of ExtendStorage.
+func (mr *MockModuleMockRecorder) ExtendStorage(ctx, orderID, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendStorage", reflect.TypeOf((*MockModule)(nil).ExtendStorage), ctx, orderID, days)
+}
+
+// GetCapacity mocks base method.
+func (m *MockModule) GetCapacity(ctx context.Context) (*dto.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCapacity", ctx)
+	ret0, _ := ret[0].(*dto.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCapacity indicates an expected call of GetCapacity.
+func (mr *MockModuleMockRecorder) GetCapacity(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockModule)(nil).GetCapacity), ctx)
+}
+
+// GetOrder mocks base method.
+func (m *MockModule) GetOrder(ctx context.Context, orderID int64) (*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, orderID)
+	ret0, _ := ret[0].(*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockModuleMockRecorder) GetOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockModule)(nil).GetOrder), ctx, orderID)
+}
+
+// GetOrderHistory mocks base method.
+func (m *MockModule) GetOrderHistory(ctx context.Context, orderID int64) ([]*dto.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderHistory", ctx, orderID)
+	ret0, _ := ret[0].([]*dto.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderHistory indicates an expected call of GetOrderHistory.
+func (mr *MockModuleMockRecorder) GetOrderHistory(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderHistory", reflect.TypeOf((*MockModule)(nil).GetOrderHistory), ctx, orderID)
+}
+
+// GetRecipient mocks base method.
+func (m *MockModule) GetRecipient(ctx context.Context, id int64) (*dto.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipient", ctx, id)
+	ret0, _ := ret[0].(*dto.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipient indicates an expected call of GetRecipient.
+func (mr *MockModuleMockRecorder) GetRecipient(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipient", reflect.TypeOf((*MockModule)(nil).GetRecipient), ctx, id)
+}
+
+// GetReturnManifest mocks base method.
+func (m *MockModule) GetReturnManifest(ctx context.Context, courierID int64) ([]*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturnManifest", ctx, courierID)
+	ret0, _ := ret[0].([]*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturnManifest indicates an expected call of GetReturnManifest.
+func (mr *MockModuleMockRecorder) GetReturnManifest(ctx, courierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturnManifest", reflect.TypeOf((*MockModule)(nil).GetReturnManifest), ctx, courierID)
+}
+
+// IssueOrderClient mocks base method.
+func (m *MockModule) IssueOrderClient(ctx context.Context, request *dto.IssueRequest) ([]*dto.IssueResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueOrderClient", ctx, request)
+	ret0, _ := ret[0].([]*dto.IssueResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // IssueOrderClient indicates an expected call of IssueOrderClient.
-func (mr *MockModuleMockRecorder) IssueOrderClient(ctx, orderIDs interface{}) *gomock.Call {
+func (mr *MockModuleMockRecorder) IssueOrderClient(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOrderClient", reflect.TypeOf((*MockModule)(nil).IssueOrderClient), ctx, request)
+}
+
+// ListCellOrders mocks base method.
+func (m *MockModule) ListCellOrders(ctx context.Context, cellID int64) ([]*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCellOrders", ctx, cellID)
+	ret0, _ := ret[0].([]*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCellOrders indicates an expected call of ListCellOrders.
+func (mr *MockModuleMockRecorder) ListCellOrders(ctx, cellID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOrderClient", reflect.TypeOf((*MockModule)(nil).IssueOrderClient), ctx, orderIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCellOrders", reflect.TypeOf((*MockModule)(nil).ListCellOrders), ctx, cellID)
+}
+
+// ListCells mocks base method.
+func (m *MockModule) ListCells(ctx context.Context) ([]*dto.Cell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCells", ctx)
+	ret0, _ := ret[0].([]*dto.Cell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCells indicates an expected call of ListCells.
+func (mr *MockModuleMockRecorder) ListCells(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCells", reflect.TypeOf((*MockModule)(nil).ListCells), ctx)
+}
+
+// ListCourierReturns mocks base method.
+func (m *MockModule) ListCourierReturns(ctx context.Context, from, to time.Time) ([]*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCourierReturns", ctx, from, to)
+	ret0, _ := ret[0].([]*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCourierReturns indicates an expected call of ListCourierReturns.
+func (mr *MockModuleMockRecorder) ListCourierReturns(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCourierReturns", reflect.TypeOf((*MockModule)(nil).ListCourierReturns), ctx, from, to)
 }
 
 // ListOrders mocks base method.
@@ -93,33 +333,137 @@ func (mr *MockModuleMockRecorder) ListOrders(ctx, recipientID, limit interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockModule)(nil).ListOrders), ctx, recipientID, limit)
 }
 
+// ListOrdersByPhone mocks base method.
+func (m *MockModule) ListOrdersByPhone(ctx context.Context, phone string, limit int32) ([]*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrdersByPhone", ctx, phone, limit)
+	ret0, _ := ret[0].([]*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrdersByPhone indicates an expected call of ListOrdersByPhone.
+func (mr *MockModuleMockRecorder) ListOrdersByPhone(ctx, phone, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrdersByPhone", reflect.TypeOf((*MockModule)(nil).ListOrdersByPhone), ctx, phone, limit)
+}
+
+// ListPackageTypes mocks base method.
+func (m *MockModule) ListPackageTypes(ctx context.Context) ([]*dto.PackageType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPackageTypes", ctx)
+	ret0, _ := ret[0].([]*dto.PackageType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPackageTypes indicates an expected call of ListPackageTypes.
+func (mr *MockModuleMockRecorder) ListPackageTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPackageTypes", reflect.TypeOf((*MockModule)(nil).ListPackageTypes), ctx)
+}
+
+// ListRecipients mocks base method.
+func (m *MockModule) ListRecipients(ctx context.Context, page, limit int32) ([]*dto.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecipients", ctx, page, limit)
+	ret0, _ := ret[0].([]*dto.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecipients indicates an expected call of ListRecipients.
+func (mr *MockModuleMockRecorder) ListRecipients(ctx, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecipients", reflect.TypeOf((*MockModule)(nil).ListRecipients), ctx, page, limit)
+}
+
 // ListReturnOrders mocks base method.
-func (m *MockModule) ListReturnOrders(ctx context.Context, page, limit int32) ([]*dto.Order, error) {
+func (m *MockModule) ListReturnOrders(ctx context.Context, page, limit int32, condition string) ([]*dto.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListReturnOrders", ctx, page, limit)
+	ret := m.ctrl.Call(m, "ListReturnOrders", ctx, page, limit, condition)
 	ret0, _ := ret[0].([]*dto.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListReturnOrders indicates an expected call of ListReturnOrders.
-func (mr *MockModuleMockRecorder) ListReturnOrders(ctx, page, limit interface{}) *gomock.Call {
+func (mr *MockModuleMockRecorder) ListReturnOrders(ctx, page, limit, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnOrders", reflect.TypeOf((*MockModule)(nil).ListReturnOrders), ctx, page, limit, condition)
+}
+
+// MoveOrderToCell mocks base method.
+func (m *MockModule) MoveOrderToCell(ctx context.Context, orderID, cellID int64) (*dto.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOrderToCell", ctx, orderID, cellID)
+	ret0, _ := ret[0].(*dto.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveOrderToCell indicates an expected call of MoveOrderToCell.
+func (mr *MockModuleMockRecorder) MoveOrderToCell(ctx, orderID, cellID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnOrders", reflect.TypeOf((*MockModule)(nil).ListReturnOrders), ctx, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOrderToCell", reflect.TypeOf((*MockModule)(nil).MoveOrderToCell), ctx, orderID, cellID)
+}
+
+// RegeneratePickupCode mocks base method.
+func (m *MockModule) RegeneratePickupCode(ctx context.Context, orderID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegeneratePickupCode", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegeneratePickupCode indicates an expected call of RegeneratePickupCode.
+func (mr *MockModuleMockRecorder) RegeneratePickupCode(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegeneratePickupCode", reflect.TypeOf((*MockModule)(nil).RegeneratePickupCode), ctx, orderID)
 }
 
 // ReturnOrderCourier mocks base method.
-func (m *MockModule) ReturnOrderCourier(ctx context.Context, orderID int64) error {
+func (m *MockModule) ReturnOrderCourier(ctx context.Context, orderID, courierID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrderCourier", ctx, orderID)
+	ret := m.ctrl.Call(m, "ReturnOrderCourier", ctx, orderID, courierID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReturnOrderCourier indicates an expected call of ReturnOrderCourier.
-func (mr *MockModuleMockRecorder) ReturnOrderCourier(ctx, orderID interface{}) *gomock.Call {
+func (mr *MockModuleMockRecorder) ReturnOrderCourier(ctx, orderID, courierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderCourier", reflect.TypeOf((*MockModule)(nil).ReturnOrderCourier), ctx, orderID, courierID)
+}
+
+// UpdateRecipient mocks base method.
+func (m *MockModule) UpdateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecipient", ctx, recipient)
+	ret0, _ := ret[0].(*dto.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecipient indicates an expected call of UpdateRecipient.
+func (mr *MockModuleMockRecorder) UpdateRecipient(ctx, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecipient", reflect.TypeOf((*MockModule)(nil).UpdateRecipient), ctx, recipient)
+}
+
+// VerifyOrderIntegrity mocks base method.
+func (m *MockModule) VerifyOrderIntegrity(ctx context.Context, orderIDs []int64) (*dto.IntegrityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyOrderIntegrity", ctx, orderIDs)
+	ret0, _ := ret[0].(*dto.IntegrityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyOrderIntegrity indicates an expected call of VerifyOrderIntegrity.
+func (mr *MockModuleMockRecorder) VerifyOrderIntegrity(ctx, orderIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrderCourier", reflect.TypeOf((*MockModule)(nil).ReturnOrderCourier), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyOrderIntegrity", reflect.TypeOf((*MockModule)(nil).VerifyOrderIntegrity), ctx, orderIDs)
 }
 
 // MockKafkaSender is a mock of KafkaSender interface.
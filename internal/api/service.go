@@ -3,6 +3,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,17 +13,44 @@ import (
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/date"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Module interface {
-	AcceptOrderCourier(ctx context.Context, order *dto.Order) error
-	ReturnOrderCourier(ctx context.Context, orderID int64) error
-	IssueOrderClient(ctx context.Context, orderIDs []int64) error
+	AcceptOrderCourier(ctx context.Context, order *dto.Order) (*dto.Order, error)
+	ReturnOrderCourier(ctx context.Context, orderID, courierID int64) error
+	IssueOrderClient(ctx context.Context, request *dto.IssueRequest) ([]*dto.IssueResult, error)
 	AcceptReturnClient(ctx context.Context, order *dto.Order) error
 	ListOrders(ctx context.Context, recipientID int64, limit int32) ([]*dto.Order, error)
-	ListReturnOrders(ctx context.Context, page, limit int32) ([]*dto.Order, error)
+	ListReturnOrders(ctx context.Context, page, limit int32, condition string) ([]*dto.Order, error)
+	GetOrder(ctx context.Context, orderID int64) (*dto.Order, error)
+	GetOrderHistory(ctx context.Context, orderID int64) ([]*dto.OrderEvent, error)
+	ListCourierReturns(ctx context.Context, from, to time.Time) ([]*dto.Order, error)
+	GetReturnManifest(ctx context.Context, courierID int64) ([]*dto.Order, error)
+	ConfirmReturnManifest(ctx context.Context, courierID int64, orderIDs []int64) error
+	ListPackageTypes(ctx context.Context) ([]*dto.PackageType, error)
+	CreatePackageType(ctx context.Context, packageType *dto.PackageType) (*dto.PackageType, error)
+	DeactivatePackageType(ctx context.Context, name string) error
+	ExtendStorage(ctx context.Context, orderID int64, days int) (*dto.Order, error)
+	RegeneratePickupCode(ctx context.Context, orderID int64) error
+	VerifyOrderIntegrity(ctx context.Context, orderIDs []int64) (*dto.IntegrityReport, error)
+	ListOrdersByPhone(ctx context.Context, phone string, limit int32) ([]*dto.Order, error)
+	CreateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error)
+	GetRecipient(ctx context.Context, id int64) (*dto.Recipient, error)
+	ListRecipients(ctx context.Context, page, limit int32) ([]*dto.Recipient, error)
+	UpdateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error)
+	DeleteRecipient(ctx context.Context, id int64) error
+	CreateCell(ctx context.Context, cell *dto.Cell) (*dto.Cell, error)
+	ListCells(ctx context.Context) ([]*dto.Cell, error)
+	ListCellOrders(ctx context.Context, cellID int64) ([]*dto.Order, error)
+	MoveOrderToCell(ctx context.Context, orderID, cellID int64) (*dto.Order, error)
+	GetCapacity(ctx context.Context) (*dto.Capacity, error)
+	AcceptOrdersBatch(ctx context.Context, request *dto.AcceptBatchRequest) ([]*dto.AcceptResult, error)
 }
 
 type KafkaSender interface {
@@ -51,7 +79,7 @@ func (s *OrderService) AcceptOrderFromCourier(ctx context.Context, req *order.Ac
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	message := kafka.EventMessage{
 		EventID:   uuid.New(),
@@ -74,14 +102,7 @@ func (s *OrderService) AcceptOrderFromCourier(ctx context.Context, req *order.Ac
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.Module.AcceptOrderCourier(ctx, &dto.Order{
-		OrderID:      req.GetOrderId(),
-		RecipientID:  req.GetRecipientId(),
-		StorageUntil: req.GetStorageUntil().AsTime(),
-		PackageType:  req.GetPackageType(),
-		Weight:       req.GetWeight(),
-		Cost:         req.GetCost(),
-	})
+	acceptedOrder, err := s.Module.AcceptOrderCourier(ctx, acceptOrderToDTO(req))
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "module_error", "error", err.Error())
@@ -91,9 +112,92 @@ func (s *OrderService) AcceptOrderFromCourier(ctx context.Context, req *order.Ac
 
 	span.LogKV("event", "order_accepted")
 
-	return &order.AcceptOrderResponse{
-		Message: "Order accepted successfully",
-		OrderId: req.GetOrderId(),
+	return acceptedOrderToResponse(acceptedOrder), nil
+}
+
+// AcceptOrdersBatch принимает от курьера партию заказов и возвращает результат по каждому заказу.
+// Заказы, не прошедшие валидацию, не передаются в модуль; в режиме atomic они отклоняют всю партию.
+func (s *OrderService) AcceptOrdersBatch(ctx context.Context, req *order.AcceptOrdersBatchRequest) (*order.AcceptOrdersBatchResponse, error) {
+	const op = "api.OrderService.AcceptOrdersBatch"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/accept-orders-batch",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results := make([]*order.AcceptOrderResult, len(req.GetOrders()))
+	orders := make([]*dto.Order, 0, len(req.GetOrders()))
+	// positions номера заказов из orders в партии
+	positions := make([]int, 0, len(req.GetOrders()))
+
+	for i, item := range req.GetOrders() {
+		if err := item.ValidateAll(); err != nil {
+			span.LogKV("event", "validation_error", "order_id", item.GetOrderId(), "error", err.Error())
+
+			if req.GetAtomic() {
+				span.SetTag("error", true)
+
+				return nil, status.Errorf(codes.InvalidArgument, "order %d: %v", item.GetOrderId(), err)
+			}
+
+			results[i] = &order.AcceptOrderResult{OrderId: item.GetOrderId(), Status: "invalid", Reason: err.Error()}
+
+			continue
+		}
+
+		orders = append(orders, acceptOrderToDTO(item))
+		positions = append(positions, i)
+	}
+
+	if len(orders) > 0 {
+		accepted, err := s.Module.AcceptOrdersBatch(ctx, &dto.AcceptBatchRequest{Orders: orders, Atomic: req.GetAtomic()})
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "module_error", "error", err.Error())
+
+			return nil, handleOrderError(err)
+		}
+
+		for i, result := range accepted {
+			results[positions[i]] = acceptResultToResponse(result)
+		}
+	}
+
+	acceptedCount := 0
+	for _, result := range results {
+		if result.GetOrder() != nil {
+			acceptedCount++
+		}
+	}
+
+	span.LogKV("event", "orders_accepted", "accepted_count", acceptedCount, "requested_count", len(results))
+
+	return &order.AcceptOrdersBatchResponse{
+		Message:       acceptBatchMessage(acceptedCount, len(results)),
+		AcceptedCount: int32(acceptedCount),
+		Results:       results,
 	}, nil
 }
 
@@ -104,7 +208,7 @@ func (s *OrderService) ReturnOrderToCourier(ctx context.Context, req *order.Retu
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	message := kafka.EventMessage{
 		EventID:   uuid.New(),
@@ -127,7 +231,7 @@ func (s *OrderService) ReturnOrderToCourier(ctx context.Context, req *order.Retu
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.Module.ReturnOrderCourier(ctx, req.GetOrderId())
+	err = s.Module.ReturnOrderCourier(ctx, req.GetOrderId(), req.GetCourierId())
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "module_error", "error", err.Error())
@@ -150,13 +254,13 @@ func (s *OrderService) IssueOrderToClient(ctx context.Context, req *order.IssueO
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	message := kafka.EventMessage{
 		EventID:   uuid.New(),
 		Timestamp: time.Now(),
 		Method:    "/api/v1/orders/issue-order",
-		Arguments: req,
+		Arguments: withoutPickupCodes(req),
 	}
 	err := s.Sender.SendMessage(&message)
 	if err != nil {
@@ -173,7 +277,12 @@ func (s *OrderService) IssueOrderToClient(ctx context.Context, req *order.IssueO
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.Module.IssueOrderClient(ctx, req.GetOrderIds())
+	results, err := s.Module.IssueOrderClient(ctx, &dto.IssueRequest{
+		RecipientID: req.GetRecipientId(),
+		OrderIDs:    req.GetOrderIds(),
+		PickupCodes: req.GetPickupCodes(),
+		Atomic:      req.GetAtomic(),
+	})
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "module_error", "error", err.Error())
@@ -181,10 +290,22 @@ func (s *OrderService) IssueOrderToClient(ctx context.Context, req *order.IssueO
 		return nil, handleOrderError(err)
 	}
 
-	span.LogKV("event", "order_issued")
+	fees := make([]*dto.StorageFee, 0, len(results))
+	for _, result := range results {
+		if result.StorageFee != nil {
+			fees = append(fees, result.StorageFee)
+		}
+	}
+
+	span.LogKV("event", "order_issued", "issued_count", len(fees), "requested_count", len(results))
+
+	storageFees, totalStorageFee := storageFeeListToResponse(fees)
 
 	return &order.IssueOrderResponse{
-		Message: "Order issued successfully",
+		Message:         issueMessage(len(fees), len(results)),
+		StorageFees:     storageFees,
+		TotalStorageFee: totalStorageFee,
+		Results:         issueResultListToResponse(results),
 	}, nil
 }
 
@@ -195,7 +316,7 @@ func (s *OrderService) ListOrders(ctx context.Context, req *order.ListOrdersRequ
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	message := kafka.EventMessage{
 		EventID:   uuid.New(),
@@ -223,7 +344,12 @@ func (s *OrderService) ListOrders(ctx context.Context, req *order.ListOrdersRequ
 		limit = req.GetLimit()
 	}
 
-	orders, err := s.Module.ListOrders(ctx, req.GetRecipientId(), limit)
+	var orders []*dto.Order
+	if req.GetPhone() != "" {
+		orders, err = s.Module.ListOrdersByPhone(ctx, req.GetPhone(), limit)
+	} else {
+		orders, err = s.Module.ListOrders(ctx, req.GetRecipientId(), limit)
+	}
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "module_error", "error", err.Error())
@@ -241,7 +367,7 @@ func (s *OrderService) AcceptReturnFromClient(ctx context.Context, req *order.Ac
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	message := kafka.EventMessage{
 		EventID:   uuid.New(),
@@ -264,10 +390,20 @@ func (s *OrderService) AcceptReturnFromClient(ctx context.Context, req *order.Ac
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.Module.AcceptReturnClient(ctx, &dto.Order{
-		OrderID:     req.GetOrderId(),
-		RecipientID: req.GetRecipientId(),
-	})
+	returnOrder := &dto.Order{
+		OrderID:         req.GetOrderId(),
+		RecipientID:     req.GetRecipientId(),
+		ReturnReason:    req.GetReason(),
+		ReturnCondition: req.GetCondition(),
+		ReturnNote:      req.GetNote(),
+	}
+	if refund := req.GetRefund(); refund != nil {
+		amount := refund.GetAmount()
+		returnOrder.RefundAmount = &amount
+		returnOrder.Currency = refund.GetCurrency()
+	}
+
+	err = s.Module.AcceptReturnClient(ctx, returnOrder)
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "module_error", "error", err.Error())
@@ -288,7 +424,7 @@ func (s *OrderService) ReturnList(ctx context.Context, req *order.ReturnListRequ
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	message := kafka.EventMessage{
 		EventID:   uuid.New(),
@@ -316,7 +452,7 @@ func (s *OrderService) ReturnList(ctx context.Context, req *order.ReturnListRequ
 		limit = req.GetLimit()
 	}
 
-	orders, err := s.Module.ListReturnOrders(ctx, req.Page, limit)
+	orders, err := s.Module.ListReturnOrders(ctx, req.Page, limit, req.GetCondition())
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "module_error", "error", err.Error())
@@ -327,20 +463,1287 @@ func (s *OrderService) ReturnList(ctx context.Context, req *order.ReturnListRequ
 	return &order.ReturnListResponse{Orders: orderListToResponse(orders)}, nil
 }
 
-func orderToResponse(orderDTO *dto.Order) *order.OrderEntity {
-	return &order.OrderEntity{
-		OrderId:      orderDTO.OrderID,
-		RecipientId:  orderDTO.RecipientID,
-		StorageUntil: date.ConvertUTCToStr(orderDTO.StorageUntil),
+func (s *OrderService) GetOrder(ctx context.Context, req *order.GetOrderRequest) (*order.GetOrderResponse, error) {
+	const op = "api.OrderService.GetOrder"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/get",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orderDTO, err := s.Module.GetOrder(ctx, req.GetOrderId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
 	}
+
+	return &order.GetOrderResponse{Order: orderDetailsToResponse(orderDTO)}, nil
 }
 
-func orderListToResponse(orderList []*dto.Order) []*order.OrderEntity {
-	orderEntityList := make([]*order.OrderEntity, 0, len(orderList))
+func (s *OrderService) GetOrderHistory(ctx context.Context, req *order.GetOrderHistoryRequest) (*order.GetOrderHistoryResponse, error) {
+	const op = "api.OrderService.GetOrderHistory"
 
-	for _, orderDTO := range orderList {
-		orderEntityList = append(orderEntityList, orderToResponse(orderDTO))
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/order-history",
+		Arguments: req,
 	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
 
-	return orderEntityList
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := s.Module.GetOrderHistory(ctx, req.GetOrderId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.GetOrderHistoryResponse{Events: orderEventListToResponse(events)}, nil
+}
+
+func (s *OrderService) ListCourierReturns(ctx context.Context, req *order.ListCourierReturnsRequest) (*order.ListCourierReturnsResponse, error) {
+	const op = "api.OrderService.ListCourierReturns"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/courier-returns",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orders, err := s.Module.ListCourierReturns(ctx, req.GetFrom().AsTime(), req.GetTo().AsTime())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.ListCourierReturnsResponse{Orders: orderListToResponse(orders)}, nil
+}
+
+func (s *OrderService) GetReturnManifest(ctx context.Context, req *order.GetReturnManifestRequest) (*order.GetReturnManifestResponse, error) {
+	const op = "api.OrderService.GetReturnManifest"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/return-manifest",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orders, err := s.Module.GetReturnManifest(ctx, req.GetCourierId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.GetReturnManifestResponse{
+		CourierId: req.GetCourierId(),
+		Orders:    orderListToResponse(orders),
+	}, nil
+}
+
+func (s *OrderService) ConfirmReturnManifest(ctx context.Context, req *order.ConfirmReturnManifestRequest) (*order.ConfirmReturnManifestResponse, error) {
+	const op = "api.OrderService.ConfirmReturnManifest"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/confirm-return-manifest",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Module.ConfirmReturnManifest(ctx, req.GetCourierId(), req.GetOrderIds())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "return_manifest_confirmed")
+
+	return &order.ConfirmReturnManifestResponse{
+		Message:  "Return manifest confirmed successfully",
+		OrderIds: req.GetOrderIds(),
+	}, nil
+}
+
+func (s *OrderService) ListPackageTypes(ctx context.Context, req *order.ListPackageTypesRequest) (*order.ListPackageTypesResponse, error) {
+	const op = "api.OrderService.ListPackageTypes"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/package-types/list",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	packageTypes, err := s.Module.ListPackageTypes(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.ListPackageTypesResponse{PackageTypes: packageTypeListToResponse(packageTypes)}, nil
+}
+
+func (s *OrderService) CreatePackageType(ctx context.Context, req *order.CreatePackageTypeRequest) (*order.CreatePackageTypeResponse, error) {
+	const op = "api.OrderService.CreatePackageType"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/package-types/create",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	packageType, err := s.Module.CreatePackageType(ctx, &dto.PackageType{
+		Name:              req.GetName(),
+		MaxWeight:         req.GetMaxWeight(),
+		MaxLength:         req.GetMaxLength(),
+		MaxWidth:          req.GetMaxWidth(),
+		MaxHeight:         req.GetMaxHeight(),
+		VolumetricDivisor: req.GetVolumetricDivisor(),
+		Cost:              req.GetCost().GetAmount(),
+		Currency:          req.GetCost().GetCurrency(),
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "package_type_created")
+
+	return &order.CreatePackageTypeResponse{
+		Message:     "Package type created successfully",
+		PackageType: packageTypeToResponse(packageType),
+	}, nil
+}
+
+func (s *OrderService) DeactivatePackageType(ctx context.Context, req *order.DeactivatePackageTypeRequest) (*order.DeactivatePackageTypeResponse, error) {
+	const op = "api.OrderService.DeactivatePackageType"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/package-types/deactivate",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Module.DeactivatePackageType(ctx, req.GetName())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "package_type_deactivated")
+
+	return &order.DeactivatePackageTypeResponse{
+		Message: "Package type deactivated successfully",
+		Name:    req.GetName(),
+	}, nil
+}
+
+func (s *OrderService) ExtendStorage(ctx context.Context, req *order.ExtendStorageRequest) (*order.ExtendStorageResponse, error) {
+	const op = "api.OrderService.ExtendStorage"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/extend-storage",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	extendedOrder, err := s.Module.ExtendStorage(ctx, req.GetOrderId(), int(req.GetDays()))
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "storage_extended")
+
+	return &order.ExtendStorageResponse{
+		Message: "Order storage extended successfully",
+		Order:   orderToResponse(extendedOrder),
+	}, nil
+}
+
+func (s *OrderService) RegeneratePickupCode(
+	ctx context.Context,
+	req *order.RegeneratePickupCodeRequest,
+) (*order.RegeneratePickupCodeResponse, error) {
+	const op = "api.OrderService.RegeneratePickupCode"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/regenerate-pickup-code",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Module.RegeneratePickupCode(ctx, req.GetOrderId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "pickup_code_regenerated")
+
+	return &order.RegeneratePickupCodeResponse{
+		Message: "Pickup code regenerated and sent to the recipient",
+		OrderId: req.GetOrderId(),
+	}, nil
+}
+
+func (s *OrderService) VerifyOrderIntegrity(
+	ctx context.Context,
+	req *order.VerifyOrderIntegrityRequest,
+) (*order.VerifyOrderIntegrityResponse, error) {
+	const op = "api.OrderService.VerifyOrderIntegrity"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/orders/verify-integrity",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := s.Module.VerifyOrderIntegrity(ctx, req.GetOrderIds())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "integrity_verified", "checked", report.Checked, "violations", len(report.Violations))
+
+	violations := make([]*order.IntegrityViolationEntity, 0, len(report.Violations))
+	for _, violation := range report.Violations {
+		violations = append(violations, &order.IntegrityViolationEntity{
+			OrderId: violation.OrderID,
+			Status:  violation.Status,
+		})
+	}
+
+	responseMessage := "All orders passed integrity verification"
+	if len(violations) > 0 {
+		responseMessage = fmt.Sprintf("%d of %d orders failed integrity verification", len(violations), report.Checked)
+	}
+
+	return &order.VerifyOrderIntegrityResponse{
+		Message:    responseMessage,
+		Checked:    int32(report.Checked),
+		Violations: violations,
+	}, nil
+}
+
+func (s *OrderService) CreateRecipient(ctx context.Context, req *order.CreateRecipientRequest) (*order.CreateRecipientResponse, error) {
+	const op = "api.OrderService.CreateRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/recipients/create",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recipient, err := s.Module.CreateRecipient(ctx, &dto.Recipient{
+		ID:               req.GetId(),
+		Name:             req.GetName(),
+		Phone:            req.GetPhone(),
+		Email:            req.GetEmail(),
+		PreferredChannel: req.GetPreferredChannel(),
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "recipient_created")
+
+	return &order.CreateRecipientResponse{
+		Message:   "Recipient created successfully",
+		Recipient: recipientToResponse(recipient),
+	}, nil
+}
+
+func (s *OrderService) GetRecipient(ctx context.Context, req *order.GetRecipientRequest) (*order.GetRecipientResponse, error) {
+	const op = "api.OrderService.GetRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/recipients/get",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recipient, err := s.Module.GetRecipient(ctx, req.GetId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.GetRecipientResponse{Recipient: recipientToResponse(recipient)}, nil
+}
+
+func (s *OrderService) ListRecipients(ctx context.Context, req *order.ListRecipientsRequest) (*order.ListRecipientsResponse, error) {
+	const op = "api.OrderService.ListRecipients"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/recipients/list",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := defaultOrderLimit
+	if req.Limit != nil {
+		limit = req.GetLimit()
+	}
+
+	recipients, err := s.Module.ListRecipients(ctx, req.GetPage(), limit)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	result := make([]*order.RecipientEntity, 0, len(recipients))
+	for _, recipient := range recipients {
+		result = append(result, recipientToResponse(recipient))
+	}
+
+	return &order.ListRecipientsResponse{Recipients: result}, nil
+}
+
+func (s *OrderService) UpdateRecipient(ctx context.Context, req *order.UpdateRecipientRequest) (*order.UpdateRecipientResponse, error) {
+	const op = "api.OrderService.UpdateRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/recipients/update",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	recipient, err := s.Module.UpdateRecipient(ctx, &dto.Recipient{
+		ID:               req.GetId(),
+		Name:             req.GetName(),
+		Phone:            req.GetPhone(),
+		Email:            req.GetEmail(),
+		PreferredChannel: req.GetPreferredChannel(),
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "recipient_updated")
+
+	return &order.UpdateRecipientResponse{
+		Message:   "Recipient updated successfully",
+		Recipient: recipientToResponse(recipient),
+	}, nil
+}
+
+func (s *OrderService) DeleteRecipient(ctx context.Context, req *order.DeleteRecipientRequest) (*order.DeleteRecipientResponse, error) {
+	const op = "api.OrderService.DeleteRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/recipients/delete",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.Module.DeleteRecipient(ctx, req.GetId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "recipient_deleted")
+
+	return &order.DeleteRecipientResponse{
+		Message: "Recipient deleted successfully",
+		Id:      req.GetId(),
+	}, nil
+}
+
+func (s *OrderService) CreateCell(ctx context.Context, req *order.CreateCellRequest) (*order.CreateCellResponse, error) {
+	const op = "api.OrderService.CreateCell"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/cells/create",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	created, err := s.Module.CreateCell(ctx, &dto.Cell{
+		Zone:         req.GetZone(),
+		Rack:         req.GetRack(),
+		Shelf:        req.GetShelf(),
+		MaxOrders:    int(req.GetMaxOrders()),
+		MaxWeight:    req.GetMaxWeight(),
+		PackageTypes: req.GetPackageTypes(),
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "cell_created", "cell_id", created.ID)
+
+	return &order.CreateCellResponse{
+		Message: "Cell created successfully",
+		Cell:    cellToResponse(created),
+	}, nil
+}
+
+func (s *OrderService) ListCells(ctx context.Context, req *order.ListCellsRequest) (*order.ListCellsResponse, error) {
+	const op = "api.OrderService.ListCells"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/cells/list",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cells, err := s.Module.ListCells(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	result := make([]*order.CellEntity, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, cellToResponse(cell))
+	}
+
+	return &order.ListCellsResponse{Cells: result}, nil
+}
+
+func (s *OrderService) ListCellOrders(ctx context.Context, req *order.ListCellOrdersRequest) (*order.ListCellOrdersResponse, error) {
+	const op = "api.OrderService.ListCellOrders"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/cells/orders",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	orders, err := s.Module.ListCellOrders(ctx, req.GetCellId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.ListCellOrdersResponse{Orders: orderListToResponse(orders)}, nil
+}
+
+func (s *OrderService) MoveOrder(ctx context.Context, req *order.MoveOrderRequest) (*order.MoveOrderResponse, error) {
+	const op = "api.OrderService.MoveOrder"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/cells/move-order",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := req.ValidateAll(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "validation_error", "error", err.Error())
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	moved, err := s.Module.MoveOrderToCell(ctx, req.GetOrderId(), req.GetCellId())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	span.LogKV("event", "order_moved", "cell_id", moved.CellID)
+
+	return &order.MoveOrderResponse{
+		Message:  "Order moved successfully",
+		OrderId:  moved.OrderID,
+		CellId:   moved.CellID,
+		CellCode: moved.CellCode,
+	}, nil
+}
+
+func (s *OrderService) GetCapacity(ctx context.Context, req *order.GetCapacityRequest) (*order.GetCapacityResponse, error) {
+	const op = "api.OrderService.GetCapacity"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	message := kafka.EventMessage{
+		EventID:   uuid.New(),
+		Timestamp: time.Now(),
+		Method:    "/api/v1/pickup-point/capacity",
+		Arguments: req,
+	}
+	err := s.Sender.SendMessage(&message)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "kafka_send_error", "error", err.Error())
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	capacity, err := s.Module.GetCapacity(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "module_error", "error", err.Error())
+
+		return nil, handleOrderError(err)
+	}
+
+	return &order.GetCapacityResponse{
+		PvzId:      capacity.PickupPointID,
+		OrderCount: capacity.OrderCount,
+		Weight:     capacity.Weight,
+		MaxOrders:  capacity.MaxOrders,
+		MaxWeight:  capacity.MaxWeight,
+	}, nil
+}
+
+// withoutPickupCodes возвращает копию запроса без кодов выдачи, чтобы они не попали в журнал событий
+func withoutPickupCodes(req *order.IssueOrderRequest) *order.IssueOrderRequest {
+	audit, ok := proto.Clone(req).(*order.IssueOrderRequest)
+	if !ok {
+		return &order.IssueOrderRequest{}
+	}
+
+	audit.PickupCodes = nil
+
+	return audit
+}
+
+func orderToResponse(orderDTO *dto.Order) *order.OrderEntity {
+	orderEntity := &order.OrderEntity{
+		OrderId:             orderDTO.OrderID,
+		RecipientId:         orderDTO.RecipientID,
+		StorageUntil:        date.ConvertUTCToStr(orderDTO.StorageUntil),
+		Status:              orderDTO.Status,
+		CourierId:           orderDTO.CourierID,
+		PackageLayers:       orderDTO.PackageLayers,
+		OrderCost:           moneyToResponse(orderDTO.Cost, orderDTO.Currency),
+		PackageCost:         moneyToResponse(orderDTO.PackageCost, orderDTO.Currency),
+		TotalCost:           moneyToResponse(orderDTO.TotalCost, orderDTO.Currency),
+		StorageFee:          moneyToResponse(orderDTO.StorageFee, orderDTO.Currency),
+		ExtensionCount:      int32(orderDTO.ExtensionCount),
+		ReturnReason:        orderDTO.ReturnReason,
+		ReturnCondition:     orderDTO.ReturnCondition,
+		ReturnNote:          orderDTO.ReturnNote,
+		Category:            orderDTO.Category,
+		ReturnWindow:        durationpb.New(orderDTO.ReturnWindow),
+		ReturnDeadline:      timestampToResponse(orderDTO.ReturnDeadline),
+		ReturnedToCourierAt: timestampToResponse(orderDTO.ReturnedToCourierAt),
+		CellId:              orderDTO.CellID,
+	}
+
+	if orderDTO.RefundAmount != nil {
+		orderEntity.Refund = moneyToResponse(*orderDTO.RefundAmount, orderDTO.Currency)
+	}
+
+	return orderEntity
+}
+
+func orderDetailsToResponse(orderDTO *dto.Order) *order.OrderDetailsEntity {
+	orderEntity := &order.OrderDetailsEntity{
+		OrderId:              orderDTO.OrderID,
+		RecipientId:          orderDTO.RecipientID,
+		PickupPointId:        orderDTO.PickupPointID,
+		CourierId:            orderDTO.CourierID,
+		CellId:               orderDTO.CellID,
+		Status:               orderDTO.Status,
+		Weight:               orderDTO.Weight,
+		Length:               orderDTO.Length,
+		Width:                orderDTO.Width,
+		Height:               orderDTO.Height,
+		PackageType:          orderDTO.PackageType,
+		PackageLayers:        orderDTO.PackageLayers,
+		Category:             orderDTO.Category,
+		OrderCost:            moneyToResponse(orderDTO.Cost, orderDTO.Currency),
+		PackageCost:          moneyToResponse(orderDTO.PackageCost, orderDTO.Currency),
+		TotalCost:            moneyToResponse(orderDTO.TotalCost, orderDTO.Currency),
+		StorageFee:           moneyToResponse(orderDTO.StorageFee, orderDTO.Currency),
+		StorageUntil:         timestamppb.New(orderDTO.StorageUntil),
+		OriginalStorageUntil: timestampToResponse(orderDTO.OriginalStorageUntil),
+		ExtensionCount:       int32(orderDTO.ExtensionCount),
+		PickupCodeAttempts:   int32(orderDTO.PickupCodeAttempts),
+		IssuedAt:             timestampToResponse(orderDTO.IssuedAt),
+		ReturnedAt:           timestampToResponse(orderDTO.ReturnAt),
+		ReturnedToCourierAt:  timestampToResponse(orderDTO.ReturnedToCourierAt),
+		ReturnReason:         orderDTO.ReturnReason,
+		ReturnCondition:      orderDTO.ReturnCondition,
+		ReturnNote:           orderDTO.ReturnNote,
+		ReturnWindow:         durationpb.New(orderDTO.ReturnWindow),
+		ReturnDeadline:       timestampToResponse(orderDTO.ReturnDeadline),
+		Hash:                 orderDTO.Hash,
+		Version:              orderDTO.Version,
+	}
+
+	if orderDTO.RefundAmount != nil {
+		orderEntity.Refund = moneyToResponse(*orderDTO.RefundAmount, orderDTO.Currency)
+	}
+
+	return orderEntity
+}
+
+func orderListToResponse(orderList []*dto.Order) []*order.OrderEntity {
+	orderEntityList := make([]*order.OrderEntity, 0, len(orderList))
+
+	for _, orderDTO := range orderList {
+		orderEntityList = append(orderEntityList, orderToResponse(orderDTO))
+	}
+
+	return orderEntityList
+}
+
+func orderEventListToResponse(eventList []*dto.OrderEvent) []*order.OrderEventEntity {
+	eventEntityList := make([]*order.OrderEventEntity, 0, len(eventList))
+
+	for _, event := range eventList {
+		eventEntityList = append(eventEntityList, &order.OrderEventEntity{
+			Id:        event.ID,
+			OrderId:   event.OrderID,
+			OldStatus: event.OldStatus,
+			NewStatus: event.NewStatus,
+			Operation: event.Operation,
+			Payload:   event.Payload,
+			CreatedAt: timestamppb.New(event.CreatedAt),
+		})
+	}
+
+	return eventEntityList
+}
+
+func recipientToResponse(recipient *dto.Recipient) *order.RecipientEntity {
+	return &order.RecipientEntity{
+		Id:               recipient.ID,
+		Name:             recipient.Name,
+		Phone:            recipient.Phone,
+		Email:            recipient.Email,
+		PreferredChannel: recipient.PreferredChannel,
+		CreatedAt:        timestamppb.New(recipient.CreatedAt),
+		UpdatedAt:        timestamppb.New(recipient.UpdatedAt),
+	}
+}
+
+func cellToResponse(cell *dto.Cell) *order.CellEntity {
+	return &order.CellEntity{
+		Id:           cell.ID,
+		Zone:         cell.Zone,
+		Rack:         cell.Rack,
+		Shelf:        cell.Shelf,
+		Code:         cell.Code,
+		MaxOrders:    int32(cell.MaxOrders),
+		MaxWeight:    cell.MaxWeight,
+		PackageTypes: cell.PackageTypes,
+		OrderCount:   int32(cell.OrderCount),
+		Weight:       cell.Weight,
+		CreatedAt:    timestamppb.New(cell.CreatedAt),
+	}
+}
+
+func packageTypeToResponse(packageType *dto.PackageType) *order.PackageTypeEntity {
+	return &order.PackageTypeEntity{
+		Name:              packageType.Name,
+		MaxWeight:         packageType.MaxWeight,
+		MaxLength:         packageType.MaxLength,
+		MaxWidth:          packageType.MaxWidth,
+		MaxHeight:         packageType.MaxHeight,
+		VolumetricDivisor: packageType.VolumetricDivisor,
+		Cost:              moneyToResponse(packageType.Cost, packageType.Currency),
+		Active:            packageType.Active,
+		CreatedAt:         timestamppb.New(packageType.CreatedAt),
+	}
+}
+
+func packageTypeListToResponse(packageTypes []*dto.PackageType) []*order.PackageTypeEntity {
+	result := make([]*order.PackageTypeEntity, 0, len(packageTypes))
+	for _, packageType := range packageTypes {
+		result = append(result, packageTypeToResponse(packageType))
+	}
+
+	return result
+}
+
+// storageFeeListToResponse возвращает расчет платы по заказам и общую сумму к оплате.
+// Заказы одного получателя выдаются в одной валюте, поэтому сумма берется в валюте первого заказа.
+func storageFeeListToResponse(fees []*dto.StorageFee) ([]*order.StorageFeeEntity, *order.Money) {
+	result := make([]*order.StorageFeeEntity, 0, len(fees))
+	total := &order.Money{Currency: money.DefaultCurrency}
+
+	for i, fee := range fees {
+		if i == 0 {
+			total.Currency = fee.Currency
+		}
+		total.Amount += fee.Amount
+
+		result = append(result, storageFeeToResponse(fee))
+	}
+
+	return result, total
+}
+
+func storageFeeToResponse(fee *dto.StorageFee) *order.StorageFeeEntity {
+	return &order.StorageFeeEntity{
+		OrderId:        fee.OrderID,
+		OverdueDays:    int32(fee.OverdueDays),
+		FreeDays:       int32(fee.FreeDays),
+		ChargeableDays: int32(fee.ChargeableDays),
+		DailyRate:      moneyToResponse(fee.DailyRate, fee.Currency),
+		Cap:            moneyToResponse(fee.Cap, fee.Currency),
+		Capped:         fee.Capped,
+		Amount:         moneyToResponse(fee.Amount, fee.Currency),
+	}
+}
+
+func issueResultListToResponse(results []*dto.IssueResult) []*order.IssueOrderResult {
+	response := make([]*order.IssueOrderResult, 0, len(results))
+
+	for _, result := range results {
+		issueResult := &order.IssueOrderResult{
+			OrderId: result.OrderID,
+			Status:  result.Status,
+		}
+		if result.StorageFee != nil {
+			issueResult.StorageFee = storageFeeToResponse(result.StorageFee)
+		}
+
+		response = append(response, issueResult)
+	}
+
+	return response
+}
+
+// issueMessage описывает итог выдачи: все заказы, часть заказов или ни одного
+func issueMessage(issued, requested int) string {
+	switch {
+	case issued == requested:
+		return "Order issued successfully"
+	case issued == 0:
+		return "No orders were issued"
+	default:
+		return fmt.Sprintf("%d of %d orders issued", issued, requested)
+	}
+}
+
+// acceptOrderToDTO преобразует запрос на приемку заказа от курьера в DTO
+func acceptOrderToDTO(req *order.AcceptOrderRequest) *dto.Order {
+	orderCost, currency := acceptOrderCost(req)
+
+	return &dto.Order{
+		OrderID:         req.GetOrderId(),
+		RecipientID:     req.GetRecipientId(),
+		CourierID:       req.GetCourierId(),
+		StorageUntil:    req.GetStorageUntil().AsTime(),
+		PackageType:     req.GetPackageType(),
+		PackageLayers:   req.GetPackageLayers(),
+		Weight:          req.GetWeight(),
+		Length:          req.GetLength(),
+		Width:           req.GetWidth(),
+		Height:          req.GetHeight(),
+		Cost:            orderCost,
+		Currency:        currency,
+		Category:        req.GetCategory(),
+		CreateRecipient: req.GetCreateRecipient(),
+	}
+}
+
+func acceptedOrderToResponse(acceptedOrder *dto.Order) *order.AcceptOrderResponse {
+	return &order.AcceptOrderResponse{
+		Message:     "Order accepted successfully",
+		OrderId:     acceptedOrder.OrderID,
+		OrderCost:   moneyToResponse(acceptedOrder.Cost, acceptedOrder.Currency),
+		PackageCost: moneyToResponse(acceptedOrder.PackageCost, acceptedOrder.Currency),
+		TotalCost:   moneyToResponse(acceptedOrder.TotalCost, acceptedOrder.Currency),
+		CellId:      acceptedOrder.CellID,
+		CellCode:    acceptedOrder.CellCode,
+	}
+}
+
+func acceptResultToResponse(result *dto.AcceptResult) *order.AcceptOrderResult {
+	acceptResult := &order.AcceptOrderResult{
+		OrderId: result.OrderID,
+		Status:  result.Status,
+		Reason:  result.Reason,
+	}
+	if result.Order != nil {
+		acceptResult.Order = acceptedOrderToResponse(result.Order)
+	}
+
+	return acceptResult
+}
+
+// acceptBatchMessage описывает итог приемки партии: все заказы, часть заказов или ни одного
+func acceptBatchMessage(accepted, requested int) string {
+	switch {
+	case accepted == requested:
+		return "Orders accepted successfully"
+	case accepted == 0:
+		return "No orders were accepted"
+	default:
+		return fmt.Sprintf("%d of %d orders accepted", accepted, requested)
+	}
+}
+
+// acceptOrderCost возвращает стоимость заказа в минимальных единицах валюты.
+// Старые клиенты передают стоимость в рублях в устаревшем поле cost.
+func acceptOrderCost(req *order.AcceptOrderRequest) (int64, string) {
+	if req.GetOrderCost() != nil {
+		return req.GetOrderCost().GetAmount(), req.GetOrderCost().GetCurrency()
+	}
+
+	return money.FromMajor(req.GetCost()), money.DefaultCurrency
+}
+
+func moneyToResponse(amount int64, currency string) *order.Money {
+	return &order.Money{
+		Amount:   amount,
+		Currency: currency,
+	}
+}
+
+// timestampToResponse преобразует момент времени в Timestamp; нулевое время означает, что событие не наступило
+func timestampToResponse(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
 }
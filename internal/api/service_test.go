@@ -2,16 +2,20 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	mock_service "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/api/mocks"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/kafka"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/module"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/testutils"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -48,7 +52,8 @@ func newFixture(t *testing.T) *fixture {
 
 func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 	var (
-		ctx = context.Background()
+		ctx         = context.Background()
+		packageType = "box"
 	)
 
 	t.Run("Success", func(t *testing.T) {
@@ -59,19 +64,30 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 		req := &order.AcceptOrderRequest{
 			OrderId:      1,
 			RecipientId:  123,
+			CourierId:    7,
 			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
-			PackageType:  "Box",
+			PackageType:  &packageType,
 			Weight:       5.5,
-			Cost:         100.75,
+			OrderCost:    &order.Money{Amount: 10075, Currency: "RUB"},
 		}
 		fx.mockModule.EXPECT().AcceptOrderCourier(gomock.Any(), &dto.Order{
 			OrderID:      req.GetOrderId(),
 			RecipientID:  req.GetRecipientId(),
+			CourierID:    req.GetCourierId(),
 			StorageUntil: req.GetStorageUntil().AsTime(),
 			PackageType:  req.GetPackageType(),
 			Weight:       req.GetWeight(),
-			Cost:         req.GetCost(),
-		}).Return(nil)
+			Cost:         10075,
+			Currency:     "RUB",
+		}).Return(&dto.Order{
+			OrderID:     req.GetOrderId(),
+			Cost:        10075,
+			PackageCost: 2000,
+			Currency:    "RUB",
+			TotalCost:   12075,
+			CellID:      3,
+			CellCode:    "A-1-2",
+		}, nil)
 
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/accept-order",
@@ -82,8 +98,63 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 
 		fx.assert.NoError(err)
 		fx.assert.NotNil(resp)
-		fx.assert.Equal(uint32(codes.OK), resp.GetStatus())
-		fx.assert.Equal("order was accepted", resp.GetMessage())
+		fx.assert.Equal("Order accepted successfully", resp.GetMessage())
+		fx.assert.Equal(int64(10075), resp.GetOrderCost().GetAmount())
+		fx.assert.Equal(int64(2000), resp.GetPackageCost().GetAmount())
+		fx.assert.Equal(int64(12075), resp.GetTotalCost().GetAmount())
+		fx.assert.Equal("RUB", resp.GetTotalCost().GetCurrency())
+		fx.assert.Equal("A-1-2", resp.GetCellCode())
+	})
+	t.Run("Legacy Cost", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.AcceptOrderRequest{
+			OrderId:      1,
+			RecipientId:  123,
+			CourierId:    7,
+			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
+			PackageType:  &packageType,
+			Weight:       5.5,
+			Cost:         100.75,
+		}
+		fx.mockModule.EXPECT().AcceptOrderCourier(gomock.Any(), &dto.Order{
+			OrderID:      req.GetOrderId(),
+			RecipientID:  req.GetRecipientId(),
+			CourierID:    req.GetCourierId(),
+			StorageUntil: req.GetStorageUntil().AsTime(),
+			PackageType:  req.GetPackageType(),
+			Weight:       req.GetWeight(),
+			Cost:         10075,
+			Currency:     "RUB",
+		}).Return(&dto.Order{OrderID: req.GetOrderId(), Cost: 10075, Currency: "RUB", TotalCost: 10075}, nil)
+
+		resp, err := fx.grpcService.AcceptOrderFromCourier(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int64(10075), resp.GetTotalCost().GetAmount())
+	})
+	t.Run("Invalid Currency", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.AcceptOrderRequest{
+			OrderId:      1,
+			RecipientId:  123,
+			CourierId:    7,
+			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
+			PackageType:  &packageType,
+			OrderCost:    &order.Money{Amount: 100, Currency: "rub"},
+		}
+
+		_, err := fx.grpcService.AcceptOrderFromCourier(ctx, req)
+
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
 	})
 	t.Run("Invalid Request", func(t *testing.T) {
 		t.Parallel()
@@ -94,11 +165,13 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 			OrderId:      -1, // Invalid OrderID
 			RecipientId:  12,
 			StorageUntil: timestamppb.New(time.Now().Add(time.Hour)),
-			PackageType:  "box",
+			PackageType:  &packageType,
 			Weight:       12.0,
 			Cost:         125.4,
 		}
 
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
 		_, err := fx.grpcService.AcceptOrderFromCourier(ctx, invalidReq)
 
 		fx.assert.Error(err)
@@ -112,21 +185,13 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 		req := &order.AcceptOrderRequest{
 			OrderId:      1,
 			RecipientId:  123,
+			CourierId:    7,
 			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
-			PackageType:  "Box",
+			PackageType:  &packageType,
 			Weight:       5.5,
 			Cost:         100.75,
 		}
 
-		fx.mockModule.EXPECT().AcceptOrderCourier(gomock.Any(), &dto.Order{
-			OrderID:      req.GetOrderId(),
-			RecipientID:  req.GetRecipientId(),
-			StorageUntil: req.GetStorageUntil().AsTime(),
-			PackageType:  req.GetPackageType(),
-			Weight:       req.GetWeight(),
-			Cost:         req.GetCost(),
-		}).Return(nil)
-
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/accept-order",
 		}
@@ -141,12 +206,14 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
 
 		req := &order.AcceptOrderRequest{
 			OrderId:      1,
 			RecipientId:  123,
+			CourierId:    7,
 			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
-			PackageType:  "Box",
+			PackageType:  &packageType,
 			Weight:       5.5,
 			Cost:         100.75,
 		}
@@ -154,11 +221,13 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 		fx.mockModule.EXPECT().AcceptOrderCourier(gomock.Any(), &dto.Order{
 			OrderID:      req.GetOrderId(),
 			RecipientID:  req.GetRecipientId(),
+			CourierID:    req.GetCourierId(),
 			StorageUntil: req.GetStorageUntil().AsTime(),
 			PackageType:  req.GetPackageType(),
 			Weight:       req.GetWeight(),
-			Cost:         req.GetCost(),
-		}).Return(assert.AnError)
+			Cost:         10075,
+			Currency:     "RUB",
+		}).Return(nil, assert.AnError)
 
 		resp, err := fx.grpcService.AcceptOrderFromCourier(ctx, req)
 
@@ -166,12 +235,89 @@ func TestOrderGRPCService_AcceptOrderFromCourier(t *testing.T) {
 		fx.assert.Error(err)
 		fx.assert.Equal(codes.Internal, status.Code(err))
 	})
+	t.Run("Dimension Limit Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.AcceptOrderRequest{
+			OrderId:      1,
+			RecipientId:  123,
+			CourierId:    7,
+			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
+			PackageType:  &packageType,
+			Weight:       5.5,
+			Length:       150,
+			Width:        40,
+			Height:       30,
+		}
+
+		fx.mockModule.EXPECT().
+			AcceptOrderCourier(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("module: %w", domain.ErrDimensionExceedsLimit{
+				Dimension: domain.DimensionLength,
+				Value:     150,
+				Limit:     120,
+			}))
+
+		resp, err := fx.grpcService.AcceptOrderFromCourier(ctx, req)
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+
+		details := status.Convert(err).Details()
+		fx.assert.Len(details, 1)
+
+		badRequest, ok := details[0].(*errdetails.BadRequest)
+		fx.assert.True(ok)
+		fx.assert.Equal(domain.DimensionLength, badRequest.GetFieldViolations()[0].GetField())
+	})
+	t.Run("Pickup Point Full", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.AcceptOrderRequest{
+			OrderId:      1,
+			RecipientId:  123,
+			CourierId:    7,
+			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
+			Weight:       5.5,
+		}
+
+		fx.mockModule.EXPECT().
+			AcceptOrderCourier(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("module: %w", domain.ErrCapacityExceeded{
+				Capacity: domain.Capacity{
+					PickupPointID:   1,
+					PickupPointLoad: domain.PickupPointLoad{OrderCount: 10, Weight: 50},
+					CapacityPolicy:  domain.CapacityPolicy{MaxOrders: 100, MaxWeight: 52},
+				},
+				OrderWeight: 5.5,
+			}))
+
+		resp, err := fx.grpcService.AcceptOrderFromCourier(ctx, req)
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.ResourceExhausted, status.Code(err))
+
+		details := status.Convert(err).Details()
+		fx.assert.Len(details, 1)
+
+		quota, ok := details[0].(*errdetails.QuotaFailure)
+		fx.assert.True(ok)
+		fx.assert.Len(quota.GetViolations(), 1)
+		fx.assert.Equal("pvz:1", quota.GetViolations()[0].GetSubject())
+	})
 }
 
 func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 	var (
-		orderID int64 = 1
-		ctx           = context.Background()
+		orderID   int64 = 1
+		courierID int64 = 7
+		ctx             = context.Background()
 	)
 
 	t.Run("Success", func(t *testing.T) {
@@ -180,9 +326,10 @@ func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.ReturnOrderRequest{
-			OrderID: orderID,
+			OrderId:   orderID,
+			CourierId: courierID,
 		}
-		fx.mockModule.EXPECT().ReturnOrderCourier(gomock.Any(), req.GetOrderID()).Return(nil)
+		fx.mockModule.EXPECT().ReturnOrderCourier(gomock.Any(), req.GetOrderId(), req.GetCourierId()).Return(nil)
 
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/return-order",
@@ -193,8 +340,7 @@ func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 
 		fx.assert.NoError(err)
 		fx.assert.NotNil(resp)
-		fx.assert.Equal(uint32(codes.OK), resp.GetStatus())
-		fx.assert.Equal("order was returned to courier", resp.GetMessage())
+		fx.assert.Equal("Order returned successfully", resp.GetMessage())
 	})
 	t.Run("Invalid Request", func(t *testing.T) {
 		t.Parallel()
@@ -202,9 +348,11 @@ func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 		fx := newFixture(t)
 
 		invalidReq := &order.ReturnOrderRequest{
-			OrderID: -1, // Invalid OrderID
+			OrderId: -1, // Invalid OrderID
 		}
 
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
 		_, err := fx.grpcService.ReturnOrderToCourier(ctx, invalidReq)
 
 		fx.assert.Error(err)
@@ -216,11 +364,10 @@ func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.ReturnOrderRequest{
-			OrderID: orderID,
+			OrderId:   orderID,
+			CourierId: courierID,
 		}
 
-		fx.mockModule.EXPECT().ReturnOrderCourier(gomock.Any(), req.GetOrderID()).Return(nil)
-
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/return-order",
 		}
@@ -235,11 +382,13 @@ func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
 
 		req := &order.ReturnOrderRequest{
-			OrderID: orderID,
+			OrderId:   orderID,
+			CourierId: courierID,
 		}
-		fx.mockModule.EXPECT().ReturnOrderCourier(gomock.Any(), req.GetOrderID()).Return(assert.AnError)
+		fx.mockModule.EXPECT().ReturnOrderCourier(gomock.Any(), req.GetOrderId(), req.GetCourierId()).Return(assert.AnError)
 
 		resp, err := fx.grpcService.ReturnOrderToCourier(ctx, req)
 
@@ -247,6 +396,25 @@ func TestOrderGRPCService_ReturnOrderToCourier(t *testing.T) {
 		fx.assert.Error(err)
 		fx.assert.Equal(codes.Internal, status.Code(err))
 	})
+	t.Run("Order Not Returnable", func(t *testing.T) {
+		t.Parallel()
+
+		// ошибка модуля оборачивает и ошибку операции, и ошибку перехода статуса;
+		// в ответе всегда должно быть сообщение более конкретной ошибки операции
+		moduleErr := fmt.Errorf("%w: %w", module.ErrOrderNotExpiredOrIssued,
+			domain.ErrInvalidStatusTransition{From: domain.OrderStatusIssued, To: domain.OrderStatusReturnedToCourier})
+
+		for i := 0; i < 20; i++ {
+			fx := newFixture(t)
+			fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+			fx.mockModule.EXPECT().ReturnOrderCourier(gomock.Any(), orderID, courierID).Return(moduleErr)
+
+			_, err := fx.grpcService.ReturnOrderToCourier(ctx, &order.ReturnOrderRequest{OrderId: orderID, CourierId: courierID})
+
+			fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+			fx.assert.Contains(status.Convert(err).Message(), "order issued or not expired")
+		}
+	})
 }
 
 func TestOrderGRPCService_IssueOrderToClient(t *testing.T) {
@@ -261,9 +429,30 @@ func TestOrderGRPCService_IssueOrderToClient(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.IssueOrderRequest{
-			OrderIDs: orderIDs,
+			OrderIds: orderIDs,
 		}
-		fx.mockModule.EXPECT().IssueOrderClient(gomock.Any(), req.OrderIDs).Return(nil)
+		fx.mockModule.EXPECT().
+			IssueOrderClient(gomock.Any(), &dto.IssueRequest{OrderIDs: req.GetOrderIds()}).
+			Return([]*dto.IssueResult{
+				{
+					OrderID:    orderIDs[0],
+					Status:     "issued",
+					StorageFee: &dto.StorageFee{OrderID: orderIDs[0], Currency: "RUB"},
+				},
+				{
+					OrderID: orderIDs[1],
+					Status:  "issued",
+					StorageFee: &dto.StorageFee{
+						OrderID:        orderIDs[1],
+						OverdueDays:    3,
+						FreeDays:       1,
+						ChargeableDays: 2,
+						DailyRate:      5000,
+						Amount:         10000,
+						Currency:       "RUB",
+					},
+				},
+			}, nil)
 
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/issue-order",
@@ -274,8 +463,51 @@ func TestOrderGRPCService_IssueOrderToClient(t *testing.T) {
 
 		fx.assert.NoError(err)
 		fx.assert.NotNil(resp)
-		fx.assert.Equal(uint32(codes.OK), resp.GetStatus())
-		fx.assert.Equal("orders was issued to client", resp.GetMessage())
+		fx.assert.Equal("Order issued successfully", resp.GetMessage())
+		fx.assert.Len(resp.GetResults(), 2)
+		fx.assert.Len(resp.GetStorageFees(), 2)
+		fx.assert.Equal(int32(2), resp.GetStorageFees()[1].GetChargeableDays())
+		fx.assert.Equal(int64(10000), resp.GetTotalStorageFee().GetAmount())
+		fx.assert.Equal("RUB", resp.GetTotalStorageFee().GetCurrency())
+	})
+	t.Run("Partial Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.IssueOrderRequest{
+			OrderIds:    orderIDs,
+			RecipientId: 7,
+			PickupCodes: map[int64]string{1: "123456", 2: "654321", 3: "111222"},
+		}
+		fx.mockModule.EXPECT().
+			IssueOrderClient(gomock.Any(), &dto.IssueRequest{
+				RecipientID: 7,
+				OrderIDs:    req.GetOrderIds(),
+				PickupCodes: req.GetPickupCodes(),
+			}).
+			Return([]*dto.IssueResult{
+				{OrderID: orderIDs[0], Status: "issued", StorageFee: &dto.StorageFee{OrderID: orderIDs[0], Currency: "RUB"}},
+				{OrderID: orderIDs[1], Status: "not_found"},
+				{OrderID: orderIDs[2], Status: "wrong_recipient"},
+			}, nil)
+		fx.mockSender.EXPECT().
+			SendMessage(gomock.Any()).
+			DoAndReturn(func(message *kafka.EventMessage) error {
+				audit, ok := message.Arguments.(*order.IssueOrderRequest)
+				fx.assert.True(ok)
+				fx.assert.Empty(audit.GetPickupCodes(), "pickup codes must not be published")
+				return nil
+			})
+
+		resp, err := fx.grpcService.IssueOrderToClient(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Equal("1 of 3 orders issued", resp.GetMessage())
+		fx.assert.Len(resp.GetStorageFees(), 1)
+		fx.assert.Equal("not_found", resp.GetResults()[1].GetStatus())
+		fx.assert.Nil(resp.GetResults()[1].GetStorageFee())
+		fx.assert.Equal("wrong_recipient", resp.GetResults()[2].GetStatus())
 	})
 	t.Run("Invalid Request", func(t *testing.T) {
 		t.Parallel()
@@ -283,25 +515,38 @@ func TestOrderGRPCService_IssueOrderToClient(t *testing.T) {
 		fx := newFixture(t)
 
 		invalidReq := &order.IssueOrderRequest{
-			OrderIDs: []int64{}, // Invalid OrderIDs
+			OrderIds: []int64{}, // Invalid OrderIDs
 		}
 
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
 		_, err := fx.grpcService.IssueOrderToClient(ctx, invalidReq)
 
 		fx.assert.Error(err)
 		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
 	})
+	t.Run("Invalid Pickup Code Format", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.IssueOrderToClient(ctx, &order.IssueOrderRequest{
+			OrderIds:    orderIDs,
+			PickupCodes: map[int64]string{1: "12ab"},
+		})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
 	t.Run("Kafka Error", func(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
 
 		req := &order.IssueOrderRequest{
-			OrderIDs: orderIDs,
+			OrderIds: orderIDs,
 		}
 
-		fx.mockModule.EXPECT().IssueOrderClient(gomock.Any(), req.GetOrderIDs()).Return(nil)
-
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/issue-order",
 		}
@@ -316,19 +561,21 @@ func TestOrderGRPCService_IssueOrderToClient(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
 
 		req := &order.IssueOrderRequest{
-			OrderIDs: orderIDs,
+			OrderIds: orderIDs,
 		}
 
-		fx.mockModule.EXPECT().IssueOrderClient(gomock.Any(), req.OrderIDs).Return(assert.AnError)
+		fx.mockModule.EXPECT().
+			IssueOrderClient(gomock.Any(), &dto.IssueRequest{OrderIDs: req.GetOrderIds()}).
+			Return(nil, assert.AnError)
 
 		resp, err := fx.grpcService.IssueOrderToClient(ctx, req)
 
 		fx.assert.Nil(resp)
 		fx.assert.Error(err)
 		fx.assert.Equal(codes.Internal, status.Code(err))
-		fx.assert.Equal("rpc error: code = Internal desc = assert.AnError general error for testing", err.Error())
 	})
 }
 
@@ -345,8 +592,8 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.ListOrdersRequest{
-			RecipientID: recipientID,
-			Limit:       nil,
+			Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: recipientID},
+			Limit:     nil,
 		}
 		mockOrders := []*dto.Order{
 			{OrderID: 1, RecipientID: 1},
@@ -359,7 +606,7 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		}
 		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
 
-		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.RecipientID, defaultOrderLimit).Return(mockOrders, nil)
+		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.GetRecipientId(), defaultOrderLimit).Return(mockOrders, nil)
 
 		resp, err := fx.grpcService.ListOrders(ctx, req)
 
@@ -368,14 +615,47 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		fx.assert.Len(resp.GetOrders(), len(mockOrders))
 	})
 
+	t.Run("Success with return window", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.ListOrdersRequest{Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: recipientID}}
+		issuedAt := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+		mockOrders := []*dto.Order{
+			{
+				OrderID:        1,
+				RecipientID:    1,
+				Category:       "electronics",
+				IssuedAt:       issuedAt,
+				ReturnWindow:   14 * 24 * time.Hour,
+				ReturnDeadline: issuedAt.Add(14 * 24 * time.Hour),
+			},
+			{OrderID: 2, RecipientID: 1, ReturnWindow: 48 * time.Hour},
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.GetRecipientId(), defaultOrderLimit).Return(mockOrders, nil)
+
+		resp, err := fx.grpcService.ListOrders(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetOrders(), 2)
+		fx.assert.Equal("electronics", resp.GetOrders()[0].GetCategory())
+		fx.assert.Equal(14*24*time.Hour, resp.GetOrders()[0].GetReturnWindow().AsDuration())
+		fx.assert.Equal(issuedAt.Add(14*24*time.Hour), resp.GetOrders()[0].GetReturnDeadline().AsTime())
+		fx.assert.Equal(48*time.Hour, resp.GetOrders()[1].GetReturnWindow().AsDuration())
+		fx.assert.Nil(resp.GetOrders()[1].GetReturnDeadline())
+	})
+
 	t.Run("Success with custom Limit", func(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
 
 		req := &order.ListOrdersRequest{
-			RecipientID: recipientID,
-			Limit:       &customLimit,
+			Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: recipientID},
+			Limit:     &customLimit,
 		}
 		mockOrders := []*dto.Order{
 			{OrderID: 1, RecipientID: 2, StorageUntil: time.Now().Add(time.Hour)},
@@ -386,7 +666,7 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		}
 		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
 
-		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.RecipientID, customLimit).Return(mockOrders, nil)
+		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.GetRecipientId(), customLimit).Return(mockOrders, nil)
 
 		resp, err := fx.grpcService.ListOrders(ctx, req)
 
@@ -394,15 +674,64 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		fx.assert.NotNil(resp)
 		fx.assert.Len(resp.GetOrders(), len(mockOrders))
 	})
+	t.Run("Success by phone", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.ListOrdersRequest{
+			Recipient: &order.ListOrdersRequest_Phone{Phone: "8 (999) 123-45-67"},
+		}
+		mockOrders := []*dto.Order{
+			{OrderID: 1, RecipientID: 1},
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+		fx.mockModule.EXPECT().ListOrdersByPhone(gomock.Any(), "8 (999) 123-45-67", defaultOrderLimit).Return(mockOrders, nil)
+
+		resp, err := fx.grpcService.ListOrders(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetOrders(), 1)
+	})
+	t.Run("Unknown Phone", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.ListOrdersRequest{
+			Recipient: &order.ListOrdersRequest_Phone{Phone: "+79991234567"},
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+		fx.mockModule.EXPECT().ListOrdersByPhone(gomock.Any(), "+79991234567", defaultOrderLimit).Return(nil, module.ErrRecipientNotRegistered)
+
+		_, err := fx.grpcService.ListOrders(ctx, req)
+
+		fx.assert.Equal(codes.NotFound, status.Code(err))
+	})
+	t.Run("Recipient Not Set", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.ListOrders(ctx, &order.ListOrdersRequest{})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
 	t.Run("Invalid Request", func(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
 
 		invalidReq := &order.ListOrdersRequest{
-			RecipientID: 0, // Invalid RecipientID
+			Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: 0}, // Invalid RecipientID
 		}
 
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
 		_, err := fx.grpcService.ListOrders(ctx, invalidReq)
 
 		fx.assert.Error(err)
@@ -414,15 +743,9 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.ListOrdersRequest{
-			RecipientID: recipientID,
-			Limit:       &customLimit,
-		}
-		mockOrders := []*dto.Order{
-			{OrderID: 1, RecipientID: 2, StorageUntil: time.Now().Add(time.Hour)},
+			Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: recipientID},
+			Limit:     &customLimit,
 		}
-
-		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.RecipientID, customLimit).Return(mockOrders, nil)
-
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/list-orders",
 		}
@@ -437,13 +760,14 @@ func TestOrderGRPCService_ListOrders(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
 
 		req := &order.ListOrdersRequest{
-			RecipientID: recipientID,
-			Limit:       nil,
+			Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: recipientID},
+			Limit:     nil,
 		}
 
-		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.RecipientID, defaultOrderLimit).Return(nil, assert.AnError)
+		fx.mockModule.EXPECT().ListOrders(gomock.Any(), req.GetRecipientId(), defaultOrderLimit).Return(nil, assert.AnError)
 
 		resp, err := fx.grpcService.ListOrders(ctx, req)
 
@@ -464,12 +788,16 @@ func TestOrderGRPCService_AcceptReturnFromClient(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.AcceptReturnRequest{
-			OrderID:     1,
-			RecipientID: 2,
+			OrderId:     1,
+			RecipientId: 2,
+			Reason:      "defective",
+			Condition:   "damaged",
 		}
 		fx.mockModule.EXPECT().AcceptReturnClient(gomock.Any(), &dto.Order{
-			OrderID:     req.OrderID,
-			RecipientID: req.RecipientID,
+			OrderID:         req.GetOrderId(),
+			RecipientID:     req.GetRecipientId(),
+			ReturnReason:    req.GetReason(),
+			ReturnCondition: req.GetCondition(),
 		}).Return(nil)
 
 		event := &kafka.EventMessage{
@@ -481,8 +809,73 @@ func TestOrderGRPCService_AcceptReturnFromClient(t *testing.T) {
 
 		fx.assert.NoError(err)
 		fx.assert.NotNil(resp)
-		fx.assert.Equal(uint32(codes.OK), resp.Status)
-		fx.assert.Equal("order was returned by recipient", resp.Message)
+		fx.assert.Equal("Return accepted successfully", resp.Message)
+	})
+	t.Run("Success With Refund", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		refund := int64(5000)
+		req := &order.AcceptReturnRequest{
+			OrderId:     1,
+			RecipientId: 2,
+			Reason:      "changed_mind",
+			Condition:   "intact",
+			Note:        "unopened box",
+			Refund:      &order.Money{Amount: refund, Currency: "RUB"},
+		}
+		fx.mockModule.EXPECT().AcceptReturnClient(gomock.Any(), &dto.Order{
+			OrderID:         req.GetOrderId(),
+			RecipientID:     req.GetRecipientId(),
+			ReturnReason:    req.GetReason(),
+			ReturnCondition: req.GetCondition(),
+			ReturnNote:      req.GetNote(),
+			RefundAmount:    &refund,
+			Currency:        "RUB",
+		}).Return(nil)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		resp, err := fx.grpcService.AcceptReturnFromClient(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.NotNil(resp)
+	})
+	t.Run("Unknown Condition", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.AcceptReturnRequest{
+			OrderId:     1,
+			RecipientId: 2,
+			Reason:      "defective",
+			Condition:   "scratched",
+		}
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.AcceptReturnFromClient(ctx, req)
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Unknown Reason", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.AcceptReturnRequest{
+			OrderId:     1,
+			RecipientId: 2,
+			Reason:      "too_expensive",
+			Condition:   "intact",
+		}
+		fx.mockModule.EXPECT().AcceptReturnClient(gomock.Any(), gomock.Any()).
+			Return(fmt.Errorf("module: %w", domain.ErrReturnReasonUnknown))
+
+		_, err := fx.grpcService.AcceptReturnFromClient(ctx, req)
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
 	})
 	t.Run("Invalid Request", func(t *testing.T) {
 		t.Parallel()
@@ -490,10 +883,12 @@ func TestOrderGRPCService_AcceptReturnFromClient(t *testing.T) {
 		fx := newFixture(t)
 
 		invalidReq := &order.AcceptReturnRequest{
-			OrderID:     0, // Invalid OrderID
-			RecipientID: 0, // Invalid RecipientID
+			OrderId:     0, // Invalid OrderID
+			RecipientId: 0, // Invalid RecipientID
 		}
 
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
 		_, err := fx.grpcService.AcceptReturnFromClient(ctx, invalidReq)
 
 		fx.assert.Error(err)
@@ -505,13 +900,11 @@ func TestOrderGRPCService_AcceptReturnFromClient(t *testing.T) {
 		fx := newFixture(t)
 
 		req := &order.AcceptReturnRequest{
-			OrderID:     1,
-			RecipientID: 2,
+			OrderId:     1,
+			RecipientId: 2,
+			Reason:      "defective",
+			Condition:   "damaged",
 		}
-		fx.mockModule.EXPECT().AcceptReturnClient(gomock.Any(), &dto.Order{
-			OrderID:     req.OrderID,
-			RecipientID: req.RecipientID,
-		}).Return(nil)
 
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/accept-return",
@@ -527,14 +920,19 @@ func TestOrderGRPCService_AcceptReturnFromClient(t *testing.T) {
 		t.Parallel()
 
 		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
 
 		req := &order.AcceptReturnRequest{
-			OrderID:     1,
-			RecipientID: 2,
+			OrderId:     1,
+			RecipientId: 2,
+			Reason:      "defective",
+			Condition:   "damaged",
 		}
 		fx.mockModule.EXPECT().AcceptReturnClient(gomock.Any(), &dto.Order{
-			OrderID:     req.OrderID,
-			RecipientID: req.RecipientID,
+			OrderID:         req.GetOrderId(),
+			RecipientID:     req.GetRecipientId(),
+			ReturnReason:    req.GetReason(),
+			ReturnCondition: req.GetCondition(),
 		}).Return(assert.AnError)
 
 		resp, err := fx.grpcService.AcceptReturnFromClient(ctx, req)
@@ -571,7 +969,7 @@ func TestOrderGRPCService_ReturnList(t *testing.T) {
 		}
 		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
 
-		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, defaultOrderLimit).Return(mockOrders, nil)
+		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, defaultOrderLimit, "").Return(mockOrders, nil)
 
 		resp, err := fx.grpcService.ReturnList(ctx, req)
 
@@ -598,7 +996,7 @@ func TestOrderGRPCService_ReturnList(t *testing.T) {
 		}
 		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
 
-		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, *req.Limit).Return(mockOrders, nil)
+		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, *req.Limit, "").Return(mockOrders, nil)
 
 		resp, err := fx.grpcService.ReturnList(ctx, req)
 
@@ -616,6 +1014,8 @@ func TestOrderGRPCService_ReturnList(t *testing.T) {
 			Page: -1, // Invalid Page
 		}
 
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
 		_, err := fx.grpcService.ReturnList(ctx, invalidReq)
 
 		fx.assert.Error(err)
@@ -631,17 +1031,11 @@ func TestOrderGRPCService_ReturnList(t *testing.T) {
 			Page:  page,
 			Limit: &customLimit,
 		}
-		mockOrders := []*dto.Order{
-			{OrderID: 1, RecipientID: 2, StorageUntil: time.Now().Add(time.Hour)},
-		}
-
 		event := &kafka.EventMessage{
 			Method: "/api/v1/orders/return-list",
 		}
 		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(assert.AnError)
 
-		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, *req.Limit).Return(mockOrders, nil)
-
 		_, err := fx.grpcService.ReturnList(ctx, req)
 
 		fx.assert.Error(err)
@@ -650,12 +1044,13 @@ func TestOrderGRPCService_ReturnList(t *testing.T) {
 
 	t.Run("Module Error", func(t *testing.T) {
 		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
 
 		req := &order.ReturnListRequest{
 			Page:  page,
 			Limit: nil,
 		}
-		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, defaultOrderLimit).Return(nil, assert.AnError)
+		fx.mockModule.EXPECT().ListReturnOrders(gomock.Any(), req.Page, defaultOrderLimit, "").Return(nil, assert.AnError)
 
 		resp, err := fx.grpcService.ReturnList(ctx, req)
 
@@ -664,3 +1059,1073 @@ func TestOrderGRPCService_ReturnList(t *testing.T) {
 		fx.assert.Equal(codes.Internal, status.Code(err))
 	})
 }
+
+func TestOrderGRPCService_GetOrder(t *testing.T) {
+	var (
+		orderID int64 = 1
+		ctx           = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		issuedAt := time.Now().UTC()
+		orderDTO := &dto.Order{
+			OrderID:     orderID,
+			RecipientID: 2,
+			Status:      "issued",
+			Weight:      4,
+			Cost:        10000,
+			PackageCost: 500,
+			TotalCost:   10500,
+			Currency:    "RUB",
+			PackageType: "box",
+			IssuedAt:    issuedAt,
+			Hash:        "digest",
+			Version:     3,
+		}
+		fx.mockModule.EXPECT().GetOrder(gomock.Any(), orderID).Return(orderDTO, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/get",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.GetOrder(ctx, &order.GetOrderRequest{OrderId: orderID})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(4.0, resp.GetOrder().GetWeight())
+		fx.assert.Equal(int64(10500), resp.GetOrder().GetTotalCost().GetAmount())
+		fx.assert.Equal("box", resp.GetOrder().GetPackageType())
+		fx.assert.True(issuedAt.Equal(resp.GetOrder().GetIssuedAt().AsTime()))
+		fx.assert.Nil(resp.GetOrder().GetReturnedAt())
+		fx.assert.Equal("digest", resp.GetOrder().GetHash())
+		fx.assert.Equal(int64(3), resp.GetOrder().GetVersion())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.GetOrder(ctx, &order.GetOrderRequest{OrderId: -1})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			GetOrder(gomock.Any(), orderID).
+			Return(nil, fmt.Errorf("module: %w", module.ErrOrderNotFound))
+
+		resp, err := fx.grpcService.GetOrder(ctx, &order.GetOrderRequest{OrderId: orderID})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_GetOrderHistory(t *testing.T) {
+	var (
+		orderID int64 = 1
+		ctx           = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.GetOrderHistoryRequest{
+			OrderId: orderID,
+		}
+		events := []*dto.OrderEvent{
+			{ID: 1, OrderID: orderID, NewStatus: "accepted", Operation: "accept_order_courier", CreatedAt: time.Now()},
+			{ID: 2, OrderID: orderID, OldStatus: "accepted", NewStatus: "issued", Operation: "issue_order_client", CreatedAt: time.Now()},
+		}
+		fx.mockModule.EXPECT().GetOrderHistory(gomock.Any(), req.GetOrderId()).Return(events, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/order-history",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.GetOrderHistory(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetEvents(), len(events))
+		fx.assert.Equal("issued", resp.GetEvents()[1].GetNewStatus())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		invalidReq := &order.GetOrderHistoryRequest{
+			OrderId: -1, // Invalid OrderID
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.GetOrderHistory(ctx, invalidReq)
+
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.GetOrderHistoryRequest{
+			OrderId: orderID,
+		}
+		fx.mockModule.EXPECT().GetOrderHistory(gomock.Any(), req.GetOrderId()).Return(nil, assert.AnError)
+
+		resp, err := fx.grpcService.GetOrderHistory(ctx, req)
+
+		fx.assert.Nil(resp)
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.Internal, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_ListCourierReturns(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		to   = time.Now()
+		from = to.Add(-24 * time.Hour)
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.ListCourierReturnsRequest{
+			From: timestamppb.New(from),
+			To:   timestamppb.New(to),
+		}
+		mockOrders := []*dto.Order{
+			{OrderID: 1, RecipientID: 2, Status: "returned_to_courier", ReturnedToCourierAt: to.Add(-time.Hour)},
+		}
+		fx.mockModule.EXPECT().
+			ListCourierReturns(gomock.Any(), req.GetFrom().AsTime(), req.GetTo().AsTime()).
+			Return(mockOrders, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/courier-returns",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.ListCourierReturns(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetOrders(), 1)
+		fx.assert.Equal("returned_to_courier", resp.GetOrders()[0].GetStatus())
+		fx.assert.NotNil(resp.GetOrders()[0].GetReturnedToCourierAt())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		invalidReq := &order.ListCourierReturnsRequest{
+			From: timestamppb.New(from), // To is missing
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.ListCourierReturns(ctx, invalidReq)
+
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.ListCourierReturnsRequest{
+			From: timestamppb.New(to),
+			To:   timestamppb.New(from),
+		}
+		fx.mockModule.EXPECT().
+			ListCourierReturns(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, module.ErrInvalidDateRange)
+
+		resp, err := fx.grpcService.ListCourierReturns(ctx, req)
+
+		fx.assert.Nil(resp)
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_GetReturnManifest(t *testing.T) {
+	var (
+		courierID int64 = 7
+		ctx             = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.GetReturnManifestRequest{
+			CourierId: courierID,
+		}
+		mockOrders := []*dto.Order{
+			{OrderID: 1, RecipientID: 2, CourierID: courierID, Status: "accepted", StorageUntil: time.Now().Add(-time.Hour)},
+		}
+		fx.mockModule.EXPECT().GetReturnManifest(gomock.Any(), courierID).Return(mockOrders, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/return-manifest",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.GetReturnManifest(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(courierID, resp.GetCourierId())
+		fx.assert.Len(resp.GetOrders(), 1)
+		fx.assert.Equal(courierID, resp.GetOrders()[0].GetCourierId())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		invalidReq := &order.GetReturnManifestRequest{
+			CourierId: -1, // Invalid CourierID
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.GetReturnManifest(ctx, invalidReq)
+
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_ConfirmReturnManifest(t *testing.T) {
+	var (
+		courierID int64 = 7
+		orderIDs        = []int64{1, 2}
+		ctx             = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.ConfirmReturnManifestRequest{
+			CourierId: courierID,
+			OrderIds:  orderIDs,
+		}
+		fx.mockModule.EXPECT().ConfirmReturnManifest(gomock.Any(), courierID, orderIDs).Return(nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/confirm-return-manifest",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.ConfirmReturnManifest(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(orderIDs, resp.GetOrderIds())
+		fx.assert.Equal("Return manifest confirmed successfully", resp.GetMessage())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		invalidReq := &order.ConfirmReturnManifestRequest{
+			CourierId: courierID,
+			OrderIds:  []int64{}, // Empty manifest
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.ConfirmReturnManifest(ctx, invalidReq)
+
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		req := &order.ConfirmReturnManifestRequest{
+			CourierId: courierID,
+			OrderIds:  orderIDs,
+		}
+		fx.mockModule.EXPECT().
+			ConfirmReturnManifest(gomock.Any(), courierID, orderIDs).
+			Return(module.ErrOrderCourierMismatch)
+
+		resp, err := fx.grpcService.ConfirmReturnManifest(ctx, req)
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_ListPackageTypes(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		mockPackageTypes := []*dto.PackageType{
+			{Name: "box", MaxWeight: 30, Cost: 2000, Currency: "RUB", Active: true},
+			{Name: "crate", MaxWeight: 50, Cost: 4000, Currency: "RUB", Active: false},
+		}
+		fx.mockModule.EXPECT().ListPackageTypes(gomock.Any()).Return(mockPackageTypes, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/package-types/list",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.ListPackageTypes(ctx, &order.ListPackageTypesRequest{})
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetPackageTypes(), 2)
+		fx.assert.False(resp.GetPackageTypes()[1].GetActive())
+		fx.assert.Equal(int64(2000), resp.GetPackageTypes()[0].GetCost().GetAmount())
+	})
+}
+
+func TestOrderGRPCService_CreatePackageType(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.CreatePackageTypeRequest{
+			Name:      "crate",
+			MaxWeight: 50,
+			Cost:      &order.Money{Amount: 4000, Currency: "RUB"},
+		}
+		fx.mockModule.EXPECT().
+			CreatePackageType(gomock.Any(), &dto.PackageType{Name: "crate", MaxWeight: 50, Cost: 4000, Currency: "RUB"}).
+			Return(&dto.PackageType{Name: "crate", MaxWeight: 50, Cost: 4000, Currency: "RUB", Active: true}, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/package-types/create",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.CreatePackageType(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Equal("crate", resp.GetPackageType().GetName())
+		fx.assert.True(resp.GetPackageType().GetActive())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		invalidReq := &order.CreatePackageTypeRequest{
+			Name:      "crate",
+			MaxWeight: -1, // Negative weight limit
+		}
+
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.CreatePackageType(ctx, invalidReq)
+
+		fx.assert.Error(err)
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			CreatePackageType(gomock.Any(), gomock.Any()).
+			Return(nil, module.ErrPackageTypeExists)
+
+		resp, err := fx.grpcService.CreatePackageType(ctx, &order.CreatePackageTypeRequest{Name: "box"})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.AlreadyExists, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_DeactivatePackageType(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		fx.mockModule.EXPECT().DeactivatePackageType(gomock.Any(), "box").Return(nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/package-types/deactivate",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.DeactivatePackageType(ctx, &order.DeactivatePackageTypeRequest{Name: "box"})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal("box", resp.GetName())
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			DeactivatePackageType(gomock.Any(), "unknown").
+			Return(module.ErrPackageTypeNotFound)
+
+		resp, err := fx.grpcService.DeactivatePackageType(ctx, &order.DeactivatePackageTypeRequest{Name: "unknown"})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_ExtendStorage(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		storageUntil := time.Now().Add(72 * time.Hour)
+		fx.mockModule.EXPECT().
+			ExtendStorage(gomock.Any(), int64(1), 3).
+			Return(&dto.Order{OrderID: 1, StorageUntil: storageUntil, ExtensionCount: 1}, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/extend-storage",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.ExtendStorage(ctx, &order.ExtendStorageRequest{OrderId: 1, Days: 3})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int64(1), resp.GetOrder().GetOrderId())
+		fx.assert.Equal(int32(1), resp.GetOrder().GetExtensionCount())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.ExtendStorage(ctx, &order.ExtendStorageRequest{OrderId: 1, Days: 0})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Limit Reached", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			ExtendStorage(gomock.Any(), int64(1), 3).
+			Return(nil, fmt.Errorf("module: %w", domain.ErrExtensionCountLimit))
+
+		resp, err := fx.grpcService.ExtendStorage(ctx, &order.ExtendStorageRequest{OrderId: 1, Days: 3})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("Concurrent Update", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			ExtendStorage(gomock.Any(), int64(1), 3).
+			Return(nil, fmt.Errorf("module: %w", module.ErrOrderConflict))
+
+		resp, err := fx.grpcService.ExtendStorage(ctx, &order.ExtendStorageRequest{OrderId: 1, Days: 3})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.Aborted, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_RegeneratePickupCode(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		fx.mockModule.EXPECT().RegeneratePickupCode(gomock.Any(), int64(1)).Return(nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/regenerate-pickup-code",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.RegeneratePickupCode(ctx, &order.RegeneratePickupCodeRequest{OrderId: 1})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int64(1), resp.GetOrderId())
+		fx.assert.Equal("Pickup code regenerated and sent to the recipient", resp.GetMessage())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.RegeneratePickupCode(ctx, &order.RegeneratePickupCodeRequest{})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Issued Order", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			RegeneratePickupCode(gomock.Any(), int64(1)).
+			Return(fmt.Errorf("module: %w", domain.ErrPickupCodeNotAllowed))
+
+		resp, err := fx.grpcService.RegeneratePickupCode(ctx, &order.RegeneratePickupCodeRequest{OrderId: 1})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("Recipient Without Contacts", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			RegeneratePickupCode(gomock.Any(), int64(1)).
+			Return(fmt.Errorf("module: %w", domain.ErrPickupCodeUndeliverable))
+
+		_, err := fx.grpcService.RegeneratePickupCode(ctx, &order.RegeneratePickupCodeRequest{OrderId: 1})
+
+		fx.assert.Equal(codes.FailedPrecondition, status.Code(err))
+		fx.assert.Contains(status.Convert(err).Message(), "no contact")
+	})
+}
+
+func TestOrderGRPCService_VerifyOrderIntegrity(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		fx.mockModule.EXPECT().
+			VerifyOrderIntegrity(gomock.Any(), []int64{1, 2}).
+			Return(&dto.IntegrityReport{
+				Checked:    2,
+				Violations: []*dto.IntegrityViolation{{OrderID: 2, Status: "tampered"}},
+			}, nil)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/verify-integrity",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		resp, err := fx.grpcService.VerifyOrderIntegrity(ctx, &order.VerifyOrderIntegrityRequest{OrderIds: []int64{1, 2}})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int32(2), resp.GetChecked())
+		fx.assert.Equal("1 of 2 orders failed integrity verification", resp.GetMessage())
+		fx.assert.Len(resp.GetViolations(), 1)
+		fx.assert.Equal("tampered", resp.GetViolations()[0].GetStatus())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.VerifyOrderIntegrity(ctx, &order.VerifyOrderIntegrityRequest{OrderIds: []int64{1, 1}})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().VerifyOrderIntegrity(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+		resp, err := fx.grpcService.VerifyOrderIntegrity(ctx, &order.VerifyOrderIntegrityRequest{})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.Internal, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_CreateRecipient(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		req := &order.CreateRecipientRequest{
+			Id:    2,
+			Name:  "Иван",
+			Phone: "8 999 123-45-67",
+		}
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/recipients/create",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().
+			CreateRecipient(gomock.Any(), &dto.Recipient{ID: 2, Name: "Иван", Phone: "8 999 123-45-67"}).
+			Return(&dto.Recipient{ID: 2, Name: "Иван", Phone: "+79991234567", PreferredChannel: "sms"}, nil)
+
+		resp, err := fx.grpcService.CreateRecipient(ctx, req)
+
+		fx.assert.NoError(err)
+		fx.assert.Equal("Recipient created successfully", resp.GetMessage())
+		fx.assert.Equal("+79991234567", resp.GetRecipient().GetPhone())
+		fx.assert.Equal("sms", resp.GetRecipient().GetPreferredChannel())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.CreateRecipient(ctx, &order.CreateRecipientRequest{Id: 2, Name: "Иван", PreferredChannel: "pigeon"})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Phone Exists", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().CreateRecipient(gomock.Any(), gomock.Any()).Return(nil, module.ErrRecipientPhoneExists)
+
+		resp, err := fx.grpcService.CreateRecipient(ctx, &order.CreateRecipientRequest{Id: 2, Name: "Иван", Phone: "+79991234567"})
+
+		fx.assert.Nil(resp)
+		fx.assert.Equal(codes.AlreadyExists, status.Code(err))
+	})
+	t.Run("Contact Required", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().CreateRecipient(gomock.Any(), gomock.Any()).Return(nil, domain.ErrRecipientContactRequired)
+
+		_, err := fx.grpcService.CreateRecipient(ctx, &order.CreateRecipientRequest{Id: 2, Name: "Иван"})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_ListRecipients(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success with default Limit", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/recipients/list",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().
+			ListRecipients(gomock.Any(), int32(2), defaultOrderLimit).
+			Return([]*dto.Recipient{{ID: 1, Name: "Иван"}, {ID: 2, Name: "Петр"}}, nil)
+
+		resp, err := fx.grpcService.ListRecipients(ctx, &order.ListRecipientsRequest{Page: 2})
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetRecipients(), 2)
+		fx.assert.Equal("Петр", resp.GetRecipients()[1].GetName())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.ListRecipients(ctx, &order.ListRecipientsRequest{Page: 0})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_DeleteRecipient(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/recipients/delete",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().DeleteRecipient(gomock.Any(), int64(2)).Return(nil)
+
+		resp, err := fx.grpcService.DeleteRecipient(ctx, &order.DeleteRecipientRequest{Id: 2})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int64(2), resp.GetId())
+	})
+	t.Run("Has Orders", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().DeleteRecipient(gomock.Any(), int64(2)).Return(module.ErrRecipientHasOrders)
+
+		_, err := fx.grpcService.DeleteRecipient(ctx, &order.DeleteRecipientRequest{Id: 2})
+
+		fx.assert.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().DeleteRecipient(gomock.Any(), int64(2)).Return(module.ErrRecipientNotRegistered)
+
+		_, err := fx.grpcService.DeleteRecipient(ctx, &order.DeleteRecipientRequest{Id: 2})
+
+		fx.assert.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_CreateCell(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/cells/create",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().
+			CreateCell(gomock.Any(), &dto.Cell{Zone: "A", Rack: "1", Shelf: "2", MaxOrders: 10, MaxWeight: 30, PackageTypes: []string{"box"}}).
+			Return(&dto.Cell{ID: 4, Zone: "A", Rack: "1", Shelf: "2", Code: "A-1-2", MaxOrders: 10, MaxWeight: 30}, nil)
+
+		resp, err := fx.grpcService.CreateCell(ctx, &order.CreateCellRequest{
+			Zone: "A", Rack: "1", Shelf: "2", MaxOrders: 10, MaxWeight: 30, PackageTypes: []string{"box"},
+		})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int64(4), resp.GetCell().GetId())
+		fx.assert.Equal("A-1-2", resp.GetCell().GetCode())
+	})
+	t.Run("Invalid Request", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.CreateCell(ctx, &order.CreateCellRequest{Zone: "A", Rack: "1", Shelf: "2", MaxWeight: 30})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Location Taken", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().CreateCell(gomock.Any(), gomock.Any()).Return(nil, module.ErrCellExists)
+
+		_, err := fx.grpcService.CreateCell(ctx, &order.CreateCellRequest{Zone: "A", Rack: "1", Shelf: "2", MaxOrders: 10, MaxWeight: 30})
+
+		fx.assert.Equal(codes.AlreadyExists, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_ListCellOrders(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/cells/orders",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().
+			ListCellOrders(gomock.Any(), int64(2)).
+			Return([]*dto.Order{{OrderID: 7, CellID: 2, Status: "accepted"}}, nil)
+
+		resp, err := fx.grpcService.ListCellOrders(ctx, &order.ListCellOrdersRequest{CellId: 2})
+
+		fx.assert.NoError(err)
+		fx.assert.Len(resp.GetOrders(), 1)
+		fx.assert.Equal(int64(2), resp.GetOrders()[0].GetCellId())
+	})
+	t.Run("Cell Not Found", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().ListCellOrders(gomock.Any(), int64(2)).Return(nil, module.ErrCellNotFound)
+
+		_, err := fx.grpcService.ListCellOrders(ctx, &order.ListCellOrdersRequest{CellId: 2})
+
+		fx.assert.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_MoveOrder(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/cells/move-order",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().
+			MoveOrderToCell(gomock.Any(), int64(7), int64(2)).
+			Return(&dto.Order{OrderID: 7, CellID: 2, CellCode: "A-1-2"}, nil)
+
+		resp, err := fx.grpcService.MoveOrder(ctx, &order.MoveOrderRequest{OrderId: 7, CellId: 2})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal("A-1-2", resp.GetCellCode())
+	})
+	t.Run("Cell Full", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().MoveOrderToCell(gomock.Any(), int64(7), int64(2)).Return(nil, domain.ErrCellFull)
+
+		_, err := fx.grpcService.MoveOrder(ctx, &order.MoveOrderRequest{OrderId: 7, CellId: 2})
+
+		fx.assert.Equal(codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_GetCapacity(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/pickup-point/capacity",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		fx.mockModule.EXPECT().
+			GetCapacity(gomock.Any()).
+			Return(&dto.Capacity{PickupPointID: 1, OrderCount: 10, Weight: 50, MaxOrders: 100}, nil)
+
+		resp, err := fx.grpcService.GetCapacity(ctx, &order.GetCapacityRequest{})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int64(10), resp.GetOrderCount())
+		fx.assert.Equal(int64(100), resp.GetMaxOrders())
+		fx.assert.Zero(resp.GetMaxWeight())
+	})
+	t.Run("Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().GetCapacity(gomock.Any()).Return(nil, assert.AnError)
+
+		_, err := fx.grpcService.GetCapacity(ctx, &order.GetCapacityRequest{})
+
+		fx.assert.Equal(codes.Internal, status.Code(err))
+	})
+}
+
+func TestOrderGRPCService_AcceptOrdersBatch(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	newItem := func(orderID int64) *order.AcceptOrderRequest {
+		return &order.AcceptOrderRequest{
+			OrderId:      orderID,
+			RecipientId:  123,
+			CourierId:    7,
+			StorageUntil: timestamppb.New(time.Now().Add(48 * time.Hour)),
+			Weight:       1.5,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+
+		event := &kafka.EventMessage{
+			Method: "/api/v1/orders/accept-orders-batch",
+		}
+		fx.mockSender.EXPECT().SendMessage(testutils.EventEq(event)).Return(nil)
+
+		invalid := newItem(2)
+		invalid.RecipientId = 0
+
+		fx.mockModule.EXPECT().
+			AcceptOrdersBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request *dto.AcceptBatchRequest) ([]*dto.AcceptResult, error) {
+				fx.assert.Len(request.Orders, 2)
+				fx.assert.Equal(int64(1), request.Orders[0].OrderID)
+				fx.assert.Equal(int64(3), request.Orders[1].OrderID)
+				fx.assert.False(request.Atomic)
+
+				return []*dto.AcceptResult{
+					{OrderID: 1, Status: "accepted", Order: &dto.Order{OrderID: 1, Currency: "RUB"}},
+					{OrderID: 3, Status: "pickup_point_full", Reason: "pickup point capacity exceeded"},
+				}, nil
+			})
+
+		resp, err := fx.grpcService.AcceptOrdersBatch(ctx, &order.AcceptOrdersBatchRequest{
+			Orders: []*order.AcceptOrderRequest{newItem(1), invalid, newItem(3)},
+		})
+
+		fx.assert.NoError(err)
+		fx.assert.Equal(int32(1), resp.GetAcceptedCount())
+		fx.assert.Equal("1 of 3 orders accepted", resp.GetMessage())
+		fx.assert.Len(resp.GetResults(), 3)
+		fx.assert.Equal(int64(1), resp.GetResults()[0].GetOrder().GetOrderId())
+		fx.assert.Equal("invalid", resp.GetResults()[1].GetStatus())
+		fx.assert.Equal(int64(2), resp.GetResults()[1].GetOrderId())
+		fx.assert.Equal("pickup_point_full", resp.GetResults()[2].GetStatus())
+		fx.assert.Nil(resp.GetResults()[2].GetOrder())
+	})
+	t.Run("Atomic Validation Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		invalid := newItem(2)
+		invalid.CourierId = 0
+
+		_, err := fx.grpcService.AcceptOrdersBatch(ctx, &order.AcceptOrdersBatchRequest{
+			Orders: []*order.AcceptOrderRequest{newItem(1), invalid},
+			Atomic: true,
+		})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Atomic Module Error", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		fx.mockModule.EXPECT().
+			AcceptOrdersBatch(gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("module: order 1: %w", module.ErrOrderExists))
+
+		_, err := fx.grpcService.AcceptOrdersBatch(ctx, &order.AcceptOrdersBatchRequest{
+			Orders: []*order.AcceptOrderRequest{newItem(1)},
+			Atomic: true,
+		})
+
+		fx.assert.Equal(codes.AlreadyExists, status.Code(err))
+	})
+	t.Run("Empty Batch", func(t *testing.T) {
+		t.Parallel()
+
+		fx := newFixture(t)
+		fx.mockSender.EXPECT().SendMessage(gomock.Any()).Return(nil)
+
+		_, err := fx.grpcService.AcceptOrdersBatch(ctx, &order.AcceptOrdersBatchRequest{})
+
+		fx.assert.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -114,7 +114,7 @@ func (c *CLI) inputCommands(ctx context.Context) {
 }
 
 // handleCommands направляет команды от пользователя в нужный метод.
-func (c *CLI) handleCommands(ctx context.Context, job Job) (string, error) {
+func (c *CLI) handleCommands(ctx context.Context, job Job) (any, error) {
 	commandName := job.CommandName
 	args := job.Args
 
@@ -124,29 +124,29 @@ func (c *CLI) handleCommands(ctx context.Context, job Job) (string, error) {
 	case exitCommand:
 		c.exit()
 	case workersCommand:
-		return "", c.setWorkerNum(ctx, args)
+		return nil, c.setWorkerNum(ctx, args)
 	default:
 		// orders command
 		resp, err := c.executeCommands(ctx, commandName, args)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		return resp, nil
 
 	}
 
-	return "", nil
+	return nil, nil
 }
 
-func (c *CLI) executeCommands(ctx context.Context, commandName string, args []string) (string, error) {
+func (c *CLI) executeCommands(ctx context.Context, commandName string, args []string) (any, error) {
 	for _, cmd := range c.commandList {
 		if cmd.name == commandName {
 			return cmd.call(ctx, args)
 		}
 	}
 
-	return "", ErrCommandNotFound
+	return nil, ErrCommandNotFound
 }
 
 // handleNotifications обрабатывает нотификации о состоянии выполнения команд.
@@ -9,15 +9,37 @@ import (
 )
 
 const (
-	acceptOrderCourierCommand = "accept-order"
-	returnOrderCourierCommand = "return-order"
-	issueOrderClientCommand   = "issue-order"
-	listOrdersCommand         = "list-orders"
-	acceptReturnClientCommand = "accept-return"
-	returnListCommand         = "return-list"
-	helpCommand               = "help"
-	exitCommand               = "exit"
-	workersCommand            = "workers"
+	acceptOrderCourierCommand    = "accept-order"
+	returnOrderCourierCommand    = "return-order"
+	issueOrderClientCommand      = "issue-order"
+	listOrdersCommand            = "list-orders"
+	acceptReturnClientCommand    = "accept-return"
+	returnListCommand            = "return-list"
+	getOrderCommand              = "get-order"
+	orderHistoryCommand          = "history"
+	courierReturnsCommand        = "courier-returns"
+	returnManifestCommand        = "return-manifest"
+	confirmManifestCommand       = "confirm-manifest"
+	packageTypesCommand          = "package-types"
+	createPackageTypeCommand     = "create-package-type"
+	deactivatePackageTypeCommand = "deactivate-package-type"
+	extendStorageCommand         = "extend-storage"
+	regeneratePickupCodeCommand  = "regenerate-pickup-code"
+	verifyIntegrityCommand       = "verify-integrity"
+	createRecipientCommand       = "create-recipient"
+	getRecipientCommand          = "get-recipient"
+	listRecipientsCommand        = "recipients"
+	updateRecipientCommand       = "update-recipient"
+	deleteRecipientCommand       = "delete-recipient"
+	createCellCommand            = "create-cell"
+	listCellsCommand             = "cells"
+	cellOrdersCommand            = "cell-orders"
+	moveOrderCommand             = "move-order"
+	capacityCommand              = "capacity"
+	acceptOrdersBatchCommand     = "accept-orders-batch"
+	helpCommand                  = "help"
+	exitCommand                  = "exit"
+	workersCommand               = "workers"
 )
 
 var (
@@ -30,7 +52,7 @@ var (
 type command struct {
 	name        string
 	description string
-	call        func(context.Context, []string) (string, error)
+	call        func(context.Context, []string) (any, error)
 }
 
 // initCommandList - описание для команд
@@ -38,34 +60,144 @@ func initCommandList(handler *Handler) []command {
 	return []command{
 		{
 			name:        acceptOrderCourierCommand,
-			description: "Принять заказ от курьера: использование accept-order --order_id=1 --recipient_id=2 --storage_until=26.05.2024",
+			description: "Принять заказ от курьера: использование accept-order --order_id=1 --recipient_id=2 --courier_id=3 --storage_until=26.05.2024 [--package_type=box | --package_layers=box,film] [--cost=199.99 --currency=RUB] [--length=40 --width=30 --height=20] [--category=electronics] [--create_recipient]",
 			call:        handler.acceptOrderCourier,
 		},
 		{
 			name:        returnOrderCourierCommand,
-			description: "Вернуть заказ курьеру: испольщование return-order --order_id=1",
+			description: "Вернуть заказ курьеру: испольщование return-order --order_id=1 --courier_id=3",
 			call:        handler.returnOrderCourier,
 		},
 		{
 			name:        issueOrderClientCommand,
-			description: "Выдать заказ клиенту: использование issue-order --order_ids=1,2,4",
+			description: "Выдать заказ клиенту: использование issue-order --order_ids=1,2,4 --pickup_codes=123456,654321,111222 [--recipient_id=2] [--atomic]",
 			call:        handler.issueOrderClient,
 		},
 		{
 			name:        listOrdersCommand,
-			description: "Получить список заказов: использование list-orders [--limit=10]",
+			description: "Получить список заказов: использование list-orders --recipient_id=2 | --phone=+79991234567 [--limit=10]",
 			call:        handler.listOrders,
 		},
 		{
 			name:        acceptReturnClientCommand,
-			description: "Принять возврат от клиента: использование accept-return --order_id=1 --recipient_id=2",
+			description: "Принять возврат от клиента: использование accept-return --order_id=1 --recipient_id=2 --reason=defective --condition=damaged [--note=scratched --refund=99.90 --currency=RUB]",
 			call:        handler.acceptReturnClient,
 		},
 		{
 			name:        returnListCommand,
-			description: "Получить список возвратов: использование return-list --page=1 [--limit=10]",
+			description: "Получить список возвратов: использование return-list --page=1 [--limit=10 --condition=damaged]",
 			call:        handler.returnList,
 		},
+		{
+			name:        getOrderCommand,
+			description: "Получить полную запись заказа: использование get-order --order_id=1",
+			call:        handler.getOrder,
+		},
+		{
+			name:        orderHistoryCommand,
+			description: "Получить историю изменений заказа: использование history --order_id=1",
+			call:        handler.orderHistory,
+		},
+		{
+			name:        courierReturnsCommand,
+			description: "Получить заказы, возвращенные курьерам: использование courier-returns --from=01.07.2024 --to=08.07.2024",
+			call:        handler.courierReturns,
+		},
+		{
+			name:        returnManifestCommand,
+			description: "Сформировать список возврата курьеру: использование return-manifest --courier_id=3",
+			call:        handler.returnManifest,
+		},
+		{
+			name:        confirmManifestCommand,
+			description: "Подтвердить передачу списка возврата курьеру: использование confirm-manifest --courier_id=3 --order_ids=1,2,4",
+			call:        handler.confirmManifest,
+		},
+		{
+			name:        packageTypesCommand,
+			description: "Получить список типов упаковки: использование package-types",
+			call:        handler.packageTypes,
+		},
+		{
+			name:        createPackageTypeCommand,
+			description: "Добавить тип упаковки: использование create-package-type --name=crate --max_weight=50 --cost=40 [--currency=RUB --max_length=100 --max_width=60 --max_height=60 --volumetric_divisor=5000]",
+			call:        handler.createPackageType,
+		},
+		{
+			name:        deactivatePackageTypeCommand,
+			description: "Деактивировать тип упаковки: использование deactivate-package-type --name=crate",
+			call:        handler.deactivatePackageType,
+		},
+		{
+			name:        extendStorageCommand,
+			description: "Продлить срок хранения заказа: использование extend-storage --order_id=1 --days=3",
+			call:        handler.extendStorage,
+		},
+		{
+			name:        regeneratePickupCodeCommand,
+			description: "Выпустить новый код выдачи заказа и отправить его получателю: использование regenerate-pickup-code --order_id=1",
+			call:        handler.regeneratePickupCode,
+		},
+		{
+			name:        verifyIntegrityCommand,
+			description: "Проверить целостность заказов в БД: использование verify-integrity [--order_ids=1,2,4]",
+			call:        handler.verifyIntegrity,
+		},
+		{
+			name:        createRecipientCommand,
+			description: "Зарегистрировать получателя: использование create-recipient --id=2 --name=Иван --phone=+79991234567 [--email=ivan@example.com --channel=email]",
+			call:        handler.createRecipient,
+		},
+		{
+			name:        getRecipientCommand,
+			description: "Получить контакты получателя: использование get-recipient --id=2",
+			call:        handler.getRecipient,
+		},
+		{
+			name:        listRecipientsCommand,
+			description: "Получить список получателей: использование recipients [--page=1 --limit=10]",
+			call:        handler.listRecipients,
+		},
+		{
+			name:        updateRecipientCommand,
+			description: "Заменить контакты получателя: использование update-recipient --id=2 --name=Иван --phone=+79991234567 [--email=ivan@example.com --channel=email]",
+			call:        handler.updateRecipient,
+		},
+		{
+			name:        deleteRecipientCommand,
+			description: "Удалить получателя без заказов: использование delete-recipient --id=2",
+			call:        handler.deleteRecipient,
+		},
+		{
+			name:        createCellCommand,
+			description: "Добавить ячейку хранения: использование create-cell --zone=A --rack=1 --shelf=2 --max_orders=10 --max_weight=30 [--package_types=box,film]",
+			call:        handler.createCell,
+		},
+		{
+			name:        listCellsCommand,
+			description: "Получить список ячеек хранения и их заполненность: использование cells",
+			call:        handler.listCells,
+		},
+		{
+			name:        cellOrdersCommand,
+			description: "Получить заказы в ячейке: использование cell-orders --cell_id=1",
+			call:        handler.listCellOrders,
+		},
+		{
+			name:        moveOrderCommand,
+			description: "Переложить заказ в другую ячейку: использование move-order --order_id=1 --cell_id=2",
+			call:        handler.moveOrder,
+		},
+		{
+			name:        capacityCommand,
+			description: "Получить заполненность ПВЗ и ограничения вместимости: использование capacity",
+			call:        handler.getCapacity,
+		},
+		{
+			name:        acceptOrdersBatchCommand,
+			description: "Принять партию заказов от курьера из CSV файла: использование accept-orders-batch --file=orders.csv [--atomic]",
+			call:        handler.acceptOrdersBatch,
+		},
 		{
 			name:        helpCommand,
 			description: "Получить справку",
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/date"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/money"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// requiredOrderColumns колонки CSV, без которых заказ нельзя принять
+var requiredOrderColumns = []string{"order_id", "recipient_id", "courier_id", "storage_until"}
+
+// readAcceptOrders читает заказы для приемки из CSV с заголовком.
+// Колонки называются так же, как параметры accept-order, storage_until задается в формате ДД.ММ.ГГГГ;
+// слои упаковки перечисляются через запятую в кавычках.
+func readAcceptOrders(r io.Reader) ([]*order.AcceptOrderRequest, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("csv file is empty")
+		}
+
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	for _, name := range requiredOrderColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv column %s is required", name)
+		}
+	}
+
+	var orders []*order.AcceptOrderRequest
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := csvRow{columns: columns, record: record}
+
+		acceptOrder, err := row.acceptOrder()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		orders = append(orders, acceptOrder)
+	}
+
+	return orders, nil
+}
+
+// csvRow строка CSV с доступом к значениям по названию колонки
+type csvRow struct {
+	columns map[string]int
+	record  []string
+}
+
+// acceptOrder преобразует строку CSV в запрос на приемку заказа
+func (r csvRow) acceptOrder() (*order.AcceptOrderRequest, error) {
+	var (
+		errs                                []error
+		orderID, recipientID, courierID     int64
+		weight, cost, length, width, height float64
+		createRecipient                     bool
+	)
+
+	parseInt := func(name string, value *int64) {
+		if s := r.get(name); s != "" {
+			var err error
+			if *value, err = strconv.ParseInt(s, 10, 64); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s: %w", name, err))
+			}
+		}
+	}
+	parseFloat := func(name string, value *float64) {
+		if s := r.get(name); s != "" {
+			var err error
+			if *value, err = strconv.ParseFloat(s, 64); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s: %w", name, err))
+			}
+		}
+	}
+
+	parseInt("order_id", &orderID)
+	parseInt("recipient_id", &recipientID)
+	parseInt("courier_id", &courierID)
+	parseFloat("weight", &weight)
+	parseFloat("cost", &cost)
+	parseFloat("length", &length)
+	parseFloat("width", &width)
+	parseFloat("height", &height)
+
+	if s := r.get("create_recipient"); s != "" {
+		var err error
+		if createRecipient, err = strconv.ParseBool(s); err != nil {
+			errs = append(errs, fmt.Errorf("invalid create_recipient: %w", err))
+		}
+	}
+
+	storageUntil, err := date.ParseDateToUTC(r.get("storage_until"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid storage_until: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	var packageLayers []string
+	if s := r.get("package_layers"); s != "" {
+		packageLayers = strings.Split(s, ",")
+	}
+
+	packageType := r.get("package_type")
+
+	currency := r.get("currency")
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+
+	return &order.AcceptOrderRequest{
+		OrderId:       orderID,
+		RecipientId:   recipientID,
+		CourierId:     courierID,
+		StorageUntil:  timestamppb.New(storageUntil),
+		PackageType:   &packageType,
+		PackageLayers: packageLayers,
+		Weight:        weight,
+		Length:        length,
+		Width:         width,
+		Height:        height,
+		OrderCost: &order.Money{
+			Amount:   money.FromMajor(cost),
+			Currency: currency,
+		},
+		Category:        r.get("category"),
+		CreateRecipient: createRecipient,
+	}, nil
+}
+
+// get возвращает значение колонки name или пустую строку, если колонки нет
+func (r csvRow) get(name string) string {
+	i, ok := r.columns[name]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+
+	return strings.TrimSpace(r.record[i])
+}
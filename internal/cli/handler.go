@@ -5,22 +5,48 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/date"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/money"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Module interface {
-	AcceptOrderCourier(ctx context.Context, order *dto.Order) error
-	ReturnOrderCourier(ctx context.Context, orderID int64) error
-	IssueOrderClient(ctx context.Context, orderIDs []int64) error
+	AcceptOrderCourier(ctx context.Context, order *dto.Order) (*dto.Order, error)
+	ReturnOrderCourier(ctx context.Context, orderID, courierID int64) error
+	IssueOrderClient(ctx context.Context, request *dto.IssueRequest) ([]*dto.IssueResult, error)
 	AcceptReturnClient(ctx context.Context, order *dto.Order) error
 	ListOrders(ctx context.Context, recipientID int64, limit int32) ([]*dto.Order, error)
-	ListReturnOrders(ctx context.Context, page, limit int32) ([]*dto.Order, error)
+	ListReturnOrders(ctx context.Context, page, limit int32, condition string) ([]*dto.Order, error)
+	GetOrder(ctx context.Context, orderID int64) (*dto.Order, error)
+	GetOrderHistory(ctx context.Context, orderID int64) ([]*dto.OrderEvent, error)
+	ListCourierReturns(ctx context.Context, from, to time.Time) ([]*dto.Order, error)
+	GetReturnManifest(ctx context.Context, courierID int64) ([]*dto.Order, error)
+	ConfirmReturnManifest(ctx context.Context, courierID int64, orderIDs []int64) error
+	ListPackageTypes(ctx context.Context) ([]*dto.PackageType, error)
+	CreatePackageType(ctx context.Context, packageType *dto.PackageType) (*dto.PackageType, error)
+	DeactivatePackageType(ctx context.Context, name string) error
+	ExtendStorage(ctx context.Context, orderID int64, days int) (*dto.Order, error)
+	RegeneratePickupCode(ctx context.Context, orderID int64) error
+	VerifyOrderIntegrity(ctx context.Context, orderIDs []int64) (*dto.IntegrityReport, error)
+	ListOrdersByPhone(ctx context.Context, phone string, limit int32) ([]*dto.Order, error)
+	CreateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error)
+	GetRecipient(ctx context.Context, id int64) (*dto.Recipient, error)
+	ListRecipients(ctx context.Context, page, limit int32) ([]*dto.Recipient, error)
+	UpdateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error)
+	DeleteRecipient(ctx context.Context, id int64) error
+	CreateCell(ctx context.Context, cell *dto.Cell) (*dto.Cell, error)
+	ListCells(ctx context.Context) ([]*dto.Cell, error)
+	ListCellOrders(ctx context.Context, cellID int64) ([]*dto.Order, error)
+	MoveOrderToCell(ctx context.Context, orderID, cellID int64) (*dto.Order, error)
+	GetCapacity(ctx context.Context) (*dto.Capacity, error)
+	AcceptOrdersBatch(ctx context.Context, request *dto.AcceptBatchRequest) ([]*dto.AcceptResult, error)
 }
 
 type Handler struct {
@@ -36,18 +62,28 @@ func NewHandler(client order.OrderClient) *Handler {
 // acceptOrderCourier - парсит параметры из командной строк и принимает заказ от клиента
 func (h Handler) acceptOrderCourier(ctx context.Context, args []string) (any, error) {
 	var (
-		orderID, recipientID            int64
-		storageUntilStr, packageTypeStr string
-		weight, cost                    float64
+		orderID, recipientID, courierID                   int64
+		storageUntilStr, packageTypeStr, packageLayersStr string
+		currency, category                                string
+		weight, cost, length, width, height               float64
+		createRecipient                                   bool
 	)
 
 	fs := flag.NewFlagSet(acceptOrderCourierCommand, flag.ContinueOnError)
 	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
 	fs.Int64Var(&recipientID, "recipient_id", -1, "ID of the recipient")
+	fs.Int64Var(&courierID, "courier_id", -1, "ID of the courier")
 	fs.StringVar(&storageUntilStr, "storage_until", "", "Storage until date (YYYY-MM-DD)")
-	fs.StringVar(&packageTypeStr, "package_type", "", "type: film, package or box")
+	fs.StringVar(&packageTypeStr, "package_type", "", "type from the package types registry, see package-types")
+	fs.StringVar(&packageLayersStr, "package_layers", "", "package layers in wrapping order, e.g. box,film")
 	fs.Float64Var(&weight, "weight", 0, "weight of the order")
-	fs.Float64Var(&cost, "cost", 0, "cost of the order")
+	fs.Float64Var(&cost, "cost", 0, "cost of the order in major units, e.g. 199.99")
+	fs.StringVar(&currency, "currency", money.DefaultCurrency, "ISO 4217 currency code of the cost")
+	fs.Float64Var(&length, "length", 0, "length of the parcel, cm")
+	fs.Float64Var(&width, "width", 0, "width of the parcel, cm")
+	fs.Float64Var(&height, "height", 0, "height of the parcel, cm")
+	fs.StringVar(&category, "category", "", "product category tag that selects the return window, e.g. electronics")
+	fs.BoolVar(&createRecipient, "create_recipient", false, "register the recipient without contacts if it is not registered")
 
 	if err := fs.Parse(args); err != nil {
 		return "", err
@@ -58,13 +94,28 @@ func (h Handler) acceptOrderCourier(ctx context.Context, args []string) (any, er
 		return "", fmt.Errorf("can not to parse storageUntil: %w ", err)
 	}
 
+	var packageLayers []string
+	if packageLayersStr != "" {
+		packageLayers = strings.Split(packageLayersStr, ",")
+	}
+
 	resp, err := h.client.AcceptOrderFromCourier(ctx, &order.AcceptOrderRequest{
-		OrderId:      orderID,
-		RecipientId:  recipientID,
-		StorageUntil: timestamppb.New(storageUntil),
-		PackageType:  &packageTypeStr,
-		Weight:       weight,
-		Cost:         cost,
+		OrderId:       orderID,
+		RecipientId:   recipientID,
+		CourierId:     courierID,
+		StorageUntil:  timestamppb.New(storageUntil),
+		PackageType:   &packageTypeStr,
+		PackageLayers: packageLayers,
+		Weight:        weight,
+		Length:        length,
+		Width:         width,
+		Height:        height,
+		OrderCost: &order.Money{
+			Amount:   money.FromMajor(cost),
+			Currency: currency,
+		},
+		Category:        category,
+		CreateRecipient: createRecipient,
 	})
 
 	if err != nil {
@@ -74,19 +125,62 @@ func (h Handler) acceptOrderCourier(ctx context.Context, args []string) (any, er
 	return resp, nil
 }
 
+// acceptOrdersBatch - читает заказы из CSV файла и принимает их от курьера одной партией
+func (h Handler) acceptOrdersBatch(ctx context.Context, args []string) (any, error) {
+	var (
+		path   string
+		atomic bool
+	)
+
+	fs := flag.NewFlagSet(acceptOrdersBatchCommand, flag.ContinueOnError)
+	fs.StringVar(&path, "file", "", "path to the CSV file with a header, columns are named as accept-order flags")
+	fs.BoolVar(&atomic, "atomic", false, "accept all orders or none of them")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	if path == "" {
+		return "", errors.New("file is required")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("can not open orders file: %w", err)
+	}
+	defer file.Close()
+
+	orders, err := readAcceptOrders(file)
+	if err != nil {
+		return "", fmt.Errorf("can not read orders file: %w", err)
+	}
+
+	resp, err := h.client.AcceptOrdersBatch(ctx, &order.AcceptOrdersBatchRequest{
+		Orders: orders,
+		Atomic: atomic,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // returnOrderCourier - парсит параметры из командной строк и возвращает заказ курьеру
 func (h Handler) returnOrderCourier(ctx context.Context, args []string) (any, error) {
-	var orderID int64
+	var orderID, courierID int64
 
 	fs := flag.NewFlagSet(returnOrderCourierCommand, flag.ContinueOnError)
 	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
+	fs.Int64Var(&courierID, "courier_id", -1, "ID of the courier")
 
 	if err := fs.Parse(args); err != nil {
 		return "", err
 	}
 
 	resp, err := h.client.ReturnOrderToCourier(ctx, &order.ReturnOrderRequest{
-		OrderId: orderID,
+		OrderId:   orderID,
+		CourierId: courierID,
 	})
 
 	if err != nil {
@@ -100,10 +194,18 @@ func (h Handler) returnOrderCourier(ctx context.Context, args []string) (any, er
 
 // issueOrderClient - парсит параметры из командной строк и выдает заказ клиенту
 func (h Handler) issueOrderClient(ctx context.Context, args []string) (any, error) {
-	var ordersIDStr string
+	var (
+		ordersIDStr    string
+		pickupCodesStr string
+		recipientID    int64
+		atomic         bool
+	)
 
 	fs := flag.NewFlagSet(issueOrderClientCommand, flag.ContinueOnError)
 	fs.StringVar(&ordersIDStr, "order_ids", "", "ID of the order")
+	fs.StringVar(&pickupCodesStr, "pickup_codes", "", "pickup codes in the same order as order_ids")
+	fs.Int64Var(&recipientID, "recipient_id", 0, "ID of the recipient, defaults to the recipient of the first order")
+	fs.BoolVar(&atomic, "atomic", false, "issue all orders or none of them")
 
 	if err := fs.Parse(args); err != nil {
 		return "", err
@@ -114,7 +216,7 @@ func (h Handler) issueOrderClient(ctx context.Context, args []string) (any, erro
 		return "", errors.New("order_ids is empty")
 	}
 
-	ordersIDs := make([]int64, len(orderIDListStr))
+	ordersIDs := make([]int64, 0, len(orderIDListStr))
 
 	for _, id := range orderIDListStr {
 		orderID, err := strconv.ParseInt(id, 10, 64)
@@ -125,8 +227,21 @@ func (h Handler) issueOrderClient(ctx context.Context, args []string) (any, erro
 		ordersIDs = append(ordersIDs, orderID)
 	}
 
+	pickupCodeListStr := strings.Split(pickupCodesStr, ",")
+	if len(pickupCodeListStr) != len(ordersIDs) {
+		return "", errors.New("pickup_codes must contain a code for every order")
+	}
+
+	pickupCodes := make(map[int64]string, len(ordersIDs))
+	for i, orderID := range ordersIDs {
+		pickupCodes[orderID] = strings.TrimSpace(pickupCodeListStr[i])
+	}
+
 	resp, err := h.client.IssueOrderToClient(ctx, &order.IssueOrderRequest{
-		OrderIds: ordersIDs,
+		OrderIds:    ordersIDs,
+		RecipientId: recipientID,
+		Atomic:      atomic,
+		PickupCodes: pickupCodes,
 	})
 	if err != nil {
 		return nil, err
@@ -141,20 +256,29 @@ func (h Handler) issueOrderClient(ctx context.Context, args []string) (any, erro
 func (h Handler) listOrders(ctx context.Context, args []string) (any, error) {
 	var (
 		recipientID int64
+		phone       string
 		limit       int
 	)
 
 	fs := flag.NewFlagSet(listOrdersCommand, flag.ContinueOnError)
 	fs.Int64Var(&recipientID, "recipient_id", -1, "ID of the recipient")
+	fs.StringVar(&phone, "phone", "", "phone of the recipient, used instead of recipient_id")
 	fs.IntVar(&limit, "limit", 10, "count of list orders")
 
 	if err := fs.Parse(args); err != nil {
 		return "", err
 	}
 
-	resp, err := h.client.ListOrders(ctx, &order.ListOrdersRequest{
-		RecipientId: recipientID,
-	})
+	limit32 := int32(limit)
+	req := &order.ListOrdersRequest{
+		Recipient: &order.ListOrdersRequest_RecipientId{RecipientId: recipientID},
+		Limit:     &limit32,
+	}
+	if phone != "" {
+		req.Recipient = &order.ListOrdersRequest_Phone{Phone: phone}
+	}
+
+	resp, err := h.client.ListOrders(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -164,20 +288,44 @@ func (h Handler) listOrders(ctx context.Context, args []string) (any, error) {
 
 // acceptReturnClient - парсит параметры из командной строки и принимает возврат товара от клиента
 func (h Handler) acceptReturnClient(ctx context.Context, args []string) (any, error) {
-	var orderID, recipientID int64
+	var (
+		orderID, recipientID              int64
+		reason, condition, note, currency string
+		refund                            float64
+	)
 
 	fs := flag.NewFlagSet(acceptReturnClientCommand, flag.ContinueOnError)
 	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
 	fs.Int64Var(&recipientID, "recipient_id", -1, "ID of the recipient")
+	fs.StringVar(&reason, "reason", "", "return reason code")
+	fs.StringVar(&condition, "condition", "", "condition of the order: intact, damaged or opened")
+	fs.StringVar(&note, "note", "", "free-text note about the return")
+	fs.Float64Var(&refund, "refund", 0, "refund amount in major units, e.g. 99.90")
+	fs.StringVar(&currency, "currency", money.DefaultCurrency, "ISO 4217 currency code of the refund")
 
 	if err := fs.Parse(args); err != nil {
 		return "", err
 	}
 
-	resp, err := h.client.AcceptReturnFromClient(ctx, &order.AcceptReturnRequest{
+	req := &order.AcceptReturnRequest{
 		OrderId:     orderID,
 		RecipientId: recipientID,
+		Reason:      reason,
+		Condition:   condition,
+		Note:        note,
+	}
+
+	// сумма возврата передается, только если флаг указан явно
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "refund" {
+			req.Refund = &order.Money{
+				Amount:   money.FromMajor(refund),
+				Currency: currency,
+			}
+		}
 	})
+
+	resp, err := h.client.AcceptReturnFromClient(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -190,20 +338,481 @@ func (h Handler) acceptReturnClient(ctx context.Context, args []string) (any, er
 // returnList - парсит параметры из командной строки и отображает список возврато
 func (h Handler) returnList(ctx context.Context, args []string) (any, error) {
 	var (
-		page  int
-		limit int
+		page      int
+		limit     int
+		condition string
 	)
 
 	fs := flag.NewFlagSet(returnListCommand, flag.ContinueOnError)
 	fs.IntVar(&page, "page", -1, "number of page")
 	fs.IntVar(&limit, "limit", 10, "count of returns")
+	fs.StringVar(&condition, "condition", "", "show only returns in this condition, e.g. damaged")
 
 	if err := fs.Parse(args); err != nil {
 		return "", err
 	}
 
+	pageLimit := int32(limit)
+
 	resp, err := h.client.ReturnList(ctx, &order.ReturnListRequest{
-		Page: int32(page),
+		Page:      int32(page),
+		Limit:     &pageLimit,
+		Condition: condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// getOrder - парсит параметры из командной строки и отображает полную запись заказа
+func (h Handler) getOrder(ctx context.Context, args []string) (any, error) {
+	var orderID int64
+
+	fs := flag.NewFlagSet(getOrderCommand, flag.ContinueOnError)
+	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.GetOrder(ctx, &order.GetOrderRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// orderHistory - парсит параметры из командной строки и отображает историю изменений заказа
+func (h Handler) orderHistory(ctx context.Context, args []string) (any, error) {
+	var orderID int64
+
+	fs := flag.NewFlagSet(orderHistoryCommand, flag.ContinueOnError)
+	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.GetOrderHistory(ctx, &order.GetOrderHistoryRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// courierReturns - парсит параметры из командной строки и отображает заказы, возвращенные курьерам за период
+func (h Handler) courierReturns(ctx context.Context, args []string) (any, error) {
+	var fromStr, toStr string
+
+	fs := flag.NewFlagSet(courierReturnsCommand, flag.ContinueOnError)
+	fs.StringVar(&fromStr, "from", "", "Start of the period (DD.MM.YYYY)")
+	fs.StringVar(&toStr, "to", "", "End of the period, exclusive (DD.MM.YYYY)")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	from, err := date.ParseDateToUTC(fromStr)
+	if err != nil {
+		return "", fmt.Errorf("can not to parse from: %w ", err)
+	}
+
+	to, err := date.ParseDateToUTC(toStr)
+	if err != nil {
+		return "", fmt.Errorf("can not to parse to: %w ", err)
+	}
+
+	resp, err := h.client.ListCourierReturns(ctx, &order.ListCourierReturnsRequest{
+		From: timestamppb.New(from),
+		To:   timestamppb.New(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// returnManifest - парсит параметры из командной строки и отображает список возврата курьеру
+func (h Handler) returnManifest(ctx context.Context, args []string) (any, error) {
+	var courierID int64
+
+	fs := flag.NewFlagSet(returnManifestCommand, flag.ContinueOnError)
+	fs.Int64Var(&courierID, "courier_id", -1, "ID of the courier")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.GetReturnManifest(ctx, &order.GetReturnManifestRequest{
+		CourierId: courierID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// confirmManifest - парсит параметры из командной строки и подтверждает передачу списка возврата курьеру
+func (h Handler) confirmManifest(ctx context.Context, args []string) (any, error) {
+	var (
+		courierID    int64
+		ordersIDsStr string
+	)
+
+	fs := flag.NewFlagSet(confirmManifestCommand, flag.ContinueOnError)
+	fs.Int64Var(&courierID, "courier_id", -1, "ID of the courier")
+	fs.StringVar(&ordersIDsStr, "order_ids", "", "IDs of the orders from the manifest")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	orderIDListStr := strings.Split(ordersIDsStr, ",")
+
+	orderIDs := make([]int64, 0, len(orderIDListStr))
+
+	for _, id := range orderIDListStr {
+		orderID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return "", err
+		}
+
+		orderIDs = append(orderIDs, orderID)
+	}
+
+	resp, err := h.client.ConfirmReturnManifest(ctx, &order.ConfirmReturnManifestRequest{
+		CourierId: courierID,
+		OrderIds:  orderIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// packageTypes - отображает все типы упаковки, включая деактивированные
+func (h Handler) packageTypes(ctx context.Context, _ []string) (any, error) {
+	resp, err := h.client.ListPackageTypes(ctx, &order.ListPackageTypesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// createPackageType - парсит параметры из командной строки и добавляет тип упаковки
+func (h Handler) createPackageType(ctx context.Context, args []string) (any, error) {
+	var (
+		name, currency                         string
+		maxWeight, cost                        float64
+		maxLength, maxWidth, maxHeight, volDiv float64
+	)
+
+	fs := flag.NewFlagSet(createPackageTypeCommand, flag.ContinueOnError)
+	fs.StringVar(&name, "name", "", "name of the package type")
+	fs.Float64Var(&maxWeight, "max_weight", 0, "max weight of the order, 0 means no limit")
+	fs.Float64Var(&cost, "cost", 0, "cost of the package in major units, e.g. 19.90")
+	fs.StringVar(&currency, "currency", money.DefaultCurrency, "ISO 4217 currency code of the cost")
+	fs.Float64Var(&maxLength, "max_length", 0, "max length of the parcel in cm, 0 means no limit")
+	fs.Float64Var(&maxWidth, "max_width", 0, "max width of the parcel in cm, 0 means no limit")
+	fs.Float64Var(&maxHeight, "max_height", 0, "max height of the parcel in cm, 0 means no limit")
+	fs.Float64Var(&volDiv, "volumetric_divisor", 0, "divisor for volumetric weight, 0 disables the rule")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.CreatePackageType(ctx, &order.CreatePackageTypeRequest{
+		Name:              name,
+		MaxWeight:         maxWeight,
+		MaxLength:         maxLength,
+		MaxWidth:          maxWidth,
+		MaxHeight:         maxHeight,
+		VolumetricDivisor: volDiv,
+		Cost: &order.Money{
+			Amount:   money.FromMajor(cost),
+			Currency: currency,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// deactivatePackageType - парсит параметры из командной строки и деактивирует тип упаковки
+func (h Handler) deactivatePackageType(ctx context.Context, args []string) (any, error) {
+	var name string
+
+	fs := flag.NewFlagSet(deactivatePackageTypeCommand, flag.ContinueOnError)
+	fs.StringVar(&name, "name", "", "name of the package type")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.DeactivatePackageType(ctx, &order.DeactivatePackageTypeRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// extendStorage - парсит параметры из командной строки и продлевает срок хранения заказа
+func (h Handler) extendStorage(ctx context.Context, args []string) (any, error) {
+	var (
+		orderID int64
+		days    int
+	)
+
+	fs := flag.NewFlagSet(extendStorageCommand, flag.ContinueOnError)
+	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
+	fs.IntVar(&days, "days", 0, "number of days to extend the storage by")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.ExtendStorage(ctx, &order.ExtendStorageRequest{
+		OrderId: orderID,
+		Days:    int32(days),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// regeneratePickupCode - парсит параметры из командной строки и выпускает новый код выдачи, который отправляется получателю
+func (h Handler) regeneratePickupCode(ctx context.Context, args []string) (any, error) {
+	var orderID int64
+
+	fs := flag.NewFlagSet(regeneratePickupCodeCommand, flag.ContinueOnError)
+	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.RegeneratePickupCode(ctx, &order.RegeneratePickupCodeRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// verifyIntegrity - парсит параметры из командной строки и проверяет целостность заказов
+func (h Handler) verifyIntegrity(ctx context.Context, args []string) (any, error) {
+	var ordersIDStr string
+
+	fs := flag.NewFlagSet(verifyIntegrityCommand, flag.ContinueOnError)
+	fs.StringVar(&ordersIDStr, "order_ids", "", "IDs of the orders, all orders if empty")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	var ordersIDs []int64
+
+	if ordersIDStr != "" {
+		for _, id := range strings.Split(ordersIDStr, ",") {
+			orderID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				return "", err
+			}
+
+			ordersIDs = append(ordersIDs, orderID)
+		}
+	}
+
+	resp, err := h.client.VerifyOrderIntegrity(ctx, &order.VerifyOrderIntegrityRequest{
+		OrderIds: ordersIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// createRecipient - парсит параметры из командной строки и регистрирует получателя
+func (h Handler) createRecipient(ctx context.Context, args []string) (any, error) {
+	var (
+		id                            int64
+		name, phone, email, preferred string
+	)
+
+	fs := flag.NewFlagSet(createRecipientCommand, flag.ContinueOnError)
+	fs.Int64Var(&id, "id", -1, "ID of the recipient")
+	fs.StringVar(&name, "name", "", "name of the recipient")
+	fs.StringVar(&phone, "phone", "", "phone of the recipient, e.g. +79991234567")
+	fs.StringVar(&email, "email", "", "email of the recipient")
+	fs.StringVar(&preferred, "channel", "", "preferred notification channel: sms or email")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.CreateRecipient(ctx, &order.CreateRecipientRequest{
+		Id:               id,
+		Name:             name,
+		Phone:            phone,
+		Email:            email,
+		PreferredChannel: preferred,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// getRecipient - парсит параметры из командной строки и отображает получателя
+func (h Handler) getRecipient(ctx context.Context, args []string) (any, error) {
+	var id int64
+
+	fs := flag.NewFlagSet(getRecipientCommand, flag.ContinueOnError)
+	fs.Int64Var(&id, "id", -1, "ID of the recipient")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.GetRecipient(ctx, &order.GetRecipientRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// listRecipients - парсит параметры из командной строки и отображает список получателей
+func (h Handler) listRecipients(ctx context.Context, args []string) (any, error) {
+	var page, limit int
+
+	fs := flag.NewFlagSet(listRecipientsCommand, flag.ContinueOnError)
+	fs.IntVar(&page, "page", 1, "page number")
+	fs.IntVar(&limit, "limit", 10, "count of recipients on the page")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	limit32 := int32(limit)
+
+	resp, err := h.client.ListRecipients(ctx, &order.ListRecipientsRequest{
+		Page:  int32(page),
+		Limit: &limit32,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// updateRecipient - парсит параметры из командной строки и заменяет контакты получателя
+func (h Handler) updateRecipient(ctx context.Context, args []string) (any, error) {
+	var (
+		id                            int64
+		name, phone, email, preferred string
+	)
+
+	fs := flag.NewFlagSet(updateRecipientCommand, flag.ContinueOnError)
+	fs.Int64Var(&id, "id", -1, "ID of the recipient")
+	fs.StringVar(&name, "name", "", "name of the recipient")
+	fs.StringVar(&phone, "phone", "", "phone of the recipient, e.g. +79991234567")
+	fs.StringVar(&email, "email", "", "email of the recipient")
+	fs.StringVar(&preferred, "channel", "", "preferred notification channel: sms or email")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.UpdateRecipient(ctx, &order.UpdateRecipientRequest{
+		Id:               id,
+		Name:             name,
+		Phone:            phone,
+		Email:            email,
+		PreferredChannel: preferred,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// deleteRecipient - парсит параметры из командной строки и удаляет получателя
+func (h Handler) deleteRecipient(ctx context.Context, args []string) (any, error) {
+	var id int64
+
+	fs := flag.NewFlagSet(deleteRecipientCommand, flag.ContinueOnError)
+	fs.Int64Var(&id, "id", -1, "ID of the recipient")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.DeleteRecipient(ctx, &order.DeleteRecipientRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// createCell - парсит параметры из командной строки и добавляет ячейку хранения
+func (h Handler) createCell(ctx context.Context, args []string) (any, error) {
+	var (
+		zone, rack, shelf, packageTypesStr string
+		maxOrders                          int
+		maxWeight                          float64
+	)
+
+	fs := flag.NewFlagSet(createCellCommand, flag.ContinueOnError)
+	fs.StringVar(&zone, "zone", "", "storage zone of the cell")
+	fs.StringVar(&rack, "rack", "", "rack of the cell")
+	fs.StringVar(&shelf, "shelf", "", "shelf of the cell")
+	fs.IntVar(&maxOrders, "max_orders", 0, "maximum number of orders in the cell")
+	fs.Float64Var(&maxWeight, "max_weight", 0, "maximum total weight of orders in the cell")
+	fs.StringVar(&packageTypesStr, "package_types", "", "package types the cell is meant for, e.g. box,film; any if empty")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	var packageTypes []string
+	if packageTypesStr != "" {
+		packageTypes = strings.Split(packageTypesStr, ",")
+	}
+
+	resp, err := h.client.CreateCell(ctx, &order.CreateCellRequest{
+		Zone:         zone,
+		Rack:         rack,
+		Shelf:        shelf,
+		MaxOrders:    int32(maxOrders),
+		MaxWeight:    maxWeight,
+		PackageTypes: packageTypes,
 	})
 	if err != nil {
 		return nil, err
@@ -211,3 +820,62 @@ func (h Handler) returnList(ctx context.Context, args []string) (any, error) {
 
 	return resp, nil
 }
+
+// listCells - отображает ячейки хранения ПВЗ и их заполненность
+func (h Handler) listCells(ctx context.Context, _ []string) (any, error) {
+	resp, err := h.client.ListCells(ctx, &order.ListCellsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// listCellOrders - парсит параметры из командной строки и отображает заказы в ячейке
+func (h Handler) listCellOrders(ctx context.Context, args []string) (any, error) {
+	var cellID int64
+
+	fs := flag.NewFlagSet(cellOrdersCommand, flag.ContinueOnError)
+	fs.Int64Var(&cellID, "cell_id", -1, "ID of the cell")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.ListCellOrders(ctx, &order.ListCellOrdersRequest{CellId: cellID})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// moveOrder - парсит параметры из командной строки и перекладывает заказ в другую ячейку
+func (h Handler) moveOrder(ctx context.Context, args []string) (any, error) {
+	var orderID, cellID int64
+
+	fs := flag.NewFlagSet(moveOrderCommand, flag.ContinueOnError)
+	fs.Int64Var(&orderID, "order_id", -1, "ID of the order")
+	fs.Int64Var(&cellID, "cell_id", -1, "ID of the target cell")
+
+	if err := fs.Parse(args); err != nil {
+		return "", err
+	}
+
+	resp, err := h.client.MoveOrder(ctx, &order.MoveOrderRequest{OrderId: orderID, CellId: cellID})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// getCapacity - отображает текущую заполненность ПВЗ и ограничения вместимости
+func (h Handler) getCapacity(ctx context.Context, _ []string) (any, error) {
+	resp, err := h.client.GetCapacity(ctx, &order.GetCapacityRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
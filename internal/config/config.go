@@ -8,17 +8,29 @@ import (
 
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
 )
 
 type Config struct {
-	Name           string      `yaml:"name"`
-	DB             DBConfig    `yaml:"db"`
-	Kafka          KafkaConfig `yaml:"kafka"`
-	CacheConfig    CacheConfig `yaml:"cache"`
-	OutputSource   string      `yaml:"output_source"`
-	GRPCPort       int         `yaml:"grpc_port"`
-	HTTPPort       int         `yaml:"http_port"`
-	PrometheusPort int         `yaml:"prometheus_port"`
+	Name             string                 `yaml:"name"`
+	DB               DBConfig               `yaml:"db"`
+	Kafka            KafkaConfig            `yaml:"kafka"`
+	CacheConfig      CacheConfig            `yaml:"cache"`
+	OutputSource     string                 `yaml:"output_source"`
+	GRPCPort         int                    `yaml:"grpc_port"`
+	HTTPPort         int                    `yaml:"http_port"`
+	PrometheusPort   int                    `yaml:"prometheus_port"`
+	PickupPointID    int64                  `yaml:"pvz_id" env:"PVZ_ID"`
+	StorageFee       StorageFeeConfig       `yaml:"storage_fee"`
+	StorageExtension StorageExtensionConfig `yaml:"storage_extension"`
+	PickupCode       PickupCodeConfig       `yaml:"pickup_code"`
+	ReturnReasons    []string               `yaml:"return_reasons"`
+	ReturnPolicy     ReturnPolicyConfig     `yaml:"return_policy"`
+	Notifier         NotifierConfig         `yaml:"notifier"`
+	ExpiryScan       ExpiryScanConfig       `yaml:"expiry_scan"`
+	Capacity         CapacityConfig         `yaml:"capacity"`
+	Idempotency      IdempotencyConfig      `yaml:"idempotency"`
+	IntegritySecret  string
 }
 
 type CacheConfig struct {
@@ -36,6 +48,93 @@ type DBConfig struct {
 	Password string
 }
 
+// StorageFeeConfig правила платы за хранение заказа сверх срока.
+// Суммы указываются в минимальных единицах валюты заказа.
+type StorageFeeConfig struct {
+	StorageFeeRule `yaml:",inline"`
+	PackageTypes   map[string]StorageFeeRule `yaml:"package_types"`
+}
+
+type StorageFeeRule struct {
+	FreeDays  int   `yaml:"free_days"`
+	DailyRate int64 `yaml:"daily_rate"`
+	Cap       int64 `yaml:"cap"`
+}
+
+// StorageExtensionConfig ограничения продления срока хранения; нулевое значение означает, что ограничения нет
+type StorageExtensionConfig struct {
+	MaxTotal time.Duration `yaml:"max_total"`
+	MaxCount int           `yaml:"max_count"`
+}
+
+// PickupCodeConfig ограничения проверки и перевыпуска кода выдачи; нулевые значения означают значения по умолчанию
+type PickupCodeConfig struct {
+	MaxAttempts          int           `yaml:"max_attempts"`
+	RegenerationInterval time.Duration `yaml:"regeneration_interval"`
+}
+
+// ReturnPolicyConfig сроки возврата заказа клиентом и срок хранения заказов, возвращенных курьеру.
+// Срок категории товара важнее срока типа упаковки; нулевое значение означает значение по умолчанию.
+type ReturnPolicyConfig struct {
+	Window       time.Duration            `yaml:"window"`
+	Retention    time.Duration            `yaml:"retention"`
+	PackageTypes map[string]time.Duration `yaml:"package_types"`
+	Categories   map[string]time.Duration `yaml:"categories"`
+}
+
+// ReturnWindowPolicies переводит настройки в правила домена.
+// Сервис и команда cleanup строят правила одинаково, чтобы заказ удалялся по тому же сроку, который показывает GetOrder.
+func (c ReturnPolicyConfig) ReturnWindowPolicies() domain.ReturnWindowPolicies {
+	return domain.ReturnWindowPolicies{
+		Default:      c.Window,
+		PackageTypes: c.PackageTypes,
+		Categories:   c.Categories,
+		Retention:    c.Retention,
+	}
+}
+
+// NotifierConfig настройки доставки уведомлений получателям из outbox.
+// Шаблоны сообщений задаются по коду события; для незаданных событий используются шаблоны по умолчанию.
+type NotifierConfig struct {
+	Backend             NotifierBackend   `yaml:"backend"`
+	Topic               string            `yaml:"topic"`
+	WebhookURL          string            `yaml:"webhook_url"`
+	WebhookTimeout      time.Duration     `yaml:"webhook_timeout"`
+	File                string            `yaml:"file"`
+	Interval            time.Duration     `yaml:"interval"`
+	BatchSize           int32             `yaml:"batch_size"`
+	MaxRetries          int               `yaml:"max_retries"`
+	ClaimTimeout        time.Duration     `yaml:"claim_timeout"`
+	ExpiryCheckInterval time.Duration     `yaml:"expiry_check_interval"`
+	Templates           map[string]string `yaml:"templates"`
+}
+
+// ExpiryScanConfig как часто и какими пачками просроченные заказы отбираются для возврата курьеру;
+// нулевое значение означает значение по умолчанию
+type ExpiryScanConfig struct {
+	Interval  time.Duration `yaml:"interval"`
+	BatchSize int32         `yaml:"batch_size"`
+}
+
+// CapacityConfig ограничения заполненности ПВЗ по количеству и общему весу хранящихся заказов.
+// Ограничения из pickup_points важнее общих; нулевое значение означает, что ограничения нет.
+type CapacityConfig struct {
+	CapacityLimit `yaml:",inline"`
+	PickupPoints  map[int64]CapacityLimit `yaml:"pickup_points"`
+}
+
+type CapacityLimit struct {
+	MaxOrders int64   `yaml:"max_orders"`
+	MaxWeight float64 `yaml:"max_weight"`
+}
+
+// IdempotencyConfig сколько хранятся ключи идемпотентности и как часто удаляются истекшие;
+// нулевое значение означает значение по умолчанию
+type IdempotencyConfig struct {
+	TTL             time.Duration `yaml:"ttl"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval"`
+}
+
 type KafkaConfig struct {
 	Brokers []string
 	Topic   string
@@ -51,6 +150,7 @@ func MustLoad() *Config {
 	cfg := MustLoadPath(configPath)
 
 	cfg.DB.Password = GetValue("DB_PASSWORD", "")
+	cfg.IntegritySecret = GetValue("ORDER_INTEGRITY_SECRET", "")
 
 	return cfg
 }
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnPolicyConfig_ReturnWindowPolicies(t *testing.T) {
+	cfg := ReturnPolicyConfig{
+		Window:       72 * time.Hour,
+		Retention:    720 * time.Hour,
+		PackageTypes: map[string]time.Duration{"film": 24 * time.Hour},
+		Categories:   map[string]time.Duration{"electronics": 336 * time.Hour},
+	}
+
+	policies := cfg.ReturnWindowPolicies()
+
+	assert.Equal(t, 72*time.Hour, policies.Default)
+	assert.Equal(t, 720*time.Hour, policies.RetentionPeriod())
+	assert.Equal(t, cfg.PackageTypes, policies.PackageTypes)
+	assert.Equal(t, cfg.Categories, policies.Categories)
+	assert.Equal(t, 24*time.Hour, policies.Shortest())
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+)
+
+// NotifierBackend способ доставки уведомлений получателям
+type NotifierBackend int
+
+const (
+	NotifierBackendNone NotifierBackend = iota
+	NotifierBackendStdout
+	NotifierBackendFile
+	NotifierBackendKafka
+	NotifierBackendWebhook
+)
+
+var notifierBackendStrings = [...]string{
+	"none",
+	"stdout",
+	"file",
+	"kafka",
+	"webhook",
+}
+
+func (nb *NotifierBackend) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	backend, err := ParseNotifierBackend(s)
+	if err != nil {
+		return err
+	}
+	*nb = backend
+	return nil
+}
+
+func (nb NotifierBackend) String() string {
+	if int(nb) < len(notifierBackendStrings) {
+		return notifierBackendStrings[nb]
+	}
+	return fmt.Sprintf("unknown NotifierBackend(%d)", nb)
+}
+
+func ParseNotifierBackend(s string) (NotifierBackend, error) {
+	if s == "" {
+		return NotifierBackendNone, nil
+	}
+	for i, v := range notifierBackendStrings {
+		if s == v {
+			return NotifierBackend(i), nil
+		}
+	}
+	return NotifierBackendNone, fmt.Errorf("unknown NotifierBackend string: %s", s)
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+// CapacityPolicy ограничения заполненности ПВЗ по количеству и общему весу хранящихся заказов.
+// Нулевое значение ограничения означает, что ограничения нет.
+type CapacityPolicy struct {
+	MaxOrders int64
+	MaxWeight float64
+}
+
+// CapacityPolicies ограничения заполненности по умолчанию и для отдельных ПВЗ
+type CapacityPolicies struct {
+	Default      CapacityPolicy
+	PickupPoints map[int64]CapacityPolicy
+}
+
+// For возвращает ограничения заполненности ПВЗ pickupPointID
+func (p CapacityPolicies) For(pickupPointID int64) CapacityPolicy {
+	if policy, ok := p.PickupPoints[pickupPointID]; ok {
+		return policy
+	}
+
+	return p.Default
+}
+
+// Limited сообщает, задано ли хотя бы одно ограничение
+func (p CapacityPolicy) Limited() bool {
+	return p.MaxOrders > 0 || p.MaxWeight > 0
+}
+
+// PickupPointLoad текущая заполненность ПВЗ заказами, которые физически находятся на складе
+type PickupPointLoad struct {
+	OrderCount int64   `db:"order_count"`
+	Weight     float64 `db:"weight"`
+}
+
+// Capacity заполненность ПВЗ и ее ограничения
+type Capacity struct {
+	PickupPointID int64
+	PickupPointLoad
+	CapacityPolicy
+}
+
+// Admit проверяет, что после приемки заказа ПВЗ не превысит ограничения заполненности
+func (c Capacity) Admit(order *Order) error {
+	err := ErrCapacityExceeded{Capacity: c, OrderWeight: order.Weight}
+	if err.OrdersExceeded() || err.WeightExceeded() {
+		return err
+	}
+
+	return nil
+}
+
+// Reserve проверяет, что заказ помещается в ПВЗ, и учитывает его в заполненности.
+// Используется, когда в одной операции принимается несколько заказов.
+func (c *Capacity) Reserve(order *Order) error {
+	err := c.Admit(order)
+	if err != nil {
+		return err
+	}
+
+	c.OrderCount++
+	c.Weight += order.Weight
+
+	return nil
+}
+
+// ToCapacityDTO преобразует заполненность ПВЗ в сущность DTO
+func ToCapacityDTO(capacity Capacity) *dto.Capacity {
+	return &dto.Capacity{
+		PickupPointID: capacity.PickupPointID,
+		OrderCount:    capacity.OrderCount,
+		MaxOrders:     capacity.MaxOrders,
+		Weight:        capacity.Weight,
+		MaxWeight:     capacity.MaxWeight,
+	}
+}
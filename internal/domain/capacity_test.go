@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityPolicies_For(t *testing.T) {
+	t.Parallel()
+
+	policies := CapacityPolicies{
+		Default:      CapacityPolicy{MaxOrders: 100},
+		PickupPoints: map[int64]CapacityPolicy{2: {MaxOrders: 10, MaxWeight: 50}},
+	}
+
+	assert.Equal(t, CapacityPolicy{MaxOrders: 100}, policies.For(1))
+	assert.Equal(t, CapacityPolicy{MaxOrders: 10, MaxWeight: 50}, policies.For(2))
+	assert.False(t, CapacityPolicies{}.For(1).Limited())
+}
+
+func TestCapacity_Admit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		capacity Capacity
+		weight   float64
+		wantErr  error
+	}{
+		{
+			name:     "room left",
+			capacity: Capacity{PickupPointLoad: PickupPointLoad{OrderCount: 9, Weight: 40}, CapacityPolicy: CapacityPolicy{MaxOrders: 10, MaxWeight: 50}},
+			weight:   10,
+		},
+		{
+			name:     "order count reached",
+			capacity: Capacity{PickupPointLoad: PickupPointLoad{OrderCount: 10}, CapacityPolicy: CapacityPolicy{MaxOrders: 10}},
+			weight:   1,
+			wantErr:  ErrPickupPointFull,
+		},
+		{
+			name:     "weight exceeded",
+			capacity: Capacity{PickupPointLoad: PickupPointLoad{OrderCount: 1, Weight: 45}, CapacityPolicy: CapacityPolicy{MaxWeight: 50}},
+			weight:   6,
+			wantErr:  ErrPickupPointFull,
+		},
+		{
+			name:     "unlimited",
+			capacity: Capacity{PickupPointLoad: PickupPointLoad{OrderCount: 1000, Weight: 1000}},
+			weight:   100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.capacity.Admit(&Order{Weight: tt.weight})
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("error reports usage", func(t *testing.T) {
+		t.Parallel()
+
+		capacity := Capacity{
+			PickupPointID:   1,
+			PickupPointLoad: PickupPointLoad{OrderCount: 10, Weight: 20},
+			CapacityPolicy:  CapacityPolicy{MaxOrders: 10, MaxWeight: 50},
+		}
+
+		err := capacity.Admit(&Order{Weight: 2})
+
+		var capacityErr ErrCapacityExceeded
+		require.ErrorAs(t, err, &capacityErr)
+		assert.Equal(t, int64(10), capacityErr.OrderCount)
+		assert.InDelta(t, 2.0, capacityErr.OrderWeight, 1e-9)
+		assert.Contains(t, err.Error(), "10 of 10 orders")
+	})
+}
+
+func TestCapacity_Reserve(t *testing.T) {
+	t.Parallel()
+
+	capacity := &Capacity{CapacityPolicy: CapacityPolicy{MaxOrders: 2, MaxWeight: 10}}
+
+	require.NoError(t, capacity.Reserve(&Order{Weight: 4}))
+	require.NoError(t, capacity.Reserve(&Order{Weight: 5}))
+	require.ErrorIs(t, capacity.Reserve(&Order{Weight: 0.5}), ErrPickupPointFull)
+
+	assert.Equal(t, int64(2), capacity.OrderCount)
+	assert.InDelta(t, 9.0, capacity.Weight, 1e-9)
+}
@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+// Cell ячейка хранения заказов в ПВЗ: зона, стеллаж и полка.
+// OrderCount и Weight - текущая заполненность ячейки, они не хранятся, а считаются по заказам в ячейке.
+// Пустой список PackageTypes означает, что в ячейку можно положить заказ в любой упаковке.
+type Cell struct {
+	ID            int64     `db:"id"`
+	PickupPointID int64     `db:"pvz_id"`
+	Zone          string    `db:"zone"`
+	Rack          string    `db:"rack"`
+	Shelf         string    `db:"shelf"`
+	MaxOrders     int       `db:"max_orders"`
+	MaxWeight     float64   `db:"max_weight"`
+	PackageTypes  []string  `db:"package_types"`
+	CreatedAt     time.Time `db:"created_at"`
+	OrderCount    int       `db:"order_count"`
+	Weight        float64   `db:"weight"`
+}
+
+// NewCell создает пустую ячейку с проверенным расположением и вместимостью
+func NewCell(cell *dto.Cell) (*Cell, error) {
+	zone := strings.TrimSpace(cell.Zone)
+	rack := strings.TrimSpace(cell.Rack)
+	shelf := strings.TrimSpace(cell.Shelf)
+
+	if zone == "" || rack == "" || shelf == "" {
+		return nil, ErrCellLocationRequired
+	}
+
+	if cell.MaxOrders <= 0 || cell.MaxWeight <= 0 {
+		return nil, ErrCellCapacityInvalid
+	}
+
+	types := make([]string, 0, len(cell.PackageTypes))
+	for _, name := range cell.PackageTypes {
+		if _, ok := packageTypes.Lookup(name); !ok {
+			return nil, ErrPackageTypeUnsupported
+		}
+
+		if !slices.Contains(types, name) {
+			types = append(types, name)
+		}
+	}
+
+	return &Cell{
+		Zone:         zone,
+		Rack:         rack,
+		Shelf:        shelf,
+		MaxOrders:    cell.MaxOrders,
+		MaxWeight:    cell.MaxWeight,
+		PackageTypes: types,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// Code возвращает адрес ячейки в виде зона-стеллаж-полка
+func (c *Cell) Code() string {
+	return fmt.Sprintf("%s-%s-%s", c.Zone, c.Rack, c.Shelf)
+}
+
+// Accepts проверяет, что ячейка предназначена для упаковки заказа.
+// Заказ в составной упаковке подходит ячейке, предназначенной для любого из ее слоев.
+func (c *Cell) Accepts(order *Order) bool {
+	if len(c.PackageTypes) == 0 {
+		return true
+	}
+
+	if order.PackageType == nil {
+		return slices.Contains(c.PackageTypes, DefaultPackageType)
+	}
+
+	if slices.Contains(c.PackageTypes, order.PackageType.Type()) {
+		return true
+	}
+
+	for _, layer := range order.PackageType.Layers() {
+		if slices.Contains(c.PackageTypes, layer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Place проверяет, что заказ помещается в ячейку, и учитывает его в заполненности ячейки
+func (c *Cell) Place(order *Order) error {
+	if !c.Accepts(order) {
+		return ErrCellPackageMismatch
+	}
+
+	if !c.hasRoomFor(order) {
+		return ErrCellFull
+	}
+
+	c.OrderCount++
+	c.Weight += order.Weight
+
+	return nil
+}
+
+// hasRoomFor проверяет, что после размещения заказа ячейка не превысит вместимость по количеству и весу
+func (c *Cell) hasRoomFor(order *Order) bool {
+	return c.OrderCount < c.MaxOrders && c.Weight+order.Weight <= c.MaxWeight
+}
+
+// MoveTo перекладывает заказ в ячейку cell.
+// Переложить можно только заказ, который находится на складе ПВЗ.
+func (o *Order) MoveTo(cell *Cell) error {
+	if !o.Status.IsStored() {
+		return ErrCellOrderNotStored
+	}
+
+	err := cell.Place(o)
+	if err != nil {
+		return err
+	}
+
+	o.CellID = sql.NullInt64{Int64: cell.ID, Valid: true}
+
+	return nil
+}
+
+// SelectCell выбирает ячейку для заказа.
+// Предпочтение отдается ячейкам, предназначенным для упаковки заказа, а не универсальным;
+// среди них выбирается ячейка, в которой после размещения останется меньше всего свободного веса,
+// чтобы крупные ячейки оставались свободными для тяжелых заказов.
+func SelectCell(cells []*Cell, order *Order) (*Cell, error) {
+	candidates := make([]*Cell, 0, len(cells))
+	for _, cell := range cells {
+		if cell.Accepts(order) && cell.hasRoomFor(order) {
+			candidates = append(candidates, cell)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrCellNotFree
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+
+		aDedicated, bDedicated := len(a.PackageTypes) > 0, len(b.PackageTypes) > 0
+		if aDedicated != bDedicated {
+			return aDedicated
+		}
+
+		aFree, bFree := a.MaxWeight-a.Weight, b.MaxWeight-b.Weight
+		if aFree != bFree {
+			return aFree < bFree
+		}
+
+		return a.ID < b.ID
+	})
+
+	return candidates[0], nil
+}
+
+// ToCellDTO преобразует ячейку в сущность DTO
+func ToCellDTO(cell *Cell) *dto.Cell {
+	return &dto.Cell{
+		ID:           cell.ID,
+		Zone:         cell.Zone,
+		Rack:         cell.Rack,
+		Shelf:        cell.Shelf,
+		Code:         cell.Code(),
+		MaxOrders:    cell.MaxOrders,
+		MaxWeight:    cell.MaxWeight,
+		PackageTypes: cell.PackageTypes,
+		OrderCount:   cell.OrderCount,
+		Weight:       cell.Weight,
+		CreatedAt:    cell.CreatedAt,
+	}
+}
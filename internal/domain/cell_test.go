@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+func TestNewCell(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cell    *dto.Cell
+		wantErr error
+	}{
+		{
+			name:    "location required",
+			cell:    &dto.Cell{Zone: "A", Rack: " ", Shelf: "1", MaxOrders: 5, MaxWeight: 20},
+			wantErr: ErrCellLocationRequired,
+		},
+		{
+			name:    "capacity required",
+			cell:    &dto.Cell{Zone: "A", Rack: "1", Shelf: "1", MaxOrders: 0, MaxWeight: 20},
+			wantErr: ErrCellCapacityInvalid,
+		},
+		{
+			name:    "unknown package type",
+			cell:    &dto.Cell{Zone: "A", Rack: "1", Shelf: "1", MaxOrders: 5, MaxWeight: 20, PackageTypes: []string{"crate"}},
+			wantErr: ErrPackageTypeUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cell, err := NewCell(tt.cell)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, cell)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		cell, err := NewCell(&dto.Cell{
+			Zone: " A ", Rack: "2", Shelf: "3", MaxOrders: 5, MaxWeight: 20, PackageTypes: []string{"box", "box"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "A-2-3", cell.Code())
+		assert.Equal(t, []string{"box"}, cell.PackageTypes)
+	})
+}
+
+func TestSelectCell(t *testing.T) {
+	t.Parallel()
+
+	box, err := NewPackageType("box")
+	require.NoError(t, err)
+	film, err := NewPackageType("film")
+	require.NoError(t, err)
+	boxInFilm, err := NewCompositePackageType([]string{"box", "film"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		cells   []*Cell
+		order   *Order
+		wantID  int64
+		wantErr error
+	}{
+		{
+			name: "dedicated cell preferred over universal",
+			cells: []*Cell{
+				{ID: 1, MaxOrders: 10, MaxWeight: 5},
+				{ID: 2, MaxOrders: 10, MaxWeight: 50, PackageTypes: []string{"box"}},
+			},
+			order:  &Order{Weight: 2, PackageType: box},
+			wantID: 2,
+		},
+		{
+			name: "best fit by remaining weight",
+			cells: []*Cell{
+				{ID: 1, MaxOrders: 10, MaxWeight: 50},
+				{ID: 2, MaxOrders: 10, MaxWeight: 20, Weight: 15},
+				{ID: 3, MaxOrders: 10, MaxWeight: 20, Weight: 19},
+			},
+			order:  &Order{Weight: 3, PackageType: film},
+			wantID: 2,
+		},
+		{
+			name: "full cells skipped",
+			cells: []*Cell{
+				{ID: 1, MaxOrders: 2, MaxWeight: 20, OrderCount: 2},
+				{ID: 2, MaxOrders: 10, MaxWeight: 20},
+			},
+			order:  &Order{Weight: 3, PackageType: film},
+			wantID: 2,
+		},
+		{
+			name: "composite package matches layer",
+			cells: []*Cell{
+				{ID: 1, MaxOrders: 10, MaxWeight: 20, PackageTypes: []string{"bag"}},
+				{ID: 2, MaxOrders: 10, MaxWeight: 20, PackageTypes: []string{"film"}},
+			},
+			order:  &Order{Weight: 3, PackageType: boxInFilm},
+			wantID: 2,
+		},
+		{
+			name: "no free cell",
+			cells: []*Cell{
+				{ID: 1, MaxOrders: 10, MaxWeight: 20, Weight: 18},
+				{ID: 2, MaxOrders: 10, MaxWeight: 20, PackageTypes: []string{"film"}},
+			},
+			order:   &Order{Weight: 3, PackageType: box},
+			wantErr: ErrCellNotFree,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cell, err := SelectCell(tt.cells, tt.order)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantID, cell.ID)
+			}
+		})
+	}
+}
+
+func TestOrder_MoveTo(t *testing.T) {
+	t.Parallel()
+
+	box, err := NewPackageType("box")
+	require.NoError(t, err)
+
+	t.Run("moved", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Weight: 3, PackageType: box, Status: OrderStatusAccepted}
+		cell := &Cell{ID: 7, MaxOrders: 2, MaxWeight: 10, OrderCount: 1, Weight: 4}
+
+		err := order.MoveTo(cell)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), order.CellID.Int64)
+		assert.Equal(t, 2, cell.OrderCount)
+		assert.InDelta(t, 7.0, cell.Weight, 1e-9)
+	})
+	t.Run("cell full", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Weight: 3, PackageType: box, Status: OrderStatusAccepted}
+
+		err := order.MoveTo(&Cell{ID: 7, MaxOrders: 2, MaxWeight: 10, Weight: 8})
+
+		require.ErrorIs(t, err, ErrCellFull)
+		assert.False(t, order.CellID.Valid)
+	})
+	t.Run("package mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Weight: 3, PackageType: box, Status: OrderStatusAccepted}
+
+		err := order.MoveTo(&Cell{ID: 7, MaxOrders: 2, MaxWeight: 10, PackageTypes: []string{"film"}})
+
+		require.ErrorIs(t, err, ErrCellPackageMismatch)
+	})
+	t.Run("issued order", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Weight: 3, PackageType: box, Status: OrderStatusIssued}
+
+		err := order.MoveTo(&Cell{ID: 7, MaxOrders: 2, MaxWeight: 10})
+
+		require.ErrorIs(t, err, ErrCellOrderNotStored)
+	})
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"time"
+)
+
+// Courier структура курьера, который привозит и забирает заказы
+type Courier struct {
+	ID        int64     `db:"id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// NewCourier создает курьера с указанным идентификатором
+func NewCourier(id int64) *Courier {
+	return &Courier{
+		ID:        id,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// BelongsToCourier проверяет, что заказ был принят от курьера courierID.
+// Заказы, принятые до появления курьеров, могут быть возвращены любому курьеру.
+func (o *Order) BelongsToCourier(courierID int64) bool {
+	return !o.CourierID.Valid || o.CourierID.Int64 == courierID
+}
@@ -0,0 +1,77 @@
+package domain
+
+// Dimensions габариты посылки в сантиметрах
+type Dimensions struct {
+	Length float64 `db:"length"`
+	Width  float64 `db:"width"`
+	Height float64 `db:"height"`
+}
+
+// DimensionLimits ограничения типа упаковки по габаритам.
+// Нулевое значение означает, что ограничения нет.
+type DimensionLimits struct {
+	MaxLength float64 `db:"max_length"`
+	MaxWidth  float64 `db:"max_width"`
+	MaxHeight float64 `db:"max_height"`
+	// VolumetricDivisor делитель для расчета объемного веса: Д*Ш*В / делитель, кг
+	VolumetricDivisor float64 `db:"volumetric_divisor"`
+}
+
+// Названия габаритов, которые попадают в описание ошибок
+const (
+	DimensionLength = "length"
+	DimensionWidth  = "width"
+	DimensionHeight = "height"
+)
+
+// IsZero сообщает, что габариты посылки не переданы
+func (d Dimensions) IsZero() bool {
+	return d.Length == 0 && d.Width == 0 && d.Height == 0
+}
+
+// Volume возвращает объем посылки в кубических сантиметрах
+func (d Dimensions) Volume() float64 {
+	return d.Length * d.Width * d.Height
+}
+
+// Validate проверяет, что габариты не отрицательные
+func (d Dimensions) Validate() error {
+	if d.Length < 0 || d.Width < 0 || d.Height < 0 {
+		return ErrDimensionsNegative
+	}
+	return nil
+}
+
+// VolumetricWeight возвращает объемный вес посылки; ноль, если правило не задано
+func (l DimensionLimits) VolumetricWeight(dimensions Dimensions) float64 {
+	if l.VolumetricDivisor <= 0 {
+		return 0
+	}
+	return dimensions.Volume() / l.VolumetricDivisor
+}
+
+// validate проверяет габариты посылки по отдельности.
+// Ограничение по объемному весу проверяется типом упаковки, который знает максимальный вес.
+func (l DimensionLimits) validate(dimensions Dimensions) error {
+	checks := []struct {
+		name  string
+		value float64
+		limit float64
+	}{
+		{DimensionLength, dimensions.Length, l.MaxLength},
+		{DimensionWidth, dimensions.Width, l.MaxWidth},
+		{DimensionHeight, dimensions.Height, l.MaxHeight},
+	}
+
+	for _, check := range checks {
+		if check.limit > 0 && check.value > check.limit {
+			return ErrDimensionExceedsLimit{
+				Dimension: check.name,
+				Value:     check.value,
+				Limit:     check.limit,
+			}
+		}
+	}
+
+	return nil
+}
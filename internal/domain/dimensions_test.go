@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageType_ValidateDimensions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		packageType *PackageType
+		dimensions  Dimensions
+		expectedErr error
+	}{
+		{
+			name:        "Box, dimensions not set",
+			packageType: testPackageType("box"),
+			dimensions:  Dimensions{},
+			expectedErr: nil,
+		},
+		{
+			name:        "Box, valid dimensions",
+			packageType: testPackageType("box"),
+			dimensions:  Dimensions{Length: 50, Width: 40, Height: 30},
+			expectedErr: nil,
+		},
+		{
+			name:        "Box, negative dimensions",
+			packageType: testPackageType("box"),
+			dimensions:  Dimensions{Length: -1, Width: 40, Height: 30},
+			expectedErr: ErrDimensionsNegative,
+		},
+		{
+			name:        "Package, width exceeds limit",
+			packageType: testPackageType("package"),
+			dimensions:  Dimensions{Length: 50, Width: 45, Height: 10},
+			expectedErr: ErrDimensionExceedsLimit{Dimension: DimensionWidth, Value: 45, Limit: 40},
+		},
+		{
+			name:        "Box, volumetric weight exceeds limit",
+			packageType: testPackageType("box"),
+			dimensions:  Dimensions{Length: 100, Width: 60, Height: 50},
+			expectedErr: ErrVolumetricWeightExceedsLimit{VolumetricWeight: 60, Limit: 30},
+		},
+		{
+			name:        "Film, no limits",
+			packageType: testPackageType("film"),
+			dimensions:  Dimensions{Length: 500, Width: 500, Height: 500},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.packageType.ValidateDimensions(tt.dimensions)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -6,8 +6,52 @@ import (
 )
 
 var (
-	ErrPackageTypeUnsupported = errors.New("unsupported package type")
-	ErrWeightNegative         = errors.New("weight is negative")
+	ErrPackageTypeUnsupported        = errors.New("unsupported package type")
+	ErrPackageTypeInactive           = errors.New("package type is deactivated")
+	ErrPackageTypeInvalid            = errors.New("package type must have a name and non-negative limits and cost")
+	ErrWeightNegative                = errors.New("weight is negative")
+	ErrWeightLimit                   = errors.New("weight exceeds package limit")
+	ErrDimensionsNegative            = errors.New("dimensions are negative")
+	ErrDimensionsExceedLimit         = errors.New("dimensions exceed package limit")
+	ErrVolumetricWeightLimit         = errors.New("volumetric weight exceeds package limit")
+	ErrCurrencyInvalid               = errors.New("currency must be an ISO 4217 code")
+	ErrCurrencyMismatch              = errors.New("amounts are in different currencies")
+	ErrMoneyNegative                 = errors.New("amount is negative")
+	ErrOrderStatusUnknown            = errors.New("unknown order status")
+	ErrOrderStatusTransition         = errors.New("order status transition is not allowed")
+	ErrPickupPointRequired           = errors.New("pickup point is not specified")
+	ErrExtensionDaysInvalid          = errors.New("storage extension must be at least one day")
+	ErrExtensionNotAllowed           = errors.New("storage can not be extended for issued or returned orders")
+	ErrExtensionCountLimit           = errors.New("storage extension count limit reached")
+	ErrExtensionTotalLimit           = errors.New("total storage extension limit exceeded")
+	ErrPickupCodeRequired            = errors.New("pickup code is required")
+	ErrPickupCodeInvalid             = errors.New("pickup code is invalid")
+	ErrPickupCodeLocked              = errors.New("pickup code is locked after too many failed attempts")
+	ErrPickupCodeNotGenerated        = errors.New("pickup code was not generated for order")
+	ErrPickupCodeNotAllowed          = errors.New("pickup code can be regenerated only for orders awaiting pickup")
+	ErrPickupCodeUndeliverable       = errors.New("recipient has no contact to deliver pickup code to")
+	ErrPickupCodeRegeneratedRecently = errors.New("pickup code was regenerated too recently")
+	ErrReturnReasonUnknown           = errors.New("unknown return reason")
+	ErrReturnConditionUnknown        = errors.New("return condition must be intact, damaged or opened")
+	ErrReturnNoteTooLong             = errors.New("return note is too long")
+	ErrRefundExceedsCost             = errors.New("refund exceeds order cost")
+
+	ErrRecipientNameRequired       = errors.New("recipient name is required")
+	ErrRecipientNameTooLong        = errors.New("recipient name is too long")
+	ErrRecipientContactRequired    = errors.New("recipient phone or email is required")
+	ErrRecipientPhoneInvalid       = errors.New("recipient phone must be an international number")
+	ErrRecipientEmailInvalid       = errors.New("recipient email is invalid")
+	ErrRecipientChannelUnknown     = errors.New("preferred channel must be sms or email")
+	ErrRecipientChannelUnavailable = errors.New("recipient has no contact for preferred channel")
+
+	ErrCellLocationRequired = errors.New("cell zone, rack and shelf are required")
+	ErrCellCapacityInvalid  = errors.New("cell capacity must be positive")
+	ErrCellPackageMismatch  = errors.New("cell does not accept order package type")
+	ErrCellFull             = errors.New("cell has no room for order")
+	ErrCellNotFree          = errors.New("no free cell for order")
+	ErrCellOrderNotStored   = errors.New("only orders stored in pickup point can be placed in a cell")
+
+	ErrPickupPointFull = errors.New("pickup point capacity exceeded")
 )
 
 type ErrWeightExceedsLimit struct {
@@ -18,3 +62,72 @@ type ErrWeightExceedsLimit struct {
 func (e ErrWeightExceedsLimit) Error() string {
 	return fmt.Sprintf("weight exceeds %f kg limit: got %f kg", e.Limit, e.Weight)
 }
+
+func (e ErrWeightExceedsLimit) Unwrap() error {
+	return ErrWeightLimit
+}
+
+type ErrDimensionExceedsLimit struct {
+	Dimension string
+	Value     float64
+	Limit     float64
+}
+
+func (e ErrDimensionExceedsLimit) Error() string {
+	return fmt.Sprintf("%s exceeds %f cm limit: got %f cm", e.Dimension, e.Limit, e.Value)
+}
+
+func (e ErrDimensionExceedsLimit) Unwrap() error {
+	return ErrDimensionsExceedLimit
+}
+
+type ErrVolumetricWeightExceedsLimit struct {
+	VolumetricWeight float64
+	Limit            float64
+}
+
+func (e ErrVolumetricWeightExceedsLimit) Error() string {
+	return fmt.Sprintf("volumetric weight exceeds %f kg limit: got %f kg", e.Limit, e.VolumetricWeight)
+}
+
+func (e ErrVolumetricWeightExceedsLimit) Unwrap() error {
+	return ErrVolumetricWeightLimit
+}
+
+type ErrInvalidStatusTransition struct {
+	From OrderStatus
+	To   OrderStatus
+}
+
+func (e ErrInvalidStatusTransition) Error() string {
+	return fmt.Sprintf("%s: from %q to %q", ErrOrderStatusTransition, e.From, e.To)
+}
+
+func (e ErrInvalidStatusTransition) Unwrap() error {
+	return ErrOrderStatusTransition
+}
+
+// ErrCapacityExceeded ошибка приемки заказа в заполненный ПВЗ; содержит текущую заполненность ПВЗ
+type ErrCapacityExceeded struct {
+	Capacity
+	OrderWeight float64
+}
+
+func (e ErrCapacityExceeded) Error() string {
+	return fmt.Sprintf("%s: %d of %d orders, %.3f of %.3f kg stored, order weighs %.3f kg",
+		ErrPickupPointFull, e.OrderCount, e.MaxOrders, e.Weight, e.MaxWeight, e.OrderWeight)
+}
+
+func (e ErrCapacityExceeded) Unwrap() error {
+	return ErrPickupPointFull
+}
+
+// OrdersExceeded сообщает, что приемка заказа превышает ограничение по количеству заказов
+func (e ErrCapacityExceeded) OrdersExceeded() bool {
+	return e.MaxOrders > 0 && e.OrderCount+1 > e.MaxOrders
+}
+
+// WeightExceeded сообщает, что приемка заказа превышает ограничение по общему весу
+func (e ErrCapacityExceeded) WeightExceeded() bool {
+	return e.MaxWeight > 0 && e.Weight+e.OrderWeight > e.MaxWeight
+}
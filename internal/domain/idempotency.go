@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+)
+
+// IdempotencyKey ключ идемпотентности запроса, изменяющего заказы ПВЗ, и сохраненный ответ на этот запрос.
+// Пока запрос выполняется, Response пустой; после ExpiresAt ключ можно использовать заново.
+type IdempotencyKey struct {
+	PickupPointID int64     `db:"pvz_id"`
+	Key           string    `db:"key"`
+	Method        string    `db:"method"`
+	Fingerprint   string    `db:"fingerprint"`
+	Response      []byte    `db:"response"`
+	CreatedAt     time.Time `db:"created_at"`
+	ExpiresAt     time.Time `db:"expires_at"`
+}
+
+// NewIdempotencyKey создает ключ идемпотентности запроса method, который действует ttl
+func NewIdempotencyKey(pickupPointID int64, key, method, fingerprint string, now time.Time, ttl time.Duration) *IdempotencyKey {
+	return &IdempotencyKey{
+		PickupPointID: pickupPointID,
+		Key:           key,
+		Method:        method,
+		Fingerprint:   fingerprint,
+		CreatedAt:     now,
+		ExpiresAt:     now.Add(ttl),
+	}
+}
+
+// Matches сообщает, что запрос совпадает с запросом, для которого создан ключ
+func (k *IdempotencyKey) Matches(method, fingerprint string) bool {
+	return k.Method == method && k.Fingerprint == fingerprint
+}
+
+// Completed сообщает, что запрос выполнен и ответ на него сохранен
+func (k *IdempotencyKey) Completed() bool {
+	return len(k.Response) > 0
+}
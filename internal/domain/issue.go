@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"errors"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+// IssueStatus результат выдачи отдельного заказа получателю
+type IssueStatus string
+
+const (
+	IssueStatusIssued         IssueStatus = "issued"
+	IssueStatusNotFound       IssueStatus = "not_found"
+	IssueStatusWrongRecipient IssueStatus = "wrong_recipient"
+	IssueStatusAlreadyIssued  IssueStatus = "already_issued"
+	IssueStatusExpired        IssueStatus = "expired"
+	IssueStatusInvalidCode    IssueStatus = "invalid_pickup_code"
+	IssueStatusCodeLocked     IssueStatus = "pickup_code_locked"
+)
+
+// IssueResult результат выдачи заказа; Err заполнен, если заказ не выдан
+type IssueResult struct {
+	OrderID    int64
+	Status     IssueStatus
+	StorageFee StorageFee
+	Err        error
+}
+
+// IssueFailureStatus возвращает результат выдачи заказа, который нельзя перевести в issued из статуса from.
+// Заказ, возвращенный курьеру, не выдается по той же причине, что и просроченный.
+func IssueFailureStatus(from OrderStatus) IssueStatus {
+	switch from {
+	case OrderStatusExpired, OrderStatusAwaitingReturn, OrderStatusReturnedToCourier:
+		return IssueStatusExpired
+	default:
+		return IssueStatusAlreadyIssued
+	}
+}
+
+// PickupCodeFailureStatus возвращает результат выдачи заказа, код выдачи которого не прошел проверку
+func PickupCodeFailureStatus(err error) IssueStatus {
+	if errors.Is(err, ErrPickupCodeLocked) {
+		return IssueStatusCodeLocked
+	}
+
+	return IssueStatusInvalidCode
+}
+
+// Issued сообщает, что заказ выдан
+func (r IssueResult) Issued() bool {
+	return r.Status == IssueStatusIssued
+}
+
+// ToIssueResultDTO преобразует результат выдачи в DTO
+func ToIssueResultDTO(result IssueResult) *dto.IssueResult {
+	issueResult := &dto.IssueResult{
+		OrderID: result.OrderID,
+		Status:  string(result.Status),
+	}
+
+	if result.Issued() {
+		issueResult.StorageFee = ToStorageFeeDTO(result.OrderID, result.StorageFee)
+	}
+
+	return issueResult
+}
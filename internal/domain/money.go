@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"regexp"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/money"
+)
+
+// currencyPattern формат кода валюты ISO 4217
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Money денежная сумма в минимальных единицах валюты (копейках)
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney создает сумму; если валюта не указана, используется валюта по умолчанию
+func NewMoney(amount int64, currency string) (Money, error) {
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+
+	if !currencyPattern.MatchString(currency) {
+		return Money{}, ErrCurrencyInvalid
+	}
+
+	if amount < 0 {
+		return Money{}, ErrMoneyNegative
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Add складывает суммы в одной валюте.
+// Нулевая сумма совместима с любой валютой, поэтому бесплатная упаковка не мешает заказу в другой валюте.
+func (m Money) Add(other Money) (Money, error) {
+	switch {
+	case other.Amount == 0:
+		return m, nil
+	case m.Amount == 0:
+		return other, nil
+	case m.Currency != other.Currency:
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMoney(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		amount      int64
+		currency    string
+		want        Money
+		expectedErr error
+	}{
+		{
+			name:     "default currency",
+			amount:   100,
+			currency: "",
+			want:     Money{Amount: 100, Currency: "RUB"},
+		},
+		{
+			name:     "explicit currency",
+			amount:   250,
+			currency: "USD",
+			want:     Money{Amount: 250, Currency: "USD"},
+		},
+		{
+			name:        "lowercase currency",
+			amount:      100,
+			currency:    "usd",
+			expectedErr: ErrCurrencyInvalid,
+		},
+		{
+			name:        "negative amount",
+			amount:      -1,
+			currency:    "RUB",
+			expectedErr: ErrMoneyNegative,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewMoney(tt.amount, tt.currency)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same currency", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := Money{Amount: 500, Currency: "RUB"}.Add(Money{Amount: 2000, Currency: "RUB"})
+
+		require.NoError(t, err)
+		assert.Equal(t, Money{Amount: 2500, Currency: "RUB"}, got)
+	})
+
+	t.Run("zero amount in another currency", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := Money{Amount: 500, Currency: "USD"}.Add(Money{Currency: "RUB"})
+
+		require.NoError(t, err)
+		assert.Equal(t, Money{Amount: 500, Currency: "USD"}, got)
+	})
+
+	t.Run("currency mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Money{Amount: 500, Currency: "USD"}.Add(Money{Amount: 2000, Currency: "RUB"})
+
+		require.ErrorIs(t, err, ErrCurrencyMismatch)
+	})
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationEvent событие заказа, о котором уведомляется получатель
+type NotificationEvent string
+
+const (
+	NotificationOrderArrived           NotificationEvent = "order_arrived"
+	NotificationOrderExpiresTomorrow   NotificationEvent = "order_expires_tomorrow"
+	NotificationOrderReturnedToCourier NotificationEvent = "order_returned_to_courier"
+	NotificationPickupCodeRegenerated  NotificationEvent = "pickup_code_regenerated"
+)
+
+// ExpiryNoticePeriod за сколько до окончания срока хранения получатель предупреждается об этом
+const ExpiryNoticePeriod = 24 * time.Hour
+
+// notificationNamespace пространство имен идентификаторов уведомлений
+var notificationNamespace = uuid.MustParse("8f3b6c1e-2d4a-4f6b-9a57-0c1d2e3f4a5b")
+
+// Notification уведомление получателя о событии заказа, ожидающее доставки в outbox.
+// Контакты получателя подставляются при доставке, чтобы учесть их изменение после события.
+// PickupCode заполняется только в уведомлениях о прибытии заказа и о перевыпуске кода
+// и стирается из outbox после доставки.
+type Notification struct {
+	ID            uuid.UUID         `json:"id"`
+	Event         NotificationEvent `json:"event"`
+	OrderID       int64             `json:"order_id"`
+	RecipientID   int64             `json:"recipient_id"`
+	PickupPointID int64             `json:"pvz_id"`
+	StorageUntil  time.Time         `json:"storage_until"`
+	PickupCode    string            `json:"pickup_code,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// NewNotification создает уведомление о событии заказа.
+// Идентификатор зависит от события, заказа и срока хранения, поэтому повторное событие не дублирует уведомление,
+// а после продления срока хранения предупреждение об окончании срока отправляется снова.
+func NewNotification(event NotificationEvent, order *Order) *Notification {
+	key := fmt.Sprintf("%s:%d:%d", event, order.ID, order.StorageUntil.Unix())
+
+	return &Notification{
+		ID:            uuid.NewSHA1(notificationNamespace, []byte(key)),
+		Event:         event,
+		OrderID:       order.ID,
+		RecipientID:   order.RecipientID,
+		PickupPointID: order.PickupPointID,
+		StorageUntil:  order.StorageUntil,
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+// NewOrderArrivedNotification создает уведомление о прибытии заказа с первым кодом выдачи.
+// Код получает только получатель: курьеру при приемке он не возвращается.
+func NewOrderArrivedNotification(order *Order, code string) *Notification {
+	notification := NewNotification(NotificationOrderArrived, order)
+	notification.PickupCode = code
+
+	return notification
+}
+
+// NewPickupCodeNotification создает уведомление с перевыпущенным кодом выдачи.
+// Идентификатор зависит от хэша кода, поэтому каждый перевыпуск доставляется отдельно.
+func NewPickupCodeNotification(order *Order, code string) *Notification {
+	notification := NewNotification(NotificationPickupCodeRegenerated, order)
+	notification.ID = uuid.NewSHA1(notificationNamespace, []byte(fmt.Sprintf("%s:%d:%s",
+		NotificationPickupCodeRegenerated, order.ID, order.PickupCodeHash)))
+	notification.PickupCode = code
+
+	return notification
+}
+
+// Contact возвращает предпочитаемый канал получателя и адрес в нем.
+// ok == false, если получатель еще не оставил контактов.
+func (r *Recipient) Contact() (channel ContactChannel, address string, ok bool) {
+	switch r.PreferredChannel {
+	case ContactChannelSMS:
+		return ContactChannelSMS, r.Phone, r.Phone != ""
+	case ContactChannelEmail:
+		return ContactChannelEmail, r.Email, r.Email != ""
+	default:
+		return "", "", false
+	}
+}
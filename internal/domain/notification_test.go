@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNotification(t *testing.T) {
+	t.Parallel()
+
+	storageUntil := time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC)
+	order := &Order{ID: 10, RecipientID: 2, PickupPointID: 1, StorageUntil: storageUntil}
+
+	first := NewNotification(NotificationOrderExpiresTomorrow, order)
+	again := NewNotification(NotificationOrderExpiresTomorrow, order)
+
+	assert.Equal(t, first.ID, again.ID)
+	assert.Equal(t, int64(10), first.OrderID)
+	assert.Equal(t, int64(2), first.RecipientID)
+	assert.Equal(t, int64(1), first.PickupPointID)
+
+	arrived := NewNotification(NotificationOrderArrived, order)
+	assert.NotEqual(t, first.ID, arrived.ID)
+
+	order.StorageUntil = storageUntil.Add(72 * time.Hour)
+	extended := NewNotification(NotificationOrderExpiresTomorrow, order)
+	assert.NotEqual(t, first.ID, extended.ID)
+}
+
+func TestRecipient_Contact(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		recipient   Recipient
+		wantChannel ContactChannel
+		wantAddress string
+		wantOK      bool
+	}{
+		{
+			name:        "sms",
+			recipient:   Recipient{Phone: "+79991234567", Email: "ivan@example.com", PreferredChannel: ContactChannelSMS},
+			wantChannel: ContactChannelSMS,
+			wantAddress: "+79991234567",
+			wantOK:      true,
+		},
+		{
+			name:        "email",
+			recipient:   Recipient{Phone: "+79991234567", Email: "ivan@example.com", PreferredChannel: ContactChannelEmail},
+			wantChannel: ContactChannelEmail,
+			wantAddress: "ivan@example.com",
+			wantOK:      true,
+		},
+		{
+			name:      "unregistered",
+			recipient: Recipient{ID: 5},
+			wantOK:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			channel, address, ok := tt.recipient.Contact()
+
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantChannel, channel)
+				assert.Equal(t, tt.wantAddress, address)
+			}
+		})
+	}
+}
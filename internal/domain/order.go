@@ -5,21 +5,39 @@ import (
 	"time"
 
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
-	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/hash"
 )
 
 // Order структура заказа
 type Order struct {
-	ID           int64             `db:"id"`
-	RecipientID  int64             `db:"recipient_id"`
-	Weight       float64           `db:"weight"`
-	Cost         float64           `db:"order_cost"`
-	PackageCost  float64           `db:"package_cost"`
-	PackageType  *OrderPackageType `db:"package_type"`
-	StorageUntil time.Time         `db:"storage_until"`
-	IssuedAt     sql.NullTime      `db:"issued_at"`
-	ReturnedAt   sql.NullTime      `db:"returned_at"`
-	Hash         string            `db:"hash"`
+	ID            int64         `db:"id"`
+	RecipientID   int64         `db:"recipient_id"`
+	PickupPointID int64         `db:"pvz_id"`
+	CourierID     sql.NullInt64 `db:"courier_id"`
+	CellID        sql.NullInt64 `db:"cell_id"`
+	Weight        float64       `db:"weight"`
+	Dimensions
+	Cost                    int64             `db:"order_cost"`
+	PackageCost             int64             `db:"package_cost"`
+	Currency                string            `db:"currency"`
+	StorageFee              int64             `db:"storage_fee"`
+	PackageType             *OrderPackageType `db:"package_type"`
+	Category                string            `db:"category"`
+	Status                  OrderStatus       `db:"status"`
+	StorageUntil            time.Time         `db:"storage_until"`
+	OriginalStorageUntil    time.Time         `db:"original_storage_until"`
+	ExtensionCount          int               `db:"extension_count"`
+	PickupCodeHash          string            `db:"pickup_code_hash"`
+	PickupCodeAttempts      int               `db:"pickup_code_attempts"`
+	PickupCodeRegeneratedAt sql.NullTime      `db:"pickup_code_regenerated_at"`
+	IssuedAt                sql.NullTime      `db:"issued_at"`
+	ReturnedAt              sql.NullTime      `db:"returned_at"`
+	ReturnedToCourierAt     sql.NullTime      `db:"returned_to_courier_at"`
+	ReturnReason            string            `db:"return_reason"`
+	ReturnCondition         ReturnCondition   `db:"return_condition"`
+	ReturnNote              string            `db:"return_note"`
+	RefundAmount            sql.NullInt64     `db:"refund_amount"`
+	Hash                    string            `db:"hash"`
+	Version                 int64             `db:"version"`
 }
 
 func NewOrder(order *dto.Order, packageType *OrderPackageType) (*Order, error) {
@@ -28,27 +46,94 @@ func NewOrder(order *dto.Order, packageType *OrderPackageType) (*Order, error) {
 		return nil, err
 	}
 
+	dimensions := Dimensions{Length: order.Length, Width: order.Width, Height: order.Height}
+
+	err = packageType.ValidateDimensions(dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := NewMoney(order.Cost, order.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	packagePrice := packageType.GetPackageCost()
+
+	// упаковка оплачивается в валюте заказа; бесплатная упаковка подходит к любой валюте
+	if packagePrice.Amount > 0 && packagePrice.Currency != price.Currency {
+		return nil, ErrCurrencyMismatch
+	}
+
 	return &Order{
-		ID:           order.OrderID,
-		RecipientID:  order.RecipientID,
-		Weight:       order.Weight,
-		Cost:         order.Cost,
-		PackageCost:  packageType.GetPackageCost(),
-		PackageType:  packageType,
-		StorageUntil: order.StorageUntil.UTC(),
-		IssuedAt:     sql.NullTime{Time: order.IssuedAt.UTC(), Valid: true},
-		ReturnedAt:   sql.NullTime{Time: order.ReturnAt.UTC(), Valid: true},
-		Hash:         hash.GenerateHash(),
+		ID:                   order.OrderID,
+		RecipientID:          order.RecipientID,
+		PickupPointID:        order.PickupPointID,
+		CourierID:            sql.NullInt64{Int64: order.CourierID, Valid: order.CourierID > 0},
+		Weight:               order.Weight,
+		Dimensions:           dimensions,
+		Cost:                 price.Amount,
+		PackageCost:          packagePrice.Amount,
+		Currency:             price.Currency,
+		PackageType:          packageType,
+		Category:             order.Category,
+		Status:               OrderStatusAccepted,
+		StorageUntil:         order.StorageUntil.UTC(),
+		OriginalStorageUntil: order.StorageUntil.UTC(),
+		IssuedAt:             sql.NullTime{},
+		ReturnedAt:           sql.NullTime{},
+		ReturnedToCourierAt:  sql.NullTime{},
+		Version:              1,
 	}, nil
 }
 
+// TotalCost возвращает стоимость заказа вместе с упаковкой
+func (o *Order) TotalCost() Money {
+	return Money{Amount: o.Cost + o.PackageCost, Currency: o.Currency}
+}
+
 // ToDomain преобразует сущность БД в сущность DTO
 func ToDomain(order *Order) *dto.Order {
-	return &dto.Order{
-		OrderID:      order.ID,
-		RecipientID:  order.RecipientID,
-		StorageUntil: order.StorageUntil,
-		IssuedAt:     order.IssuedAt.Time,
-		ReturnAt:     order.ReturnedAt.Time,
+	orderDTO := &dto.Order{
+		OrderID:              order.ID,
+		RecipientID:          order.RecipientID,
+		PickupPointID:        order.PickupPointID,
+		CourierID:            order.CourierID.Int64,
+		CellID:               order.CellID.Int64,
+		StorageUntil:         order.StorageUntil,
+		OriginalStorageUntil: order.OriginalStorageUntil,
+		ExtensionCount:       order.ExtensionCount,
+		PickupCodeAttempts:   order.PickupCodeAttempts,
+		Status:               string(order.Status),
+		IssuedAt:             order.IssuedAt.Time,
+		ReturnAt:             order.ReturnedAt.Time,
+		ReturnedToCourierAt:  order.ReturnedToCourierAt.Time,
+		Weight:               order.Weight,
+		Length:               order.Length,
+		Width:                order.Width,
+		Height:               order.Height,
+		Cost:                 order.Cost,
+		PackageCost:          order.PackageCost,
+		Currency:             order.Currency,
+		TotalCost:            order.TotalCost().Amount,
+		StorageFee:           order.StorageFee,
+		Category:             order.Category,
+		ReturnReason:         order.ReturnReason,
+		ReturnCondition:      string(order.ReturnCondition),
+		ReturnNote:           order.ReturnNote,
+		Hash:                 order.Hash,
+		Version:              order.Version,
 	}
+
+	if order.RefundAmount.Valid {
+		refund := order.RefundAmount.Int64
+		orderDTO.RefundAmount = &refund
+	}
+
+	if order.PackageType != nil {
+		orderDTO.PackageType = order.PackageType.Type()
+		orderDTO.PackageLayers = order.PackageType.Layers()
+	}
+
+	return orderDTO
 }
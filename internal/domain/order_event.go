@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+// Операции модуля, которые меняют статус, срок хранения, код выдачи или ячейку заказа
+const (
+	OperationAcceptOrderCourier   = "accept_order_courier"
+	OperationReturnOrderCourier   = "return_order_courier"
+	OperationIssueOrderClient     = "issue_order_client"
+	OperationAcceptReturnClient   = "accept_return_client"
+	OperationExtendStorage        = "extend_storage"
+	OperationRegeneratePickupCode = "regenerate_pickup_code"
+	OperationFlagExpiredOrder     = "flag_expired_order"
+	OperationMoveOrderToCell      = "move_order_to_cell"
+)
+
+// OrderEvent запись истории изменения статуса заказа
+type OrderEvent struct {
+	ID            int64       `db:"id"`
+	OrderID       int64       `db:"order_id"`
+	PickupPointID int64       `db:"pvz_id"`
+	OldStatus     OrderStatus `db:"old_status"`
+	NewStatus     OrderStatus `db:"new_status"`
+	Operation     string      `db:"operation"`
+	Payload       []byte      `db:"payload"`
+	CreatedAt     time.Time   `db:"created_at"`
+}
+
+// NewOrderEvent создает запись истории для перехода заказа из статуса from в текущий статус
+func NewOrderEvent(order *Order, from OrderStatus, operation string, payload any) (*OrderEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderEvent{
+		OrderID:       order.ID,
+		PickupPointID: order.PickupPointID,
+		OldStatus:     from,
+		NewStatus:     order.Status,
+		Operation:     operation,
+		Payload:       data,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// ToOrderEventDTO преобразует запись истории в сущность DTO
+func ToOrderEventDTO(event *OrderEvent) *dto.OrderEvent {
+	return &dto.OrderEvent{
+		ID:        event.ID,
+		OrderID:   event.OrderID,
+		OldStatus: string(event.OldStatus),
+		NewStatus: string(event.NewStatus),
+		Operation: event.Operation,
+		Payload:   string(event.Payload),
+		CreatedAt: event.CreatedAt,
+	}
+}
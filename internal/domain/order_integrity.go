@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/hash"
+)
+
+// IntegrityStatus результат проверки целостности заказа
+type IntegrityStatus string
+
+const (
+	IntegrityStatusOK       IntegrityStatus = "ok"
+	IntegrityStatusTampered IntegrityStatus = "tampered"
+	// IntegrityStatusUnsealed заказ сохранен до появления дайджеста и еще не обновлялся
+	IntegrityStatusUnsealed IntegrityStatus = "unsealed"
+)
+
+// digestVersion префикс дайджеста текущего формата. Прежний формат включал часть полей только при их наличии,
+// поэтому дайджесты без префикса проверяются как неподписанные, пока заказ не будет обновлен и переподписан.
+const digestVersion = "v2:"
+
+// IntegrityViolation заказ, не прошедший проверку целостности
+type IntegrityViolation struct {
+	OrderID int64
+	Status  IntegrityStatus
+}
+
+// Seal пересчитывает дайджест заказа. Вызывается перед каждой записью заказа в БД.
+func (o *Order) Seal(key []byte) {
+	o.Hash = digestVersion + o.Digest(key)
+}
+
+// Digest возвращает дайджест ключевых полей заказа. Набор полей фиксирован:
+// пустое поле входит в дайджест пустой строкой, поэтому его нельзя заполнить в обход сервиса.
+func (o *Order) Digest(key []byte) string {
+	packageType := ""
+	if o.PackageType != nil {
+		packageType = o.PackageType.Type()
+	}
+
+	courierID := ""
+	if o.CourierID.Valid {
+		courierID = strconv.FormatInt(o.CourierID.Int64, 10)
+	}
+
+	cellID := ""
+	if o.CellID.Valid {
+		cellID = strconv.FormatInt(o.CellID.Int64, 10)
+	}
+
+	refund := ""
+	if o.RefundAmount.Valid {
+		refund = strconv.FormatInt(o.RefundAmount.Int64, 10)
+	}
+
+	fields := []string{
+		strconv.FormatInt(o.ID, 10),
+		strconv.FormatInt(o.RecipientID, 10),
+		strconv.FormatInt(o.PickupPointID, 10),
+		courierID,
+		string(o.Status),
+		packageType,
+		formatFloat(o.Weight),
+		formatFloat(o.Length),
+		formatFloat(o.Width),
+		formatFloat(o.Height),
+		strconv.FormatInt(o.Cost, 10),
+		strconv.FormatInt(o.PackageCost, 10),
+		o.Currency,
+		strconv.FormatInt(o.StorageFee, 10),
+		formatTime(o.StorageUntil),
+		formatTime(o.OriginalStorageUntil),
+		strconv.Itoa(o.ExtensionCount),
+		formatNullTime(o.IssuedAt),
+		formatNullTime(o.ReturnedAt),
+		formatNullTime(o.ReturnedToCourierAt),
+		o.PickupCodeHash,
+		strconv.Itoa(o.PickupCodeAttempts),
+		o.Category,
+		o.ReturnReason,
+		string(o.ReturnCondition),
+		o.ReturnNote,
+		refund,
+		formatNullTime(o.PickupCodeRegeneratedAt),
+		cellID,
+	}
+
+	return hash.Digest(key, fields...)
+}
+
+// CheckIntegrity сравнивает сохраненный дайджест заказа с пересчитанным
+func (o *Order) CheckIntegrity(key []byte) IntegrityStatus {
+	digest, found := strings.CutPrefix(o.Hash, digestVersion)
+	if !found || !hash.IsDigest(digest) {
+		return IntegrityStatusUnsealed
+	}
+
+	if !hash.Equal(digest, o.Digest(key)) {
+		return IntegrityStatusTampered
+	}
+
+	return IntegrityStatusOK
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// formatTime приводит время к виду, в котором его хранит колонка TIMESTAMP:
+// без часового пояса и с точностью до микросекунд
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Truncate(time.Microsecond).Format("2006-01-02T15:04:05.000000")
+}
+
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+
+	return formatTime(t.Time)
+}
+
+// ToIntegrityViolationDTO преобразует нарушение целостности в DTO
+func ToIntegrityViolationDTO(violation IntegrityViolation) *dto.IntegrityViolation {
+	return &dto.IntegrityViolation{
+		OrderID: violation.OrderID,
+		Status:  string(violation.Status),
+	}
+}
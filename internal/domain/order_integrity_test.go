@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrder_CheckIntegrity(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("secret")
+	storageUntil := time.Date(2024, 7, 30, 12, 0, 0, 123456789, time.UTC)
+
+	newOrder := func() *Order {
+		packageType, _ := NewPackageType("box")
+
+		order := &Order{
+			ID:           1,
+			RecipientID:  2,
+			CourierID:    sql.NullInt64{Int64: 3, Valid: true},
+			Weight:       5.5,
+			Cost:         12000,
+			Currency:     "RUB",
+			PackageType:  packageType,
+			Status:       OrderStatusAccepted,
+			StorageUntil: storageUntil,
+		}
+		order.Seal(key)
+
+		return order
+	}
+
+	t.Run("sealed order", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, IntegrityStatusOK, newOrder().CheckIntegrity(key))
+	})
+
+	t.Run("changed field", func(t *testing.T) {
+		t.Parallel()
+
+		order := newOrder()
+		order.Status = OrderStatusIssued
+
+		assert.Equal(t, IntegrityStatusTampered, order.CheckIntegrity(key))
+	})
+
+	t.Run("return fields changed on order that was not returned", func(t *testing.T) {
+		t.Parallel()
+
+		changes := map[string]func(*Order){
+			"condition": func(o *Order) { o.ReturnCondition = ReturnConditionDamaged },
+			"note":      func(o *Order) { o.ReturnNote = "note" },
+			"refund":    func(o *Order) { o.RefundAmount = sql.NullInt64{Int64: 100, Valid: true} },
+			"category":  func(o *Order) { o.Category = "electronics" },
+		}
+
+		for name, change := range changes {
+			order := newOrder()
+			change(order)
+
+			assert.Equal(t, IntegrityStatusTampered, order.CheckIntegrity(key), name)
+		}
+	})
+
+	t.Run("cell changed", func(t *testing.T) {
+		t.Parallel()
+
+		changes := map[string]func(*Order){
+			"placed":  func(o *Order) { o.CellID = sql.NullInt64{Int64: 5, Valid: true} },
+			"removed": func(o *Order) { o.CellID = sql.NullInt64{} },
+		}
+
+		for name, change := range changes {
+			order := newOrder()
+			order.CellID = sql.NullInt64{Int64: 4, Valid: true}
+			order.Seal(key)
+			change(order)
+
+			assert.Equal(t, IntegrityStatusTampered, order.CheckIntegrity(key), name)
+		}
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, IntegrityStatusTampered, newOrder().CheckIntegrity([]byte("other")))
+	})
+
+	t.Run("legacy hash", func(t *testing.T) {
+		t.Parallel()
+
+		order := newOrder()
+		order.Hash = "3f2b8c1e-7a4d-4c2e-9b1a-5d6e7f8a9b0c"
+
+		assert.Equal(t, IntegrityStatusUnsealed, order.CheckIntegrity(key))
+	})
+
+	t.Run("digest of previous format", func(t *testing.T) {
+		t.Parallel()
+
+		order := newOrder()
+		order.Hash = order.Digest(key)
+
+		assert.Equal(t, IntegrityStatusUnsealed, order.CheckIntegrity(key))
+	})
+
+	t.Run("timestamps read back from database", func(t *testing.T) {
+		t.Parallel()
+
+		order := newOrder()
+		// колонка TIMESTAMP хранит микросекунды
+		order.StorageUntil = storageUntil.Truncate(time.Microsecond)
+
+		assert.Equal(t, IntegrityStatusOK, order.CheckIntegrity(key))
+	})
+}
@@ -25,12 +25,12 @@ func TestNewOrder(t *testing.T) {
 		{
 			name: "Valid orderDTO without package [empty input]",
 			args: args{
-				packageType: &OrderPackageType{OrderPackager: newDefaultPackage()},
+				packageType: &OrderPackageType{OrderPackager: testPackageType(DefaultPackageType)},
 				order: &dto.Order{
 					OrderID:      1,
 					RecipientID:  10,
 					Weight:       5.0,
-					Cost:         100.0,
+					Cost:         10000,
 					StorageUntil: time.Now().Add(24 * time.Hour),
 				},
 			},
@@ -39,12 +39,12 @@ func TestNewOrder(t *testing.T) {
 		{
 			name: "Valid orderDTO with package",
 			args: args{
-				packageType: &OrderPackageType{OrderPackager: newStandardPackage()},
+				packageType: &OrderPackageType{OrderPackager: testPackageType("package")},
 				order: &dto.Order{
 					OrderID:      1,
 					RecipientID:  10,
 					Weight:       5.0,
-					Cost:         100.0,
+					Cost:         10000,
 					StorageUntil: time.Now().Add(24 * time.Hour),
 				},
 			},
@@ -53,12 +53,12 @@ func TestNewOrder(t *testing.T) {
 		{
 			name: "Valid orderDTO with box package",
 			args: args{
-				packageType: &OrderPackageType{OrderPackager: newBoxPackage()},
+				packageType: &OrderPackageType{OrderPackager: testPackageType("box")},
 				order: &dto.Order{
 					OrderID:      1,
 					RecipientID:  10,
 					Weight:       5.0,
-					Cost:         100.0,
+					Cost:         10000,
 					StorageUntil: time.Now().Add(24 * time.Hour),
 				},
 			},
@@ -67,12 +67,12 @@ func TestNewOrder(t *testing.T) {
 		{
 			name: "Valid orderDTO with film package",
 			args: args{
-				packageType: &OrderPackageType{OrderPackager: newFilmPackage()},
+				packageType: &OrderPackageType{OrderPackager: testPackageType("film")},
 				order: &dto.Order{
 					OrderID:      1,
 					RecipientID:  10,
 					Weight:       5.0,
-					Cost:         100.0,
+					Cost:         10000,
 					StorageUntil: time.Now().Add(24 * time.Hour),
 				},
 			},
@@ -80,12 +80,12 @@ func TestNewOrder(t *testing.T) {
 		{
 			name: "Negative weight",
 			args: args{
-				packageType: &OrderPackageType{OrderPackager: newFilmPackage()},
+				packageType: &OrderPackageType{OrderPackager: testPackageType("film")},
 				order: &dto.Order{
 					OrderID:      1,
 					RecipientID:  10,
 					Weight:       -5.0,
-					Cost:         100.0,
+					Cost:         10000,
 					StorageUntil: time.Now().Add(24 * time.Hour),
 				},
 			},
@@ -94,20 +94,50 @@ func TestNewOrder(t *testing.T) {
 		{
 			name: "Heavy weight",
 			args: args{
-				packageType: &OrderPackageType{OrderPackager: newBoxPackage()},
+				packageType: &OrderPackageType{OrderPackager: testPackageType("box")},
 				order: &dto.Order{
 					OrderID:      1,
 					RecipientID:  10,
 					Weight:       120.0,
-					Cost:         100.0,
+					Cost:         10000,
 					StorageUntil: time.Now().Add(24 * time.Hour),
 				},
 			},
 			expectedErr: ErrWeightExceedsLimit{
 				Weight: 120.0,
-				Limit:  BoxMaxWeight,
+				Limit:  30,
 			},
 		},
+		{
+			name: "Paid package in another currency",
+			args: args{
+				packageType: &OrderPackageType{OrderPackager: testPackageType("box")},
+				order: &dto.Order{
+					OrderID:      1,
+					RecipientID:  10,
+					Weight:       5.0,
+					Cost:         10000,
+					Currency:     "USD",
+					StorageUntil: time.Now().Add(24 * time.Hour),
+				},
+			},
+			expectedErr: ErrCurrencyMismatch,
+		},
+		{
+			name: "Invalid currency",
+			args: args{
+				packageType: &OrderPackageType{OrderPackager: testPackageType("film")},
+				order: &dto.Order{
+					OrderID:      1,
+					RecipientID:  10,
+					Weight:       5.0,
+					Cost:         10000,
+					Currency:     "rubles",
+					StorageUntil: time.Now().Add(24 * time.Hour),
+				},
+			},
+			expectedErr: ErrCurrencyInvalid,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -132,3 +162,20 @@ func TestNewOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestOrder_TotalCost(t *testing.T) {
+	t.Parallel()
+
+	order, err := NewOrder(&dto.Order{
+		OrderID:      1,
+		RecipientID:  10,
+		Weight:       5.0,
+		Cost:         10075,
+		StorageUntil: time.Now().Add(24 * time.Hour),
+	}, &OrderPackageType{OrderPackager: testPackageType("box")})
+	require.NoError(t, err)
+
+	assert.Equal(t, "RUB", order.Currency)
+	assert.Equal(t, int64(2000), order.PackageCost)
+	assert.Equal(t, Money{Amount: 12075, Currency: "RUB"}, order.TotalCost())
+}
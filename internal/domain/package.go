@@ -3,12 +3,20 @@ package domain
 import (
 	"database/sql/driver"
 	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/money"
 )
 
 // OrderPackager определяет интерфейс для типов упаковки
 type OrderPackager interface {
 	ValidateWeight(weight float64) error
-	GetPackageCost() float64
+	ValidateDimensions(dimensions Dimensions) error
+	GetPackageCost() Money
 	Type() string
 }
 
@@ -16,133 +24,336 @@ type OrderPackageType struct {
 	OrderPackager
 }
 
-// Реализация различных типов упаковки
-type Default struct{}
-type StandardPackage struct{}
-type Box struct{}
-type Film struct{}
-
-func newDefaultPackage() OrderPackager  { return &Default{} }
-func newStandardPackage() OrderPackager { return &StandardPackage{} }
-func newBoxPackage() OrderPackager      { return &Box{} }
-func newFilmPackage() OrderPackager     { return &Film{} }
-
-// PackageTypeKey тип для определения ключей типов упаковки
-type PackageTypeKey string
-
-const (
-	DefaultPackageKey PackageTypeKey = "without package"
-	PackageKey        PackageTypeKey = "package"
-	BoxKey            PackageTypeKey = "box"
-	FilmKey           PackageTypeKey = "film"
-)
-
-const (
-	DefaultType = "without package"
-	PackageType = "package"
-	BoxType     = "box"
-	FilmType    = "film"
-)
-
-const (
-	DefaultCost float64 = 0
-	FilmCost    float64 = 0
-	PackageCost float64 = 5
-	BoxCost     float64 = 20
-)
+// DefaultPackageType название типа, который используется, если упаковка не указана
+const DefaultPackageType = "without package"
 
-const (
-	PackageMaxWeight float64 = 10
-	BoxMaxWeight     float64 = 30
-)
+// PackageLayerSeparator разделитель слоев составной упаковки при хранении в БД
+const PackageLayerSeparator = "+"
 
-// packageConstructors карта для хранения конструкторов типов упаковки
-var packageConstructors = map[PackageTypeKey]func() OrderPackager{
-	DefaultPackageKey: newDefaultPackage,
-	PackageKey:        newStandardPackage,
-	BoxKey:            newBoxPackage,
-	FilmKey:           newFilmPackage,
+// PackageType описание типа упаковки из реестра.
+// MaxWeight равный нулю означает, что ограничения по весу нет.
+type PackageType struct {
+	Name      string  `db:"name"`
+	MaxWeight float64 `db:"max_weight"`
+	DimensionLimits
+	Cost      int64     `db:"cost"`
+	Currency  string    `db:"currency"`
+	Active    bool      `db:"active"`
+	CreatedAt time.Time `db:"created_at"`
 }
 
-// toOrderPackageType для преобразования строки в PackageTypeKey
-func toOrderPackageType(packageType string) (PackageTypeKey, error) {
-	switch packageType {
-	case "package":
-		return PackageKey, nil
-	case "box":
-		return BoxKey, nil
-	case "film":
-		return FilmKey, nil
-	case "", "without package":
-		return DefaultPackageKey, nil
-	default:
-		return "", ErrPackageTypeUnsupported
+// NewPackageTypeSpec создает активный тип упаковки для добавления в реестр
+func NewPackageTypeSpec(name string, maxWeight float64, cost Money, limits DimensionLimits) (*PackageType, error) {
+	if name == "" || strings.Contains(name, PackageLayerSeparator) || maxWeight < 0 {
+		return nil, ErrPackageTypeInvalid
 	}
-}
 
-// NewPackageType функция для создания нового типа упаковки
-func NewPackageType(packageType string) (*OrderPackageType, error) {
-	key, err := toOrderPackageType(packageType)
+	cost, err := NewMoney(cost.Amount, cost.Currency)
 	if err != nil {
 		return nil, err
 	}
 
-	if constructor, exists := packageConstructors[key]; exists {
-		orderPackager := constructor()
-		return &OrderPackageType{OrderPackager: orderPackager}, nil
+	if limits.MaxLength < 0 || limits.MaxWidth < 0 || limits.MaxHeight < 0 || limits.VolumetricDivisor < 0 {
+		return nil, ErrPackageTypeInvalid
 	}
 
-	return nil, ErrPackageTypeUnsupported
+	return &PackageType{
+		Name:            name,
+		MaxWeight:       maxWeight,
+		DimensionLimits: limits,
+		Cost:            cost.Amount,
+		Currency:        cost.Currency,
+		Active:          true,
+		CreatedAt:       time.Now().UTC(),
+	}, nil
 }
 
-// Реализация методов OrderPackager для каждого типа упаковки
-func (d *Default) ValidateWeight(weight float64) error {
-	if weight <= 0 {
-		return ErrWeightNegative
+// DefaultPackageTypes типы упаковки, с которыми реестр работает до загрузки из БД
+func DefaultPackageTypes() []*PackageType {
+	return []*PackageType{
+		{Name: DefaultPackageType, MaxWeight: 0, Cost: 0, Currency: money.DefaultCurrency, Active: true},
+		{
+			Name:      "package",
+			MaxWeight: 10,
+			DimensionLimits: DimensionLimits{
+				MaxLength: 60, MaxWidth: 40, MaxHeight: 20, VolumetricDivisor: 5000,
+			},
+			Cost:     500,
+			Currency: money.DefaultCurrency,
+			Active:   true,
+		},
+		{
+			Name:      "box",
+			MaxWeight: 30,
+			DimensionLimits: DimensionLimits{
+				MaxLength: 120, MaxWidth: 80, MaxHeight: 80, VolumetricDivisor: 5000,
+			},
+			Cost:     2000,
+			Currency: money.DefaultCurrency,
+			Active:   true,
+		},
+		{Name: "film", MaxWeight: 0, Cost: 0, Currency: money.DefaultCurrency, Active: true},
 	}
-	return nil
 }
-func (d *Default) GetPackageCost() float64 { return DefaultCost }
-func (d *Default) Type() string            { return DefaultType }
 
-func (p *StandardPackage) ValidateWeight(weight float64) error {
+// Реализация методов OrderPackager для типа упаковки из реестра
+func (p *PackageType) ValidateWeight(weight float64) error {
 	if weight <= 0 {
 		return ErrWeightNegative
 	}
-	if weight > PackageMaxWeight {
+	if p.MaxWeight > 0 && weight > p.MaxWeight {
 		return ErrWeightExceedsLimit{
 			Weight: weight,
-			Limit:  PackageMaxWeight,
+			Limit:  p.MaxWeight,
 		}
 	}
 	return nil
 }
-func (p *StandardPackage) GetPackageCost() float64 { return PackageCost }
-func (p *StandardPackage) Type() string            { return PackageType }
 
-func (b *Box) ValidateWeight(weight float64) error {
-	if weight <= 0 {
-		return ErrWeightNegative
+// ValidateDimensions проверяет габариты посылки и ее объемный вес.
+// Если габариты не переданы, проверка пропускается.
+func (p *PackageType) ValidateDimensions(dimensions Dimensions) error {
+	if err := dimensions.Validate(); err != nil {
+		return err
 	}
-	if weight > BoxMaxWeight {
-		return ErrWeightExceedsLimit{
-			Weight: weight,
-			Limit:  BoxMaxWeight,
+	if dimensions.IsZero() {
+		return nil
+	}
+	if err := p.DimensionLimits.validate(dimensions); err != nil {
+		return err
+	}
+
+	volumetricWeight := p.VolumetricWeight(dimensions)
+	if p.MaxWeight > 0 && volumetricWeight > p.MaxWeight {
+		return ErrVolumetricWeightExceedsLimit{
+			VolumetricWeight: volumetricWeight,
+			Limit:            p.MaxWeight,
 		}
 	}
 	return nil
 }
-func (b *Box) GetPackageCost() float64 { return BoxCost }
-func (b *Box) Type() string            { return BoxType }
 
-func (f *Film) ValidateWeight(weight float64) error {
-	if weight <= 0 {
-		return ErrWeightNegative
+func (p *PackageType) GetPackageCost() Money {
+	return Money{Amount: p.Cost, Currency: p.Currency}
+}
+func (p *PackageType) Type() string { return p.Name }
+
+// PackageTypeRegistry потокобезопасный реестр типов упаковки
+type PackageTypeRegistry struct {
+	mu    sync.RWMutex
+	types map[string]*PackageType
+}
+
+// NewPackageTypeRegistry создает реестр с переданными типами упаковки
+func NewPackageTypeRegistry(types ...*PackageType) *PackageTypeRegistry {
+	r := &PackageTypeRegistry{}
+	r.Load(types)
+
+	return r
+}
+
+// Load заменяет содержимое реестра переданными типами упаковки
+func (r *PackageTypeRegistry) Load(types []*PackageType) {
+	loaded := make(map[string]*PackageType, len(types))
+	for _, packageType := range types {
+		copied := *packageType
+		loaded[packageType.Name] = &copied
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.types = loaded
+}
+
+// Register добавляет тип упаковки в реестр или заменяет существующий
+func (r *PackageTypeRegistry) Register(packageType *PackageType) {
+	copied := *packageType
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.types[packageType.Name] = &copied
+}
+
+// Deactivate запрещает принимать заказы с указанным типом упаковки
+func (r *PackageTypeRegistry) Deactivate(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if packageType, ok := r.types[name]; ok {
+		copied := *packageType
+		copied.Active = false
+		r.types[name] = &copied
+	}
+}
+
+// Lookup возвращает тип упаковки вне зависимости от того, активен ли он
+func (r *PackageTypeRegistry) Lookup(name string) (*PackageType, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	packageType, ok := r.types[name]
+
+	return packageType, ok
+}
+
+// List возвращает все типы упаковки, отсортированные по названию
+func (r *PackageTypeRegistry) List() []*PackageType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]*PackageType, 0, len(r.types))
+	for _, packageType := range r.types {
+		types = append(types, packageType)
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+
+	return types
+}
+
+// packageTypes реестр, из которого читает NewPackageType
+var packageTypes = NewPackageTypeRegistry(DefaultPackageTypes()...)
+
+// PackageTypes возвращает реестр типов упаковки
+func PackageTypes() *PackageTypeRegistry {
+	return packageTypes
+}
+
+// normalizePackageType приводит пустое название к типу по умолчанию
+func normalizePackageType(packageType string) string {
+	if packageType == "" {
+		return DefaultPackageType
+	}
+	return packageType
+}
+
+// NewPackageType функция для создания нового типа упаковки.
+// Принимаются только активные типы из реестра.
+func NewPackageType(packageType string) (*OrderPackageType, error) {
+	spec, ok := packageTypes.Lookup(normalizePackageType(packageType))
+	if !ok {
+		return nil, ErrPackageTypeUnsupported
+	}
+
+	if !spec.Active {
+		return nil, ErrPackageTypeInactive
+	}
+
+	return &OrderPackageType{OrderPackager: spec}, nil
+}
+
+// CompositePackage упаковка из нескольких слоев, например коробка в пленке.
+// Вес ограничивается самым строгим из слоев, стоимость слоев складывается.
+type CompositePackage struct {
+	layers []OrderPackager
+}
+
+func (c *CompositePackage) ValidateWeight(weight float64) error {
+	var exceeded *ErrWeightExceedsLimit
+
+	for _, layer := range c.layers {
+		err := layer.ValidateWeight(weight)
+
+		var limitErr ErrWeightExceedsLimit
+		switch {
+		case err == nil:
+			continue
+		case errors.As(err, &limitErr):
+			if exceeded == nil || limitErr.Limit < exceeded.Limit {
+				exceeded = &limitErr
+			}
+		default:
+			return err
+		}
+	}
+
+	if exceeded != nil {
+		return *exceeded
 	}
 	return nil
 }
-func (f *Film) GetPackageCost() float64 { return FilmCost }
-func (f *Film) Type() string            { return FilmType }
+
+// ValidateDimensions проверяет габариты по каждому слою и возвращает первое нарушение
+func (c *CompositePackage) ValidateDimensions(dimensions Dimensions) error {
+	for _, layer := range c.layers {
+		if err := layer.ValidateDimensions(dimensions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *CompositePackage) GetPackageCost() Money {
+	// совместимость валют слоев проверяется в NewCompositePackageType
+	cost, _ := c.cost()
+	return cost
+}
+
+// cost складывает стоимость слоев
+func (c *CompositePackage) cost() (Money, error) {
+	var total Money
+	for _, layer := range c.layers {
+		var err error
+
+		total, err = total.Add(layer.GetPackageCost())
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+func (c *CompositePackage) Type() string { return strings.Join(c.Layers(), PackageLayerSeparator) }
+
+// Layers возвращает названия слоев в порядке их наложения
+func (c *CompositePackage) Layers() []string {
+	names := make([]string, 0, len(c.layers))
+	for _, layer := range c.layers {
+		names = append(names, layer.Type())
+	}
+	return names
+}
+
+// NewCompositePackageType создает упаковку из слоев в указанном порядке.
+// Для одного слоя возвращается обычный тип упаковки.
+func NewCompositePackageType(layers []string) (*OrderPackageType, error) {
+	if len(layers) <= 1 {
+		name := ""
+		if len(layers) == 1 {
+			name = layers[0]
+		}
+		return NewPackageType(name)
+	}
+
+	packagers := make([]OrderPackager, 0, len(layers))
+	for _, layer := range layers {
+		packageType, err := NewPackageType(layer)
+		if err != nil {
+			return nil, err
+		}
+		packagers = append(packagers, packageType.OrderPackager)
+	}
+
+	composite := &CompositePackage{layers: packagers}
+	if _, err := composite.cost(); err != nil {
+		return nil, err
+	}
+
+	return &OrderPackageType{OrderPackager: composite}, nil
+}
+
+// Layers возвращает названия слоев упаковки; у обычной упаковки слой один
+func (opt OrderPackageType) Layers() []string {
+	if opt.OrderPackager == nil {
+		return nil
+	}
+	if composite, ok := opt.OrderPackager.(*CompositePackage); ok {
+		return composite.Layers()
+	}
+	return []string{opt.OrderPackager.Type()}
+}
 
 // Реализация интерфейсов Valuer и Scanner для OrderPackageType
 func (opt OrderPackageType) Value() (driver.Value, error) {
@@ -152,6 +363,8 @@ func (opt OrderPackageType) Value() (driver.Value, error) {
 	return opt.OrderPackager.Type(), nil
 }
 
+// Scan восстанавливает тип упаковки сохраненного заказа.
+// Деактивированные и отсутствующие в реестре типы тоже читаются, чтобы старые заказы оставались доступны.
 func (opt *OrderPackageType) Scan(src interface{}) error {
 	if src == nil {
 		opt.OrderPackager = nil
@@ -163,11 +376,44 @@ func (opt *OrderPackageType) Scan(src interface{}) error {
 		return errors.New("source is not a string")
 	}
 
-	packageType, err := NewPackageType(str)
-	if err != nil {
-		return err
+	names := strings.Split(str, PackageLayerSeparator)
+	if len(names) == 1 {
+		opt.OrderPackager = storedPackageType(names[0])
+		return nil
+	}
+
+	layers := make([]OrderPackager, 0, len(names))
+	for _, name := range names {
+		layers = append(layers, storedPackageType(name))
 	}
 
-	*opt = *packageType
+	opt.OrderPackager = &CompositePackage{layers: layers}
 	return nil
 }
+
+// storedPackageType ищет тип упаковки сохраненного заказа, не проверяя, активен ли он
+func storedPackageType(name string) *PackageType {
+	name = normalizePackageType(name)
+
+	spec, ok := packageTypes.Lookup(name)
+	if !ok {
+		return &PackageType{Name: name}
+	}
+	return spec
+}
+
+// ToPackageTypeDTO преобразует тип упаковки в сущность DTO
+func ToPackageTypeDTO(packageType *PackageType) *dto.PackageType {
+	return &dto.PackageType{
+		Name:              packageType.Name,
+		MaxWeight:         packageType.MaxWeight,
+		MaxLength:         packageType.MaxLength,
+		MaxWidth:          packageType.MaxWidth,
+		MaxHeight:         packageType.MaxHeight,
+		VolumetricDivisor: packageType.VolumetricDivisor,
+		Cost:              packageType.Cost,
+		Currency:          packageType.Currency,
+		Active:            packageType.Active,
+		CreatedAt:         packageType.CreatedAt,
+	}
+}
@@ -19,67 +19,67 @@ func TestValidateWeight(t *testing.T) {
 	}{
 		{
 			name:        "Default package, valid weight",
-			packageType: OrderPackageType{OrderPackager: newDefaultPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType(DefaultPackageType)},
 			weight:      5,
 			expectedErr: nil,
 		},
 		{
 			name:        "Default package, negative weight",
-			packageType: OrderPackageType{OrderPackager: newDefaultPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType(DefaultPackageType)},
 			weight:      -5,
 			expectedErr: ErrWeightNegative,
 		},
 		{
 			name:        "Standard package, valid weight",
-			packageType: OrderPackageType{OrderPackager: newStandardPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("package")},
 			weight:      5,
 			expectedErr: nil,
 		},
 		{
 			name:        "Standard package, negative weight",
-			packageType: OrderPackageType{OrderPackager: newStandardPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("package")},
 			weight:      -5,
 			expectedErr: ErrWeightNegative,
 		},
 		{
 			name:        "Standard package, exceeding weight",
-			packageType: OrderPackageType{OrderPackager: newStandardPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("package")},
 			weight:      15,
 			expectedErr: ErrWeightExceedsLimit{
 				Weight: 15,
-				Limit:  PackageMaxWeight,
+				Limit:  10,
 			},
 		},
 		{
 			name:        "Box package, valid weight",
-			packageType: OrderPackageType{OrderPackager: newBoxPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("box")},
 			weight:      20,
 			expectedErr: nil,
 		},
 		{
 			name:        "Box package, exceeding weight",
-			packageType: OrderPackageType{OrderPackager: newBoxPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("box")},
 			weight:      35,
 			expectedErr: ErrWeightExceedsLimit{
 				Weight: 35,
-				Limit:  BoxMaxWeight,
+				Limit:  30,
 			},
 		},
 		{
 			name:        "Box package, negative weight",
-			packageType: OrderPackageType{OrderPackager: newBoxPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("box")},
 			weight:      -5,
 			expectedErr: ErrWeightNegative,
 		},
 		{
 			name:        "Film package, valid weight",
-			packageType: OrderPackageType{OrderPackager: newFilmPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("film")},
 			weight:      5,
 			expectedErr: nil,
 		},
 		{
 			name:        "Film package, negative weight",
-			packageType: OrderPackageType{OrderPackager: newFilmPackage()},
+			packageType: OrderPackageType{OrderPackager: testPackageType("film")},
 			weight:      -5,
 			expectedErr: ErrWeightNegative,
 		},
@@ -111,25 +111,25 @@ func TestNewPackageType(t *testing.T) {
 		{
 			name:        "default package",
 			packageType: "without package",
-			want:        &OrderPackageType{OrderPackager: &Default{}},
+			want:        &OrderPackageType{OrderPackager: testPackageType(DefaultPackageType)},
 			expectError: nil,
 		},
 		{
 			name:        "standard package",
 			packageType: "package",
-			want:        &OrderPackageType{OrderPackager: &StandardPackage{}},
+			want:        &OrderPackageType{OrderPackager: testPackageType("package")},
 			expectError: nil,
 		},
 		{
 			name:        "box package",
 			packageType: "box",
-			want:        &OrderPackageType{OrderPackager: &Box{}},
+			want:        &OrderPackageType{OrderPackager: testPackageType("box")},
 			expectError: nil,
 		},
 		{
 			name:        "film package",
 			packageType: "film",
-			want:        &OrderPackageType{OrderPackager: &Film{}},
+			want:        &OrderPackageType{OrderPackager: testPackageType("film")},
 			expectError: nil,
 		},
 		{
@@ -141,7 +141,7 @@ func TestNewPackageType(t *testing.T) {
 		{
 			name:        "empty package type",
 			packageType: "",
-			want:        &OrderPackageType{OrderPackager: &Default{}},
+			want:        &OrderPackageType{OrderPackager: testPackageType(DefaultPackageType)},
 			expectError: nil,
 		},
 	}
@@ -160,3 +160,156 @@ func TestNewPackageType(t *testing.T) {
 		})
 	}
 }
+
+func TestPackageTypeRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Deactivated type is kept for lookup", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		registry := NewPackageTypeRegistry(DefaultPackageTypes()...)
+
+		// act
+		registry.Deactivate("box")
+
+		// assert
+		packageType, ok := registry.Lookup("box")
+		require.True(t, ok)
+		assert.False(t, packageType.Active)
+		assert.Equal(t, float64(30), packageType.MaxWeight)
+	})
+
+	t.Run("Register adds type", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		registry := NewPackageTypeRegistry()
+		packageType, err := NewPackageTypeSpec("pallet", 500, Money{Amount: 15000}, DimensionLimits{})
+		require.NoError(t, err)
+
+		// act
+		registry.Register(packageType)
+
+		// assert
+		types := registry.List()
+		require.Len(t, types, 1)
+		assert.Equal(t, "pallet", types[0].Name)
+		assert.True(t, types[0].Active)
+	})
+
+	t.Run("Invalid cost currency", func(t *testing.T) {
+		t.Parallel()
+
+		// act
+		_, err := NewPackageTypeSpec("pallet", 500, Money{Amount: 15000, Currency: "rub"}, DimensionLimits{})
+
+		// assert
+		require.ErrorIs(t, err, ErrCurrencyInvalid)
+	})
+
+	t.Run("Invalid spec", func(t *testing.T) {
+		t.Parallel()
+
+		// act
+		_, err := NewPackageTypeSpec("pallet", -1, Money{Amount: 15000}, DimensionLimits{})
+
+		// assert
+		require.ErrorIs(t, err, ErrPackageTypeInvalid)
+	})
+}
+
+func TestOrderPackageType_Scan(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		src      interface{}
+		wantType string
+		wantCost Money
+	}{
+		{
+			name:     "registered type",
+			src:      "box",
+			wantType: "box",
+			wantCost: Money{Amount: 2000, Currency: "RUB"},
+		},
+		{
+			name:     "empty type",
+			src:      "",
+			wantType: DefaultPackageType,
+			wantCost: Money{Currency: "RUB"},
+		},
+		{
+			name:     "type missing from registry",
+			src:      "removed-type",
+			wantType: "removed-type",
+			wantCost: Money{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var packageType OrderPackageType
+
+			err := packageType.Scan(tt.src)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, packageType.Type())
+			assert.Equal(t, tt.wantCost, packageType.GetPackageCost())
+		})
+	}
+}
+
+func TestCompositePackage(t *testing.T) {
+	t.Parallel()
+
+	composite := &CompositePackage{layers: []OrderPackager{
+		testPackageType("box"),
+		testPackageType("package"),
+		testPackageType("film"),
+	}}
+
+	t.Run("cost is the sum of layers", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, Money{Amount: 2500, Currency: "RUB"}, composite.GetPackageCost())
+	})
+
+	t.Run("strictest weight limit wins", func(t *testing.T) {
+		t.Parallel()
+
+		err := composite.ValidateWeight(20)
+
+		require.ErrorIs(t, err, ErrWeightExceedsLimit{Weight: 20, Limit: 10})
+	})
+
+	t.Run("negative weight", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, composite.ValidateWeight(-1), ErrWeightNegative)
+	})
+
+	t.Run("layers round trip through the database value", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := OrderPackageType{OrderPackager: composite}.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "box+package+film", value)
+
+		var scanned OrderPackageType
+		require.NoError(t, scanned.Scan(value))
+		assert.Equal(t, []string{"box", "package", "film"}, scanned.Layers())
+		assert.Equal(t, Money{Amount: 2500, Currency: "RUB"}, scanned.GetPackageCost())
+	})
+}
+
+// testPackageType возвращает встроенный тип упаковки, не обращаясь к глобальному реестру
+func testPackageType(name string) *PackageType {
+	for _, packageType := range DefaultPackageTypes() {
+		if packageType.Name == name {
+			return packageType
+		}
+	}
+	return nil
+}
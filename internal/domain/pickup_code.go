@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// PickupCodeLength число цифр в коде выдачи
+	PickupCodeLength = 6
+	// DefaultPickupCodeMaxAttempts число неверных попыток ввода кода, после которого заказ блокируется
+	DefaultPickupCodeMaxAttempts = 5
+	// DefaultPickupCodeRegenerationInterval как часто можно перевыпускать код выдачи одного заказа
+	DefaultPickupCodeRegenerationInterval = time.Hour
+
+	pickupCodeSaltSize = 16
+)
+
+// PickupCodePolicy ограничения на проверку и перевыпуск кода выдачи.
+// Перевыпуск снимает блокировку, поэтому он возможен не чаще раза в RegenerationInterval:
+// так за RegenerationInterval можно перебрать не больше MaxAttempts кодов одного заказа.
+// Нулевые значения означают DefaultPickupCodeMaxAttempts и DefaultPickupCodeRegenerationInterval.
+type PickupCodePolicy struct {
+	MaxAttempts          int
+	RegenerationInterval time.Duration
+}
+
+// Attempts возвращает допустимое число неверных попыток
+func (p PickupCodePolicy) Attempts() int {
+	if p.MaxAttempts <= 0 {
+		return DefaultPickupCodeMaxAttempts
+	}
+
+	return p.MaxAttempts
+}
+
+// Interval возвращает минимальный промежуток между перевыпусками кода
+func (p PickupCodePolicy) Interval() time.Duration {
+	if p.RegenerationInterval <= 0 {
+		return DefaultPickupCodeRegenerationInterval
+	}
+
+	return p.RegenerationInterval
+}
+
+// NewPickupCode возвращает случайный числовой код выдачи
+func NewPickupCode() (string, error) {
+	limit := big.NewInt(10)
+
+	var code strings.Builder
+	for range PickupCodeLength {
+		digit, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return "", err
+		}
+
+		code.WriteString(digit.String())
+	}
+
+	return code.String(), nil
+}
+
+// HashPickupCode возвращает хэш кода выдачи со случайной солью в виде "соль$хэш"
+func HashPickupCode(code string) (string, error) {
+	salt := make([]byte, pickupCodeSaltSize)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(salt) + "$" + pickupCodeDigest(salt, code), nil
+}
+
+// pickupCodeMatches сравнивает код с сохраненным хэшем за постоянное время
+func pickupCodeMatches(hash, code string) bool {
+	encodedSalt, digest, ok := strings.Cut(hash, "$")
+	if !ok {
+		return false
+	}
+
+	salt, err := hex.DecodeString(encodedSalt)
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(digest), []byte(pickupCodeDigest(salt, code))) == 1
+}
+
+func pickupCodeDigest(salt []byte, code string) string {
+	sum := sha256.Sum256(append(append([]byte{}, salt...), code...))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// GeneratePickupCode создает новый код выдачи заказа и сбрасывает счетчик неверных попыток.
+// В заказе хранится только хэш, поэтому код нужно передать получателю сразу.
+func (o *Order) GeneratePickupCode() (string, error) {
+	code, err := NewPickupCode()
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := HashPickupCode(code)
+	if err != nil {
+		return "", err
+	}
+
+	o.PickupCodeHash = hash
+	o.PickupCodeAttempts = 0
+
+	return code, nil
+}
+
+// RegeneratePickupCode выдает в момент at новый код для заказа, который еще ждет получателя,
+// сбрасывает счетчик неверных попыток и тем самым снимает блокировку.
+// Перевыпуск возможен не чаще раза в policy.Interval(), иначе перебор кода можно было бы продолжать,
+// перевыпуская его.
+func (o *Order) RegeneratePickupCode(policy PickupCodePolicy, at time.Time) (string, error) {
+	if o.Status != OrderStatusAccepted && !o.Status.IsExpired() {
+		return "", ErrPickupCodeNotAllowed
+	}
+
+	if o.PickupCodeRegeneratedAt.Valid && at.Before(o.PickupCodeRegeneratedAt.Time.Add(policy.Interval())) {
+		return "", ErrPickupCodeRegeneratedRecently
+	}
+
+	code, err := o.GeneratePickupCode()
+	if err != nil {
+		return "", err
+	}
+
+	o.PickupCodeRegeneratedAt = sql.NullTime{Time: at, Valid: true}
+
+	return code, nil
+}
+
+// PickupCodeLocked сообщает, что число неверных попыток исчерпано
+func (o *Order) PickupCodeLocked(policy PickupCodePolicy) bool {
+	return o.PickupCodeAttempts >= policy.Attempts()
+}
+
+// VerifyPickupCode проверяет код выдачи.
+// Неверный код увеличивает счетчик попыток; после policy.Attempts() неверных попыток
+// заказ блокируется до перевыпуска кода. Верный код сбрасывает счетчик.
+func (o *Order) VerifyPickupCode(policy PickupCodePolicy, code string) error {
+	if o.PickupCodeHash == "" {
+		return ErrPickupCodeNotGenerated
+	}
+
+	if o.PickupCodeLocked(policy) {
+		return ErrPickupCodeLocked
+	}
+
+	if code == "" {
+		return ErrPickupCodeRequired
+	}
+
+	if !pickupCodeMatches(o.PickupCodeHash, code) {
+		o.PickupCodeAttempts++
+
+		if o.PickupCodeLocked(policy) {
+			return ErrPickupCodeLocked
+		}
+
+		return ErrPickupCodeInvalid
+	}
+
+	o.PickupCodeAttempts = 0
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_VerifyPickupCode(t *testing.T) {
+	t.Parallel()
+
+	policy := PickupCodePolicy{MaxAttempts: 2}
+
+	newOrder := func(t *testing.T) (*Order, string) {
+		order := &Order{Status: OrderStatusAccepted}
+
+		code, err := order.GeneratePickupCode()
+		require.NoError(t, err)
+
+		return order, code
+	}
+
+	t.Run("valid code", func(t *testing.T) {
+		t.Parallel()
+
+		order, code := newOrder(t)
+		order.PickupCodeAttempts = 1
+
+		err := order.VerifyPickupCode(policy, code)
+
+		require.NoError(t, err)
+		assert.Zero(t, order.PickupCodeAttempts)
+	})
+
+	t.Run("code is stored hashed", func(t *testing.T) {
+		t.Parallel()
+
+		order, code := newOrder(t)
+
+		assert.Len(t, code, PickupCodeLength)
+		assert.NotContains(t, order.PickupCodeHash, code)
+	})
+
+	t.Run("invalid code counts attempt", func(t *testing.T) {
+		t.Parallel()
+
+		order, code := newOrder(t)
+
+		err := order.VerifyPickupCode(policy, code+"0")
+
+		require.ErrorIs(t, err, ErrPickupCodeInvalid)
+		assert.Equal(t, 1, order.PickupCodeAttempts)
+	})
+
+	t.Run("empty code does not count attempt", func(t *testing.T) {
+		t.Parallel()
+
+		order, _ := newOrder(t)
+
+		err := order.VerifyPickupCode(policy, "")
+
+		require.ErrorIs(t, err, ErrPickupCodeRequired)
+		assert.Zero(t, order.PickupCodeAttempts)
+	})
+
+	t.Run("order is locked after max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		order, code := newOrder(t)
+
+		require.ErrorIs(t, order.VerifyPickupCode(policy, "000000"), ErrPickupCodeInvalid)
+		require.ErrorIs(t, order.VerifyPickupCode(policy, "000000"), ErrPickupCodeLocked)
+		require.ErrorIs(t, order.VerifyPickupCode(policy, code), ErrPickupCodeLocked)
+		assert.Equal(t, 2, order.PickupCodeAttempts)
+	})
+
+	t.Run("regenerated code replaces old one", func(t *testing.T) {
+		t.Parallel()
+
+		order, old := newOrder(t)
+		policy := PickupCodePolicy{MaxAttempts: 3}
+
+		code, err := order.RegeneratePickupCode(policy, time.Now())
+		require.NoError(t, err)
+
+		if code != old {
+			assert.ErrorIs(t, order.VerifyPickupCode(policy, old), ErrPickupCodeInvalid)
+		}
+	})
+
+	t.Run("order without code", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusAccepted}
+
+		err := order.VerifyPickupCode(policy, "123456")
+
+		require.ErrorIs(t, err, ErrPickupCodeNotGenerated)
+	})
+
+	t.Run("default max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, DefaultPickupCodeMaxAttempts, PickupCodePolicy{}.Attempts())
+	})
+
+	t.Run("default regeneration interval", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, DefaultPickupCodeRegenerationInterval, PickupCodePolicy{}.Interval())
+	})
+}
+
+func TestOrder_RegeneratePickupCode(t *testing.T) {
+	t.Parallel()
+
+	policy := PickupCodePolicy{MaxAttempts: 3, RegenerationInterval: time.Hour}
+	now := time.Date(2024, 8, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		status        OrderStatus
+		attempts      int
+		regeneratedAt sql.NullTime
+		err           error
+	}{
+		{name: "accepted", status: OrderStatusAccepted},
+		{name: "expired", status: OrderStatusExpired},
+		{name: "after failed attempt", status: OrderStatusAccepted, attempts: 1},
+		{name: "locked order is unlocked", status: OrderStatusAccepted, attempts: 3},
+		{
+			name:          "after regeneration interval",
+			status:        OrderStatusAccepted,
+			attempts:      3,
+			regeneratedAt: sql.NullTime{Time: now.Add(-time.Hour), Valid: true},
+		},
+		{
+			name:          "regenerated recently",
+			status:        OrderStatusAccepted,
+			attempts:      3,
+			regeneratedAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true},
+			err:           ErrPickupCodeRegeneratedRecently,
+		},
+		{name: "issued", status: OrderStatusIssued, err: ErrPickupCodeNotAllowed},
+		{name: "returned to courier", status: OrderStatusReturnedToCourier, err: ErrPickupCodeNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order := &Order{Status: tt.status, PickupCodeAttempts: tt.attempts, PickupCodeRegeneratedAt: tt.regeneratedAt}
+
+			code, err := order.RegeneratePickupCode(policy, now)
+
+			require.ErrorIs(t, err, tt.err)
+			if tt.err != nil {
+				assert.Equal(t, tt.attempts, order.PickupCodeAttempts)
+				assert.Equal(t, tt.regeneratedAt, order.PickupCodeRegeneratedAt)
+				return
+			}
+
+			assert.Zero(t, order.PickupCodeAttempts)
+			assert.Equal(t, sql.NullTime{Time: now, Valid: true}, order.PickupCodeRegeneratedAt)
+			require.NoError(t, order.VerifyPickupCode(policy, code))
+		})
+	}
+}
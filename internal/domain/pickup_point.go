@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PickupPoint структура пункта выдачи заказов (ПВЗ)
+type PickupPoint struct {
+	ID        int64     `db:"id"`
+	Name      string    `db:"name"`
+	Address   string    `db:"address"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// OrderKey ключ заказа в рамках ПВЗ
+type OrderKey struct {
+	PickupPointID int64
+	OrderID       int64
+}
+
+// NewOrderKey создает ключ заказа orderID в ПВЗ pickupPointID
+func NewOrderKey(pickupPointID, orderID int64) OrderKey {
+	return OrderKey{
+		PickupPointID: pickupPointID,
+		OrderID:       orderID,
+	}
+}
+
+type pickupPointKey struct{}
+
+// WithPickupPointID возвращает контекст, в котором операции выполняются от имени ПВЗ id
+func WithPickupPointID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, pickupPointKey{}, id)
+}
+
+// PickupPointIDFromContext возвращает идентификатор ПВЗ, от имени которого выполняется операция
+func PickupPointIDFromContext(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(pickupPointKey{}).(int64)
+	if !ok || id <= 0 {
+		return 0, false
+	}
+
+	return id, true
+}
@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+// ContactChannel канал, через который получатель предпочитает получать уведомления
+type ContactChannel string
+
+const (
+	ContactChannelSMS   ContactChannel = "sms"
+	ContactChannelEmail ContactChannel = "email"
+)
+
+// RecipientNameMaxLength максимальная длина имени получателя
+const RecipientNameMaxLength = 255
+
+// Recipient получатель заказов и его контакты.
+// Получатель, созданный при приемке заказа без регистрации, не имеет контактов, пока их не заполнят.
+type Recipient struct {
+	ID               int64          `db:"id"`
+	Name             string         `db:"name"`
+	Phone            string         `db:"phone"`
+	Email            string         `db:"email"`
+	PreferredChannel ContactChannel `db:"preferred_channel"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+}
+
+// NewRecipient создает получателя с проверенными контактами
+func NewRecipient(recipient *dto.Recipient) (*Recipient, error) {
+	now := time.Now().UTC()
+
+	result := &Recipient{
+		ID:        recipient.ID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	err := result.SetContacts(recipient.Name, recipient.Phone, recipient.Email, recipient.PreferredChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// NewUnregisteredRecipient создает получателя без контактов для заказа, принятого до регистрации получателя
+func NewUnregisteredRecipient(id int64) *Recipient {
+	now := time.Now().UTC()
+
+	return &Recipient{
+		ID:        id,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// SetContacts проверяет и сохраняет контакты получателя.
+// Телефон приводится к виду +<код страны><номер>; без указанного канала выбирается SMS, если есть телефон.
+func (r *Recipient) SetContacts(name, phone, email, channel string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrRecipientNameRequired
+	}
+
+	if len([]rune(name)) > RecipientNameMaxLength {
+		return ErrRecipientNameTooLong
+	}
+
+	if phone == "" && email == "" {
+		return ErrRecipientContactRequired
+	}
+
+	if phone != "" {
+		normalized, err := NormalizePhone(phone)
+		if err != nil {
+			return err
+		}
+
+		phone = normalized
+	}
+
+	if email != "" {
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Name != "" {
+			return ErrRecipientEmailInvalid
+		}
+
+		email = strings.ToLower(address.Address)
+	}
+
+	preferred, err := preferredChannel(channel, phone, email)
+	if err != nil {
+		return err
+	}
+
+	r.Name = name
+	r.Phone = phone
+	r.Email = email
+	r.PreferredChannel = preferred
+	r.UpdatedAt = time.Now().UTC()
+
+	return nil
+}
+
+// NormalizePhone приводит номер телефона к виду +<код страны><номер>.
+// Пробелы, дефисы и скобки отбрасываются; российский номер, начинающийся с 8, переводится в +7.
+func NormalizePhone(phone string) (string, error) {
+	var digits strings.Builder
+
+	for i, r := range strings.TrimSpace(phone) {
+		switch {
+		case unicode.IsDigit(r) && r <= unicode.MaxASCII:
+			digits.WriteRune(r)
+		case r == '+' && i == 0, r == ' ', r == '-', r == '(', r == ')':
+		default:
+			return "", ErrRecipientPhoneInvalid
+		}
+	}
+
+	number := digits.String()
+	if len(number) == 11 && number[0] == '8' && !strings.HasPrefix(strings.TrimSpace(phone), "+") {
+		number = "7" + number[1:]
+	}
+
+	// E.164: не больше 15 цифр вместе с кодом страны
+	if len(number) < 10 || len(number) > 15 || number[0] == '0' {
+		return "", ErrRecipientPhoneInvalid
+	}
+
+	return "+" + number, nil
+}
+
+func preferredChannel(channel, phone, email string) (ContactChannel, error) {
+	switch ContactChannel(channel) {
+	case "":
+		if phone != "" {
+			return ContactChannelSMS, nil
+		}
+
+		return ContactChannelEmail, nil
+	case ContactChannelSMS:
+		if phone == "" {
+			return "", ErrRecipientChannelUnavailable
+		}
+
+		return ContactChannelSMS, nil
+	case ContactChannelEmail:
+		if email == "" {
+			return "", ErrRecipientChannelUnavailable
+		}
+
+		return ContactChannelEmail, nil
+	default:
+		return "", ErrRecipientChannelUnknown
+	}
+}
+
+// ToRecipientDTO преобразует получателя в сущность DTO
+func ToRecipientDTO(recipient *Recipient) *dto.Recipient {
+	return &dto.Recipient{
+		ID:               recipient.ID,
+		Name:             recipient.Name,
+		Phone:            recipient.Phone,
+		Email:            recipient.Email,
+		PreferredChannel: string(recipient.PreferredChannel),
+		CreatedAt:        recipient.CreatedAt,
+		UpdatedAt:        recipient.UpdatedAt,
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		phone   string
+		want    string
+		wantErr error
+	}{
+		{phone: "+7 999 123-45-67", want: "+79991234567"},
+		{phone: "8 (999) 123-45-67", want: "+79991234567"},
+		{phone: "79991234567", want: "+79991234567"},
+		{phone: "+44 20 7946 0958", want: "+442079460958"},
+		{phone: "123", wantErr: ErrRecipientPhoneInvalid},
+		{phone: "+7 999 ABC-45-67", wantErr: ErrRecipientPhoneInvalid},
+		{phone: "7+9991234567", wantErr: ErrRecipientPhoneInvalid},
+		{phone: "+1234567890123456", wantErr: ErrRecipientPhoneInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.phone, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizePhone(tt.phone)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewRecipient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		recipient *dto.Recipient
+		wantErr   error
+	}{
+		{
+			name:      "name required",
+			recipient: &dto.Recipient{ID: 1, Name: "  ", Phone: "+79991234567"},
+			wantErr:   ErrRecipientNameRequired,
+		},
+		{
+			name:      "invalid email",
+			recipient: &dto.Recipient{ID: 1, Name: "Иван", Email: "ivan"},
+			wantErr:   ErrRecipientEmailInvalid,
+		},
+		{
+			name:      "unknown channel",
+			recipient: &dto.Recipient{ID: 1, Name: "Иван", Phone: "+79991234567", PreferredChannel: "pigeon"},
+			wantErr:   ErrRecipientChannelUnknown,
+		},
+		{
+			name:      "channel without contact",
+			recipient: &dto.Recipient{ID: 1, Name: "Иван", Phone: "+79991234567", PreferredChannel: "email"},
+			wantErr:   ErrRecipientChannelUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewRecipient(tt.recipient)
+
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("valid recipient", func(t *testing.T) {
+		t.Parallel()
+
+		recipient, err := NewRecipient(&dto.Recipient{
+			ID:               1,
+			Name:             " Иван ",
+			Phone:            "+79991234567",
+			Email:            "ivan@example.com",
+			PreferredChannel: "email",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Иван", recipient.Name)
+		assert.Equal(t, ContactChannelEmail, recipient.PreferredChannel)
+	})
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"database/sql"
+	"slices"
+	"time"
+)
+
+// ReturnCondition состояние возвращенного заказа при осмотре
+type ReturnCondition string
+
+const (
+	ReturnConditionIntact  ReturnCondition = "intact"
+	ReturnConditionDamaged ReturnCondition = "damaged"
+	ReturnConditionOpened  ReturnCondition = "opened"
+)
+
+// ReturnNoteMaxLength максимальная длина комментария к возврату
+const ReturnNoteMaxLength = 1000
+
+// DefaultReturnReasons коды причин возврата, если список не задан в конфигурации
+var DefaultReturnReasons = []string{"defective", "wrong_item", "not_as_described", "changed_mind", "damaged_in_transit"}
+
+// ReturnReasonPolicy допустимые коды причин возврата.
+// Пустой список означает DefaultReturnReasons.
+type ReturnReasonPolicy struct {
+	Reasons []string
+}
+
+// ReturnDetails результат осмотра заказа при возврате от клиента
+type ReturnDetails struct {
+	Reason    string
+	Condition ReturnCondition
+	Note      string
+	// Refund сумма возврата в минимальных единицах валюты заказа; nil, если сумма не указана
+	Refund *int64
+	// RefundCurrency валюта суммы возврата; пустая строка означает валюту заказа
+	RefundCurrency string
+}
+
+// ParseReturnCondition проверяет, что состояние возврата известно
+func ParseReturnCondition(condition string) (ReturnCondition, error) {
+	switch c := ReturnCondition(condition); c {
+	case ReturnConditionIntact, ReturnConditionDamaged, ReturnConditionOpened:
+		return c, nil
+	default:
+		return "", ErrReturnConditionUnknown
+	}
+}
+
+// Allows сообщает, входит ли код причины в список допустимых
+func (p ReturnReasonPolicy) Allows(reason string) bool {
+	reasons := p.Reasons
+	if len(reasons) == 0 {
+		reasons = DefaultReturnReasons
+	}
+
+	return slices.Contains(reasons, reason)
+}
+
+// AcceptReturn принимает заказ от клиента и сохраняет причину возврата, состояние заказа и сумму возврата.
+// Сумма возврата не может превышать стоимость заказа вместе с упаковкой.
+func (o *Order) AcceptReturn(policy ReturnReasonPolicy, details ReturnDetails, at time.Time) error {
+	if !policy.Allows(details.Reason) {
+		return ErrReturnReasonUnknown
+	}
+
+	condition, err := ParseReturnCondition(string(details.Condition))
+	if err != nil {
+		return err
+	}
+
+	if len([]rune(details.Note)) > ReturnNoteMaxLength {
+		return ErrReturnNoteTooLong
+	}
+
+	refund := sql.NullInt64{}
+	if details.Refund != nil {
+		if *details.Refund < 0 {
+			return ErrMoneyNegative
+		}
+
+		if details.RefundCurrency != "" && details.RefundCurrency != o.Currency {
+			return ErrCurrencyMismatch
+		}
+
+		if *details.Refund > o.TotalCost().Amount {
+			return ErrRefundExceedsCost
+		}
+
+		refund = sql.NullInt64{Int64: *details.Refund, Valid: true}
+	}
+
+	err = o.Transition(OrderStatusReturnedByClient, at)
+	if err != nil {
+		return err
+	}
+
+	o.ReturnReason = details.Reason
+	o.ReturnCondition = condition
+	o.ReturnNote = details.Note
+	o.RefundAmount = refund
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_AcceptReturn(t *testing.T) {
+	t.Parallel()
+
+	policy := ReturnReasonPolicy{}
+
+	newOrder := func() *Order {
+		return &Order{
+			Status:   OrderStatusIssued,
+			Cost:     1000,
+			Currency: "RUB",
+			IssuedAt: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+		}
+	}
+	refund := func(amount int64) *int64 {
+		return &amount
+	}
+
+	t.Run("records return details", func(t *testing.T) {
+		t.Parallel()
+
+		order := newOrder()
+
+		err := order.AcceptReturn(policy, ReturnDetails{
+			Reason:    "defective",
+			Condition: ReturnConditionDamaged,
+			Note:      "broken lid",
+			Refund:    refund(1000),
+		}, time.Now())
+
+		require.NoError(t, err)
+		assert.Equal(t, OrderStatusReturnedByClient, order.Status)
+		assert.Equal(t, "defective", order.ReturnReason)
+		assert.Equal(t, ReturnConditionDamaged, order.ReturnCondition)
+		assert.Equal(t, "broken lid", order.ReturnNote)
+		assert.Equal(t, sql.NullInt64{Int64: 1000, Valid: true}, order.RefundAmount)
+	})
+
+	t.Run("custom reasons replace defaults", func(t *testing.T) {
+		t.Parallel()
+
+		custom := ReturnReasonPolicy{Reasons: []string{"late_delivery"}}
+
+		assert.True(t, custom.Allows("late_delivery"))
+		assert.False(t, custom.Allows("defective"))
+	})
+
+	tests := []struct {
+		name    string
+		details ReturnDetails
+		wantErr error
+	}{
+		{
+			name:    "unknown reason",
+			details: ReturnDetails{Reason: "too_expensive", Condition: ReturnConditionIntact},
+			wantErr: ErrReturnReasonUnknown,
+		},
+		{
+			name:    "unknown condition",
+			details: ReturnDetails{Reason: "defective", Condition: "scratched"},
+			wantErr: ErrReturnConditionUnknown,
+		},
+		{
+			name: "note too long",
+			details: ReturnDetails{
+				Reason:    "defective",
+				Condition: ReturnConditionIntact,
+				Note:      strings.Repeat("ж", ReturnNoteMaxLength+1),
+			},
+			wantErr: ErrReturnNoteTooLong,
+		},
+		{
+			name:    "negative refund",
+			details: ReturnDetails{Reason: "defective", Condition: ReturnConditionIntact, Refund: refund(-1)},
+			wantErr: ErrMoneyNegative,
+		},
+		{
+			name: "refund in another currency",
+			details: ReturnDetails{
+				Reason:         "defective",
+				Condition:      ReturnConditionIntact,
+				Refund:         refund(100),
+				RefundCurrency: "USD",
+			},
+			wantErr: ErrCurrencyMismatch,
+		},
+		{
+			name:    "refund exceeds cost",
+			details: ReturnDetails{Reason: "defective", Condition: ReturnConditionIntact, Refund: refund(1001)},
+			wantErr: ErrRefundExceedsCost,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order := newOrder()
+
+			err := order.AcceptReturn(policy, tt.details, time.Now())
+
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, OrderStatusIssued, order.Status)
+			assert.Empty(t, order.ReturnReason)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// DefaultReturnWindow срок, в течение которого клиент может вернуть выданный заказ, если он не задан в конфигурации
+	DefaultReturnWindow = 48 * time.Hour
+	// DefaultReturnedToCourierRetention срок хранения заказов, возвращенных курьеру, до их удаления командой cleanup
+	DefaultReturnedToCourierRetention = 30 * 24 * time.Hour
+)
+
+// ReturnWindowPolicies сроки возврата заказа клиентом по умолчанию, для типов упаковки и категорий товара.
+// Срок категории важнее срока типа упаковки. Нулевые значения означают значения по умолчанию.
+type ReturnWindowPolicies struct {
+	Default      time.Duration
+	PackageTypes map[string]time.Duration
+	Categories   map[string]time.Duration
+	// Retention срок хранения заказов, возвращенных курьеру, до их удаления.
+	// Это отдельная настройка: такой заказ уже у курьера, и запись о нем хранится как подтверждение передачи,
+	// а не как срок, в течение которого клиент может вернуть товар.
+	Retention time.Duration
+}
+
+// For возвращает срок возврата для заказа.
+// Для составной упаковки берется срок первого слоя, для которого он задан.
+func (p ReturnWindowPolicies) For(order *Order) time.Duration {
+	if window, ok := p.Categories[order.Category]; ok && order.Category != "" {
+		return window
+	}
+
+	if order.PackageType != nil {
+		for _, layer := range order.PackageType.Layers() {
+			if window, ok := p.PackageTypes[layer]; ok {
+				return window
+			}
+		}
+	}
+
+	if p.Default > 0 {
+		return p.Default
+	}
+
+	return DefaultReturnWindow
+}
+
+// Shortest возвращает самый короткий из сроков возврата, который может получить заказ
+func (p ReturnWindowPolicies) Shortest() time.Duration {
+	shortest := p.For(&Order{})
+
+	for _, windows := range []map[string]time.Duration{p.Categories, p.PackageTypes} {
+		for _, window := range windows {
+			shortest = min(shortest, window)
+		}
+	}
+
+	return shortest
+}
+
+// RetentionPeriod возвращает срок хранения заказов, возвращенных курьеру
+func (p ReturnWindowPolicies) RetentionPeriod() time.Duration {
+	if p.Retention > 0 {
+		return p.Retention
+	}
+
+	return DefaultReturnedToCourierRetention
+}
+
+// ReturnDeadline возвращает момент, до которого выданный заказ можно вернуть.
+// Для невыданного заказа возвращается нулевое время.
+func (o *Order) ReturnDeadline(window time.Duration) time.Time {
+	if !o.IssuedAt.Valid {
+		return time.Time{}
+	}
+
+	return o.IssuedAt.Time.Add(window)
+}
+
+// ReturnWindowClosed сообщает, истек ли к моменту at срок возврата выданного заказа
+func (o *Order) ReturnWindowClosed(window time.Duration, at time.Time) bool {
+	return o.Status == OrderStatusIssued && at.After(o.ReturnDeadline(window))
+}
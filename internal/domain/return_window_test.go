@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReturnWindowPolicies_For(t *testing.T) {
+	t.Parallel()
+
+	policies := ReturnWindowPolicies{
+		Default:      72 * time.Hour,
+		PackageTypes: map[string]time.Duration{"film": 24 * time.Hour},
+		Categories:   map[string]time.Duration{"electronics": 14 * 24 * time.Hour},
+	}
+
+	film, err := NewCompositePackageType([]string{"box", "film"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		policies ReturnWindowPolicies
+		order    *Order
+		want     time.Duration
+	}{
+		{
+			name:     "zero policy",
+			policies: ReturnWindowPolicies{},
+			order:    &Order{},
+			want:     DefaultReturnWindow,
+		},
+		{
+			name:     "default window",
+			policies: policies,
+			order:    &Order{Category: "books"},
+			want:     72 * time.Hour,
+		},
+		{
+			name:     "package layer window",
+			policies: policies,
+			order:    &Order{PackageType: film},
+			want:     24 * time.Hour,
+		},
+		{
+			name:     "category overrides package type",
+			policies: policies,
+			order:    &Order{PackageType: film, Category: "electronics"},
+			want:     14 * 24 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.policies.For(tt.order))
+		})
+	}
+}
+
+func TestOrder_ReturnWindowClosed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	order := &Order{
+		Status:   OrderStatusIssued,
+		IssuedAt: sql.NullTime{Time: now.Add(-72 * time.Hour), Valid: true},
+	}
+
+	assert.True(t, order.ReturnWindowClosed(48*time.Hour, now))
+	assert.False(t, order.ReturnWindowClosed(96*time.Hour, now))
+	assert.Equal(t, now.Add(24*time.Hour), order.ReturnDeadline(96*time.Hour))
+	assert.True(t, (&Order{Status: OrderStatusAccepted}).ReturnDeadline(time.Hour).IsZero())
+}
+
+func TestReturnWindowPolicies_RetentionPeriod(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultReturnedToCourierRetention, ReturnWindowPolicies{}.RetentionPeriod())
+	assert.Equal(t, time.Hour, ReturnWindowPolicies{Retention: time.Hour}.RetentionPeriod())
+}
+
+func TestReturnWindowPolicies_Shortest(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, DefaultReturnWindow, ReturnWindowPolicies{}.Shortest())
+	assert.Equal(t, 24*time.Hour, ReturnWindowPolicies{
+		Default:      72 * time.Hour,
+		PackageTypes: map[string]time.Duration{"film": 24 * time.Hour},
+		Categories:   map[string]time.Duration{"electronics": 14 * 24 * time.Hour},
+	}.Shortest())
+}
@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"time"
+)
+
+// OrderStatus статус заказа в жизненном цикле ПВЗ
+type OrderStatus string
+
+const (
+	OrderStatusAccepted          OrderStatus = "accepted"
+	OrderStatusIssued            OrderStatus = "issued"
+	OrderStatusReturnedByClient  OrderStatus = "returned_by_client"
+	OrderStatusReturnedToCourier OrderStatus = "returned_to_courier"
+	OrderStatusExpired           OrderStatus = "expired"
+	// OrderStatusAwaitingReturn просроченный заказ, отобранный для возврата курьеру
+	OrderStatusAwaitingReturn OrderStatus = "awaiting_courier_return"
+)
+
+// orderTransitions карта допустимых переходов между статусами заказа.
+// Просроченный заказ выдается с платой за хранение, только если это разрешает StorageFeePolicy (см. Order.Issue),
+// и снова становится принятым при продлении срока хранения (см. Order.ExtendStorage).
+// Заказ, ожидающий возврата курьеру, пока его не забрали, обрабатывается так же, как просроченный.
+var orderTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusAccepted:          {OrderStatusIssued, OrderStatusExpired},
+	OrderStatusExpired:           {OrderStatusIssued, OrderStatusReturnedToCourier, OrderStatusAccepted, OrderStatusAwaitingReturn},
+	OrderStatusAwaitingReturn:    {OrderStatusIssued, OrderStatusReturnedToCourier, OrderStatusAccepted},
+	OrderStatusIssued:            {OrderStatusReturnedByClient},
+	OrderStatusReturnedByClient:  {OrderStatusReturnedToCourier},
+	OrderStatusReturnedToCourier: {},
+}
+
+// ParseOrderStatus преобразует строку в OrderStatus
+func ParseOrderStatus(s string) (OrderStatus, error) {
+	status := OrderStatus(s)
+	if _, ok := orderTransitions[status]; !ok {
+		return "", ErrOrderStatusUnknown
+	}
+
+	return status, nil
+}
+
+// storedStatuses статусы заказов, которые физически находятся на складе ПВЗ
+var storedStatuses = []OrderStatus{
+	OrderStatusAccepted, OrderStatusExpired, OrderStatusAwaitingReturn, OrderStatusReturnedByClient,
+}
+
+// StoredStatuses возвращает статусы заказов, которые физически находятся на складе ПВЗ
+func StoredStatuses() []OrderStatus {
+	return slices.Clone(storedStatuses)
+}
+
+// IsStored возвращает true, если заказ физически находится на складе ПВЗ
+func (s OrderStatus) IsStored() bool {
+	return slices.Contains(storedStatuses, s)
+}
+
+// IsExpired возвращает true, если срок хранения заказа истек, а заказ еще не выдан и не возвращен курьеру
+func (s OrderStatus) IsExpired() bool {
+	return s == OrderStatusExpired || s == OrderStatusAwaitingReturn
+}
+
+// CanTransition проверяет, разрешен ли переход из статуса s в статус to
+func (s OrderStatus) CanTransition(to OrderStatus) bool {
+	for _, next := range orderTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusAt возвращает статус заказа на момент времени at.
+// Принятый заказ с истекшим сроком хранения считается просроченным.
+func (o *Order) StatusAt(at time.Time) OrderStatus {
+	if o.Status == OrderStatusAccepted && at.After(o.StorageUntil) {
+		return OrderStatusExpired
+	}
+
+	return o.Status
+}
+
+// Transition переводит заказ в статус to на момент времени at.
+// Это единственное место, где меняются статус заказа и связанные с ним отметки времени.
+func (o *Order) Transition(to OrderStatus, at time.Time) error {
+	from := o.StatusAt(at)
+	if !from.CanTransition(to) {
+		return ErrInvalidStatusTransition{From: from, To: to}
+	}
+
+	at = at.UTC()
+
+	switch to {
+	case OrderStatusIssued:
+		o.IssuedAt = sql.NullTime{Time: at, Valid: true}
+	case OrderStatusReturnedByClient:
+		o.ReturnedAt = sql.NullTime{Time: at, Valid: true}
+	case OrderStatusReturnedToCourier:
+		o.ReturnedToCourierAt = sql.NullTime{Time: at, Valid: true}
+	}
+
+	// выданный или возвращенный курьеру заказ покидает склад и освобождает ячейку
+	if !to.IsStored() {
+		o.CellID = sql.NullInt64{}
+	}
+
+	o.Status = to
+
+	return nil
+}
+
+// Реализация интерфейсов Valuer и Scanner для OrderStatus
+func (s OrderStatus) Value() (driver.Value, error) {
+	if s == "" {
+		return nil, nil
+	}
+	return string(s), nil
+}
+
+func (s *OrderStatus) Scan(src interface{}) error {
+	if src == nil {
+		*s = ""
+		return nil
+	}
+
+	str, ok := src.(string)
+	if !ok {
+		return errors.New("source is not a string")
+	}
+
+	status, err := ParseOrderStatus(str)
+	if err != nil {
+		return err
+	}
+
+	*s = status
+	return nil
+}
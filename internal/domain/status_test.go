@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_Transition(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		status       OrderStatus
+		storageUntil time.Time
+		to           OrderStatus
+		expectedErr  error
+	}{
+		{
+			name:         "accepted to issued",
+			status:       OrderStatusAccepted,
+			storageUntil: now.Add(time.Hour),
+			to:           OrderStatusIssued,
+		},
+		{
+			name:         "accepted with expired storage to issued",
+			status:       OrderStatusAccepted,
+			storageUntil: now.Add(-time.Hour),
+			to:           OrderStatusIssued,
+		},
+		{
+			name:         "accepted with expired storage to returned to courier",
+			status:       OrderStatusAccepted,
+			storageUntil: now.Add(-time.Hour),
+			to:           OrderStatusReturnedToCourier,
+		},
+		{
+			name:         "accepted to returned to courier",
+			status:       OrderStatusAccepted,
+			storageUntil: now.Add(time.Hour),
+			to:           OrderStatusReturnedToCourier,
+			expectedErr:  ErrInvalidStatusTransition{From: OrderStatusAccepted, To: OrderStatusReturnedToCourier},
+		},
+		{
+			name:         "issued to returned by client",
+			status:       OrderStatusIssued,
+			storageUntil: now.Add(-time.Hour),
+			to:           OrderStatusReturnedByClient,
+		},
+		{
+			name:         "issued to issued",
+			status:       OrderStatusIssued,
+			storageUntil: now.Add(time.Hour),
+			to:           OrderStatusIssued,
+			expectedErr:  ErrInvalidStatusTransition{From: OrderStatusIssued, To: OrderStatusIssued},
+		},
+		{
+			name:         "returned by client to returned to courier",
+			status:       OrderStatusReturnedByClient,
+			storageUntil: now.Add(time.Hour),
+			to:           OrderStatusReturnedToCourier,
+		},
+		{
+			name:         "accepted with expired storage to awaiting courier return",
+			status:       OrderStatusAccepted,
+			storageUntil: now.Add(-time.Hour),
+			to:           OrderStatusAwaitingReturn,
+		},
+		{
+			name:         "accepted to awaiting courier return",
+			status:       OrderStatusAccepted,
+			storageUntil: now.Add(time.Hour),
+			to:           OrderStatusAwaitingReturn,
+			expectedErr:  ErrInvalidStatusTransition{From: OrderStatusAccepted, To: OrderStatusAwaitingReturn},
+		},
+		{
+			name:         "awaiting courier return to returned to courier",
+			status:       OrderStatusAwaitingReturn,
+			storageUntil: now.Add(-time.Hour),
+			to:           OrderStatusReturnedToCourier,
+		},
+		{
+			name:         "awaiting courier return to returned by client",
+			status:       OrderStatusAwaitingReturn,
+			storageUntil: now.Add(-time.Hour),
+			to:           OrderStatusReturnedByClient,
+			expectedErr:  ErrInvalidStatusTransition{From: OrderStatusAwaitingReturn, To: OrderStatusReturnedByClient},
+		},
+		{
+			name:         "returned to courier to issued",
+			status:       OrderStatusReturnedToCourier,
+			storageUntil: now.Add(time.Hour),
+			to:           OrderStatusIssued,
+			expectedErr:  ErrInvalidStatusTransition{From: OrderStatusReturnedToCourier, To: OrderStatusIssued},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			order := &Order{Status: tt.status, StorageUntil: tt.storageUntil}
+
+			err := order.Transition(tt.to, now)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.ErrorIs(t, err, ErrOrderStatusTransition)
+				assert.Equal(t, tt.status, order.Status)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.to, order.Status)
+		})
+	}
+}
+
+func TestOrder_TransitionSetsTimestamps(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	order := &Order{Status: OrderStatusAccepted, StorageUntil: now.Add(time.Hour)}
+
+	require.NoError(t, order.Transition(OrderStatusIssued, now))
+	assert.True(t, order.IssuedAt.Valid)
+	assert.True(t, order.IssuedAt.Time.Equal(now))
+	assert.False(t, order.ReturnedAt.Valid)
+
+	require.NoError(t, order.Transition(OrderStatusReturnedByClient, now))
+	assert.True(t, order.IssuedAt.Valid)
+	assert.True(t, order.ReturnedAt.Valid)
+	assert.False(t, order.ReturnedToCourierAt.Valid)
+	assert.Equal(t, OrderStatusReturnedByClient, order.Status)
+
+	require.NoError(t, order.Transition(OrderStatusReturnedToCourier, now))
+	assert.True(t, order.ReturnedToCourierAt.Valid)
+	assert.True(t, order.ReturnedToCourierAt.Time.Equal(now))
+	assert.Equal(t, OrderStatusReturnedToCourier, order.Status)
+}
+
+func TestOrder_TransitionFreesCell(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	order := &Order{Status: OrderStatusAccepted, StorageUntil: now.Add(-time.Hour), CellID: sql.NullInt64{Int64: 3, Valid: true}}
+
+	require.NoError(t, order.Transition(OrderStatusAwaitingReturn, now))
+	assert.True(t, order.CellID.Valid)
+
+	require.NoError(t, order.Transition(OrderStatusReturnedToCourier, now))
+	assert.False(t, order.CellID.Valid)
+}
+
+func TestParseOrderStatus(t *testing.T) {
+	t.Parallel()
+
+	status, err := ParseOrderStatus("issued")
+	require.NoError(t, err)
+	assert.Equal(t, OrderStatusIssued, status)
+
+	_, err = ParseOrderStatus("lost")
+	require.ErrorIs(t, err, ErrOrderStatusUnknown)
+}
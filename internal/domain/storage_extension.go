@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+)
+
+// StorageExtensionPolicy ограничения на продление срока хранения заказа.
+// Нулевое значение ограничения означает, что ограничения нет.
+type StorageExtensionPolicy struct {
+	// MaxTotal максимальное суммарное продление относительно исходного срока хранения
+	MaxTotal time.Duration
+	// MaxCount максимальное число продлений одного заказа
+	MaxCount int
+}
+
+// StorageExtension продление срока хранения, которое сохраняется в истории заказа
+type StorageExtension struct {
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+	Days  int       `json:"days"`
+	Count int       `json:"count"`
+}
+
+// ExtendStorage продлевает срок хранения заказа на days дней.
+// Суммарное продление считается от OriginalStorageUntil - срока хранения на момент приемки.
+// Срок просроченного заказа отсчитывается от момента продления, и заказ снова считается принятым.
+func (o *Order) ExtendStorage(policy StorageExtensionPolicy, days int, at time.Time) (StorageExtension, error) {
+	if days <= 0 {
+		return StorageExtension{}, ErrExtensionDaysInvalid
+	}
+
+	status := o.StatusAt(at)
+	if status != OrderStatusAccepted && !status.IsExpired() {
+		return StorageExtension{}, ErrExtensionNotAllowed
+	}
+
+	if policy.MaxCount > 0 && o.ExtensionCount >= policy.MaxCount {
+		return StorageExtension{}, ErrExtensionCountLimit
+	}
+
+	from := o.StorageUntil
+	base := from
+	if status.IsExpired() {
+		base = at.UTC()
+	}
+	to := base.AddDate(0, 0, days)
+
+	original := o.OriginalStorageUntil
+	if original.IsZero() {
+		original = from
+	}
+
+	if policy.MaxTotal > 0 && to.Sub(original) > policy.MaxTotal {
+		return StorageExtension{}, ErrExtensionTotalLimit
+	}
+
+	o.StorageUntil = to
+	o.OriginalStorageUntil = original
+	o.ExtensionCount++
+
+	// срок уже сдвинут, поэтому сохраненный статус expired или awaiting_courier_return переводится в accepted
+	if o.Status.IsExpired() {
+		err := o.Transition(OrderStatusAccepted, at)
+		if err != nil {
+			return StorageExtension{}, err
+		}
+	}
+
+	return StorageExtension{
+		From:  from,
+		To:    to,
+		Days:  days,
+		Count: o.ExtensionCount,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrder_ExtendStorage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	policy := StorageExtensionPolicy{MaxTotal: 5 * 24 * time.Hour, MaxCount: 2}
+
+	t.Run("accepted order", func(t *testing.T) {
+		t.Parallel()
+
+		storageUntil := now.Add(time.Hour)
+		order := &Order{Status: OrderStatusAccepted, StorageUntil: storageUntil, OriginalStorageUntil: storageUntil}
+
+		extension, err := order.ExtendStorage(policy, 3, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, storageUntil, extension.From)
+		assert.Equal(t, storageUntil.AddDate(0, 0, 3), order.StorageUntil)
+		assert.Equal(t, storageUntil, order.OriginalStorageUntil)
+		assert.Equal(t, 1, order.ExtensionCount)
+		assert.Equal(t, 1, extension.Count)
+	})
+
+	t.Run("expired order is extended from now and accepted again", func(t *testing.T) {
+		t.Parallel()
+
+		storageUntil := now.Add(-24 * time.Hour)
+		order := &Order{Status: OrderStatusExpired, StorageUntil: storageUntil, OriginalStorageUntil: storageUntil}
+
+		_, err := order.ExtendStorage(policy, 2, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, now.AddDate(0, 0, 2), order.StorageUntil)
+		assert.Equal(t, OrderStatusAccepted, order.Status)
+	})
+
+	t.Run("order awaiting courier return is extended and accepted again", func(t *testing.T) {
+		t.Parallel()
+
+		storageUntil := now.Add(-24 * time.Hour)
+		order := &Order{Status: OrderStatusAwaitingReturn, StorageUntil: storageUntil, OriginalStorageUntil: storageUntil}
+
+		_, err := order.ExtendStorage(policy, 2, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, now.AddDate(0, 0, 2), order.StorageUntil)
+		assert.Equal(t, OrderStatusAccepted, order.Status)
+	})
+
+	t.Run("issued order", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusIssued, StorageUntil: now.Add(time.Hour)}
+
+		_, err := order.ExtendStorage(policy, 1, now)
+
+		require.ErrorIs(t, err, ErrExtensionNotAllowed)
+	})
+
+	t.Run("count limit", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusAccepted, StorageUntil: now.Add(time.Hour), ExtensionCount: 2}
+
+		_, err := order.ExtendStorage(policy, 1, now)
+
+		require.ErrorIs(t, err, ErrExtensionCountLimit)
+		assert.Equal(t, 2, order.ExtensionCount)
+	})
+
+	t.Run("total limit", func(t *testing.T) {
+		t.Parallel()
+
+		original := now.Add(time.Hour)
+		order := &Order{
+			Status:               OrderStatusAccepted,
+			StorageUntil:         original.AddDate(0, 0, 4),
+			OriginalStorageUntil: original,
+			ExtensionCount:       1,
+		}
+
+		_, err := order.ExtendStorage(policy, 2, now)
+
+		require.ErrorIs(t, err, ErrExtensionTotalLimit)
+		assert.Equal(t, original.AddDate(0, 0, 4), order.StorageUntil)
+	})
+
+	t.Run("non-positive days", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusAccepted, StorageUntil: now.Add(time.Hour)}
+
+		_, err := order.ExtendStorage(policy, 0, now)
+
+		require.ErrorIs(t, err, ErrExtensionDaysInvalid)
+	})
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+// StorageFeePolicy правила начисления платы за хранение заказа после окончания срока хранения.
+// Суммы указываются в минимальных единицах валюты заказа.
+type StorageFeePolicy struct {
+	// FreeDays число дней после окончания срока хранения, за которые плата не берется
+	FreeDays int
+	// DailyRate плата за каждый следующий день
+	DailyRate int64
+	// Cap максимальная сумма платы; ноль означает, что ограничения нет
+	Cap int64
+}
+
+// StorageFeePolicies правила начисления платы по умолчанию и для отдельных типов упаковки
+type StorageFeePolicies struct {
+	Default      StorageFeePolicy
+	PackageTypes map[string]StorageFeePolicy
+}
+
+// StorageFee расчет платы за хранение заказа
+type StorageFee struct {
+	OverdueDays    int
+	FreeDays       int
+	ChargeableDays int
+	DailyRate      int64
+	Cap            int64
+	Capped         bool
+	Amount         Money
+}
+
+// AllowsLatePickup сообщает, можно ли выдать заказ после окончания срока хранения.
+// Нулевая политика сохраняет прежнее поведение: просроченный заказ не выдается.
+func (p StorageFeePolicy) AllowsLatePickup() bool {
+	return p.FreeDays > 0 || p.DailyRate > 0
+}
+
+// Calculate рассчитывает плату за хранение заказа, выдаваемого в момент at
+func (p StorageFeePolicy) Calculate(storageUntil, at time.Time, currency string) StorageFee {
+	fee := StorageFee{
+		FreeDays:  p.FreeDays,
+		DailyRate: p.DailyRate,
+		Cap:       p.Cap,
+		Amount:    Money{Currency: currency},
+	}
+
+	if !at.After(storageUntil) {
+		return fee
+	}
+
+	// неполные сутки просрочки считаются целым днем
+	fee.OverdueDays = int(math.Ceil(at.Sub(storageUntil).Hours() / 24))
+	fee.ChargeableDays = max(fee.OverdueDays-p.FreeDays, 0)
+	fee.Amount.Amount = int64(fee.ChargeableDays) * p.DailyRate
+
+	if p.Cap > 0 && fee.Amount.Amount > p.Cap {
+		fee.Amount.Amount = p.Cap
+		fee.Capped = true
+	}
+
+	return fee
+}
+
+// For возвращает правила для типа упаковки заказа.
+// Для составной упаковки берутся правила первого слоя, для которого они заданы.
+func (p StorageFeePolicies) For(packageType *OrderPackageType) StorageFeePolicy {
+	if packageType == nil {
+		return p.Default
+	}
+
+	for _, layer := range packageType.Layers() {
+		if policy, ok := p.PackageTypes[layer]; ok {
+			return policy
+		}
+	}
+
+	return p.Default
+}
+
+// Issue выдает заказ получателю и начисляет плату за хранение сверх срока.
+// Просроченный заказ выдается, только если политика разрешает позднюю выдачу.
+func (o *Order) Issue(policy StorageFeePolicy, at time.Time) (StorageFee, error) {
+	from := o.StatusAt(at)
+	if from.IsExpired() && !policy.AllowsLatePickup() {
+		return StorageFee{}, ErrInvalidStatusTransition{From: from, To: OrderStatusIssued}
+	}
+
+	err := o.Transition(OrderStatusIssued, at)
+	if err != nil {
+		return StorageFee{}, err
+	}
+
+	fee := policy.Calculate(o.StorageUntil, at, o.Currency)
+	o.StorageFee = fee.Amount.Amount
+
+	return fee, nil
+}
+
+// ToStorageFeeDTO преобразует расчет платы за хранение в DTO
+func ToStorageFeeDTO(orderID int64, fee StorageFee) *dto.StorageFee {
+	return &dto.StorageFee{
+		OrderID:        orderID,
+		OverdueDays:    fee.OverdueDays,
+		FreeDays:       fee.FreeDays,
+		ChargeableDays: fee.ChargeableDays,
+		DailyRate:      fee.DailyRate,
+		Cap:            fee.Cap,
+		Capped:         fee.Capped,
+		Amount:         fee.Amount.Amount,
+		Currency:       fee.Amount.Currency,
+	}
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageFeePolicy_Calculate(t *testing.T) {
+	t.Parallel()
+
+	storageUntil := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	policy := StorageFeePolicy{FreeDays: 2, DailyRate: 5000, Cap: 20000}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want StorageFee
+	}{
+		{
+			name: "before storage deadline",
+			at:   storageUntil.Add(-time.Hour),
+			want: StorageFee{FreeDays: 2, DailyRate: 5000, Cap: 20000, Amount: Money{Currency: "RUB"}},
+		},
+		{
+			name: "within free period",
+			at:   storageUntil.Add(36 * time.Hour),
+			want: StorageFee{OverdueDays: 2, FreeDays: 2, DailyRate: 5000, Cap: 20000, Amount: Money{Currency: "RUB"}},
+		},
+		{
+			name: "partial day is charged as a full day",
+			at:   storageUntil.Add(49 * time.Hour),
+			want: StorageFee{
+				OverdueDays:    3,
+				FreeDays:       2,
+				ChargeableDays: 1,
+				DailyRate:      5000,
+				Cap:            20000,
+				Amount:         Money{Amount: 5000, Currency: "RUB"},
+			},
+		},
+		{
+			name: "fee is capped",
+			at:   storageUntil.Add(10 * 24 * time.Hour),
+			want: StorageFee{
+				OverdueDays:    10,
+				FreeDays:       2,
+				ChargeableDays: 8,
+				DailyRate:      5000,
+				Cap:            20000,
+				Capped:         true,
+				Amount:         Money{Amount: 20000, Currency: "RUB"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, policy.Calculate(storageUntil, tt.at, "RUB"))
+		})
+	}
+}
+
+func TestStorageFeePolicies_For(t *testing.T) {
+	t.Parallel()
+
+	policies := StorageFeePolicies{
+		Default:      StorageFeePolicy{DailyRate: 5000},
+		PackageTypes: map[string]StorageFeePolicy{"box": {DailyRate: 10000}},
+	}
+
+	t.Run("package type override", func(t *testing.T) {
+		t.Parallel()
+
+		policy := policies.For(&OrderPackageType{OrderPackager: testPackageType("box")})
+
+		assert.Equal(t, int64(10000), policy.DailyRate)
+	})
+
+	t.Run("composite package uses first configured layer", func(t *testing.T) {
+		t.Parallel()
+
+		composite := &CompositePackage{layers: []OrderPackager{testPackageType("film"), testPackageType("box")}}
+
+		policy := policies.For(&OrderPackageType{OrderPackager: composite})
+
+		assert.Equal(t, int64(10000), policy.DailyRate)
+	})
+
+	t.Run("default policy", func(t *testing.T) {
+		t.Parallel()
+
+		policy := policies.For(&OrderPackageType{OrderPackager: testPackageType("film")})
+
+		assert.Equal(t, int64(5000), policy.DailyRate)
+	})
+}
+
+func TestOrder_Issue(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	t.Run("overdue order is issued with fee", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusAccepted, StorageUntil: now.Add(-25 * time.Hour), Currency: "RUB"}
+
+		fee, err := order.Issue(StorageFeePolicy{DailyRate: 5000}, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, OrderStatusIssued, order.Status)
+		assert.Equal(t, int64(10000), fee.Amount.Amount)
+		assert.Equal(t, int64(10000), order.StorageFee)
+	})
+
+	t.Run("overdue order without late pickup policy", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusAccepted, StorageUntil: now.Add(-time.Hour)}
+
+		_, err := order.Issue(StorageFeePolicy{}, now)
+
+		require.ErrorIs(t, err, ErrInvalidStatusTransition{From: OrderStatusExpired, To: OrderStatusIssued})
+		assert.Equal(t, OrderStatusAccepted, order.Status)
+	})
+
+	t.Run("already issued order", func(t *testing.T) {
+		t.Parallel()
+
+		order := &Order{Status: OrderStatusIssued, StorageUntil: now.Add(time.Hour)}
+
+		_, err := order.Issue(StorageFeePolicy{DailyRate: 5000}, now)
+
+		require.ErrorIs(t, err, ErrOrderStatusTransition)
+	})
+}
@@ -0,0 +1,13 @@
+package dto
+
+type AcceptBatchRequest struct {
+	Orders []*Order `json:"orders"`
+	Atomic bool     `json:"atomic"`
+}
+
+type AcceptResult struct {
+	OrderID int64  `json:"order_id"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Order   *Order `json:"order,omitempty"`
+}
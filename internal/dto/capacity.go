@@ -0,0 +1,9 @@
+package dto
+
+type Capacity struct {
+	PickupPointID int64   `json:"pvz_id"`
+	OrderCount    int64   `json:"order_count"`
+	MaxOrders     int64   `json:"max_orders"`
+	Weight        float64 `json:"weight"`
+	MaxWeight     float64 `json:"max_weight"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+)
+
+type Cell struct {
+	ID           int64     `json:"id"`
+	Zone         string    `json:"zone"`
+	Rack         string    `json:"rack"`
+	Shelf        string    `json:"shelf"`
+	Code         string    `json:"code"`
+	MaxOrders    int       `json:"max_orders"`
+	MaxWeight    float64   `json:"max_weight"`
+	PackageTypes []string  `json:"package_types,omitempty"`
+	OrderCount   int       `json:"order_count"`
+	Weight       float64   `json:"weight"`
+	CreatedAt    time.Time `json:"created_at"`
+}
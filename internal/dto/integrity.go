@@ -0,0 +1,11 @@
+package dto
+
+type IntegrityReport struct {
+	Checked    int                   `json:"checked"`
+	Violations []*IntegrityViolation `json:"violations"`
+}
+
+type IntegrityViolation struct {
+	OrderID int64  `json:"order_id"`
+	Status  string `json:"status"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+type IssueRequest struct {
+	RecipientID int64            `json:"recipient_id"`
+	OrderIDs    []int64          `json:"order_ids"`
+	PickupCodes map[int64]string `json:"pickup_codes"`
+	Atomic      bool             `json:"atomic"`
+}
+
+type IssueResult struct {
+	OrderID    int64       `json:"order_id"`
+	Status     string      `json:"status"`
+	StorageFee *StorageFee `json:"storage_fee,omitempty"`
+}
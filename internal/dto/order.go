@@ -5,20 +5,39 @@ import (
 )
 
 type Order struct {
-	OrderID      int64     `json:"order_id"`
-	RecipientID  int64     `json:"recipient_id"`
-	StorageUntil time.Time `json:"storage_until"`
-	IssuedAt     time.Time `json:"issued_at"`
-	ReturnAt     time.Time `json:"return_at"`
-	PackageType  string    `json:"package_type"`
-	Weight       float64   `json:"weight"`
-	Cost         float64   `json:"cost"`
-}
-
-func (o *Order) IsIssued() bool {
-	return !o.IssuedAt.IsZero()
-}
-
-func (o *Order) IsReturned() bool {
-	return !o.ReturnAt.IsZero()
+	OrderID              int64         `json:"order_id"`
+	RecipientID          int64         `json:"recipient_id"`
+	PickupPointID        int64         `json:"pvz_id"`
+	CourierID            int64         `json:"courier_id"`
+	CellID               int64         `json:"cell_id,omitempty"`
+	CellCode             string        `json:"cell_code,omitempty"`
+	StorageUntil         time.Time     `json:"storage_until"`
+	OriginalStorageUntil time.Time     `json:"original_storage_until"`
+	ExtensionCount       int           `json:"extension_count"`
+	IssuedAt             time.Time     `json:"issued_at"`
+	ReturnAt             time.Time     `json:"return_at"`
+	ReturnedToCourierAt  time.Time     `json:"returned_to_courier_at"`
+	Status               string        `json:"status"`
+	PackageType          string        `json:"package_type"`
+	PackageLayers        []string      `json:"package_layers,omitempty"`
+	PackageCost          int64         `json:"package_cost"`
+	Category             string        `json:"category,omitempty"`
+	Weight               float64       `json:"weight"`
+	Length               float64       `json:"length"`
+	Width                float64       `json:"width"`
+	Height               float64       `json:"height"`
+	Cost                 int64         `json:"cost"`
+	Currency             string        `json:"currency"`
+	TotalCost            int64         `json:"total_cost"`
+	StorageFee           int64         `json:"storage_fee"`
+	PickupCodeAttempts   int           `json:"pickup_code_attempts,omitempty"`
+	ReturnReason         string        `json:"return_reason,omitempty"`
+	ReturnCondition      string        `json:"return_condition,omitempty"`
+	ReturnNote           string        `json:"return_note,omitempty"`
+	RefundAmount         *int64        `json:"refund_amount,omitempty"`
+	ReturnWindow         time.Duration `json:"return_window"`
+	ReturnDeadline       time.Time     `json:"return_deadline,omitempty"`
+	CreateRecipient      bool          `json:"create_recipient,omitempty"`
+	Hash                 string        `json:"hash,omitempty"`
+	Version              int64         `json:"version,omitempty"`
 }
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"time"
+)
+
+type OrderEvent struct {
+	ID        int64     `json:"id"`
+	OrderID   int64     `json:"order_id"`
+	OldStatus string    `json:"old_status"`
+	NewStatus string    `json:"new_status"`
+	Operation string    `json:"operation"`
+	Payload   string    `json:"payload"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"time"
+)
+
+type PackageType struct {
+	Name              string    `json:"name"`
+	MaxWeight         float64   `json:"max_weight"`
+	MaxLength         float64   `json:"max_length"`
+	MaxWidth          float64   `json:"max_width"`
+	MaxHeight         float64   `json:"max_height"`
+	VolumetricDivisor float64   `json:"volumetric_divisor"`
+	Cost              int64     `json:"cost"`
+	Currency          string    `json:"currency"`
+	Active            bool      `json:"active"`
+	CreatedAt         time.Time `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"time"
+)
+
+type Recipient struct {
+	ID               int64     `json:"id"`
+	Name             string    `json:"name"`
+	Phone            string    `json:"phone"`
+	Email            string    `json:"email"`
+	PreferredChannel string    `json:"preferred_channel"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+type StorageFee struct {
+	OrderID        int64  `json:"order_id"`
+	OverdueDays    int    `json:"overdue_days"`
+	FreeDays       int    `json:"free_days"`
+	ChargeableDays int    `json:"chargeable_days"`
+	DailyRate      int64  `json:"daily_rate"`
+	Cap            int64  `json:"cap"`
+	Capped         bool   `json:"capped"`
+	Amount         int64  `json:"amount"`
+	Currency       string `json:"currency"`
+}
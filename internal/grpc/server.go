@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"time"
 
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -42,13 +43,36 @@ func init() {
 		metrics.IssuedOrders,
 		metrics.ReturnedOrders,
 		metrics.OperationDuration,
-		metrics.OrdersProcessedTotal,
+		metrics.OrdersProcessed,
 	)
 }
 
-func NewGRPCServer(orderService *module.Module, sender *kafka.Sender) *OrderServer {
+// defaultIdempotencyTTL сколько хранится ответ на запрос с ключом идемпотентности
+const defaultIdempotencyTTL = 24 * time.Hour
+
+// idempotentMethods методы, которые изменяют заказы и поддерживают ключ идемпотентности
+var idempotentMethods = []string{
+	order.Order_AcceptOrderFromCourier_FullMethodName,
+	order.Order_AcceptOrdersBatch_FullMethodName,
+	order.Order_ReturnOrderToCourier_FullMethodName,
+	order.Order_IssueOrderToClient_FullMethodName,
+	order.Order_AcceptReturnFromClient_FullMethodName,
+}
+
+func NewGRPCServer(
+	orderService *module.Module,
+	sender *kafka.Sender,
+	pickupPoints middleware.PickupPointRegistry,
+	idempotencyKeys middleware.IdempotencyStore,
+	idempotency config.IdempotencyConfig,
+) *OrderServer {
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
 
+	idempotencyTTL := idempotency.TTL
+	if idempotencyTTL <= 0 {
+		idempotencyTTL = defaultIdempotencyTTL
+	}
+
 	kasp := keepalive.ServerParameters{
 		MaxConnectionIdle:     30 * time.Minute, // максимальное время бездействия соединения
 		MaxConnectionAge:      30 * time.Minute, // максимальное время соединения
@@ -64,12 +88,14 @@ func NewGRPCServer(orderService *module.Module, sender *kafka.Sender) *OrderServ
 			grpc_opentracing.UnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			middleware.Logging,
+			middleware.PickupPoint(pickupPoints),
+			middleware.Idempotency(idempotencyKeys, idempotencyTTL, idempotentMethods...),
 		),
 	)
 
 	grpcMetrics.InitializeMetrics(grpcServer)
 
-	order.RegisterOrderServer(grpcServer, api.NewOrderGRPCService(orderService, sender))
+	order.RegisterOrderServer(grpcServer, api.NewOrderService(orderService, sender))
 
 	return &OrderServer{
 		Server:       grpcServer,
@@ -102,7 +128,7 @@ func (s *OrderServer) RunGRPCServer(cfg *config.Config) {
 		}
 	}()
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 	log.Println("Shutting down gRPC grpc...")
@@ -116,7 +142,19 @@ func (s *OrderServer) RunProxyServer(cfg *config.Config) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if strings.EqualFold(key, middleware.PickupPointHeader) {
+				return middleware.PickupPointHeader, true
+			}
+
+			if strings.EqualFold(key, middleware.IdempotencyHeader) {
+				return middleware.IdempotencyHeader, true
+			}
+
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+	)
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
@@ -143,7 +181,7 @@ func (s *OrderServer) RunProxyServer(cfg *config.Config) {
 		}
 	}()
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
 	log.Println("Shutting down proxy grpc...")
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -8,7 +9,8 @@ import (
 )
 
 const (
-	statusLabel = "status"
+	statusLabel      = "status"
+	pickupPointLabel = "pvz_id"
 )
 
 type metricStatus string
@@ -47,6 +49,13 @@ var (
 		Help: "Total number of return orders accepted from clients",
 	})
 
+	OrdersAwaitingReturn = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oms_orders_awaiting_courier_return",
+		Help: "Number of expired orders waiting to be returned to the courier, labeled by pickup point",
+	}, []string{
+		pickupPointLabel,
+	})
+
 	OperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "oms_operation_duration_seconds",
 		Help:    "Duration of operations",
@@ -74,14 +83,18 @@ func AddReturnedOrders() {
 	ReturnedOrders.Inc()
 }
 
-func AddIssuedOrders() {
-	IssuedOrders.Inc()
+func AddIssuedOrders(count int) {
+	IssuedOrders.Add(float64(count))
 }
 
 func AddAcceptedReturns() {
 	AcceptedReturns.Inc()
 }
 
+func SetOrdersAwaitingReturn(pickupPointID int64, count int64) {
+	OrdersAwaitingReturn.With(prometheus.Labels{pickupPointLabel: strconv.FormatInt(pickupPointID, 10)}).Set(float64(count))
+}
+
 func ObserveOperationDuration(operation string, duration time.Duration) {
 	OperationDuration.WithLabelValues(operation).Observe(duration.Seconds())
 }
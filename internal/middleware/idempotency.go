@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// IdempotencyHeader ключ метаданных запроса с ключом идемпотентности
+const IdempotencyHeader = "idempotency-key"
+
+// maxIdempotencyKeyLength максимальная длина ключа идемпотентности
+const maxIdempotencyKeyLength = 255
+
+type IdempotencyStore interface {
+	CreateIdempotencyKey(ctx context.Context, key *domain.IdempotencyKey) (bool, error)
+	FindIdempotencyKey(ctx context.Context, key string) (*domain.IdempotencyKey, error)
+	SaveIdempotencyResponse(ctx context.Context, key string, response []byte) error
+	DeleteIdempotencyKey(ctx context.Context, key string) error
+}
+
+// Idempotency выполняет запросы methods с ключом идемпотентности из метаданных не больше одного раза за ttl.
+// Повтор запроса с тем же ключом и телом получает сохраненный ответ, а запрос с тем же ключом и другим телом
+// отклоняется. Сохраняются только успешные ответы: после ошибки ключ освобождается, и запрос можно повторить.
+// Запросы без ключа выполняются как обычно. Перехватчик должен стоять в цепочке после PickupPoint.
+func Idempotency(store IdempotencyStore, ttl time.Duration, methods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		const op = "interceptor.Idempotency"
+
+		if !slices.Contains(methods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		values := md.Get(IdempotencyHeader)
+		if len(values) == 0 {
+			return handler(ctx, req)
+		}
+
+		key := values[0]
+		if key == "" || len(key) > maxIdempotencyKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "metadata %q must be from 1 to %d characters",
+				IdempotencyHeader, maxIdempotencyKeyLength)
+		}
+
+		pickupPointID, ok := domain.PickupPointIDFromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, domain.ErrPickupPointRequired.Error())
+		}
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return nil, status.Error(codes.Internal, "failed to convert request to proto.Message")
+		}
+
+		fingerprint, err := requestFingerprint(info.FullMethod, message)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+		}
+
+		record := domain.NewIdempotencyKey(pickupPointID, key, info.FullMethod, fingerprint, time.Now().UTC(), ttl)
+
+		created, err := store.CreateIdempotencyKey(ctx, record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+		}
+
+		if !created {
+			return replay(ctx, store, key, info.FullMethod, fingerprint)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// заказы не изменились, поэтому повтор запроса с этим ключом должен выполниться заново
+			if deleteErr := store.DeleteIdempotencyKey(ctx, key); deleteErr != nil {
+				log.Printf("[%s] can not release key %q: %v\n", op, key, deleteErr)
+			}
+
+			return nil, err
+		}
+
+		// ответ уже получен, поэтому ошибка сохранения не отменяет запрос, а только лишает повтор ответа
+		response, err := marshalResponse(resp)
+		if err == nil {
+			err = store.SaveIdempotencyResponse(ctx, key, response)
+		}
+		if err != nil {
+			log.Printf("[%s] can not save response for key %q: %v\n", op, key, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// replay возвращает сохраненный ответ на запрос, который уже выполнялся с ключом идемпотентности key
+func replay(ctx context.Context, store IdempotencyStore, key, method, fingerprint string) (any, error) {
+	record, err := store.FindIdempotencyKey(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrIdempotencyKeyNotFound) {
+			return nil, status.Errorf(codes.Aborted, "request with idempotency key %q failed, retry it", key)
+		}
+
+		return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+
+	if !record.Matches(method, fingerprint) {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key %q is already used for another request", key)
+	}
+
+	if !record.Completed() {
+		return nil, status.Errorf(codes.Aborted, "request with idempotency key %q is still in progress", key)
+	}
+
+	var saved anypb.Any
+
+	err = proto.Unmarshal(record.Response, &saved)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+
+	resp, err := saved.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+	}
+
+	return resp, nil
+}
+
+// requestFingerprint возвращает хэш метода и тела запроса.
+// Тело сериализуется детерминированно, чтобы одинаковые запросы с map давали одинаковый хэш.
+func requestFingerprint(method string, req proto.Message) (string, error) {
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(raw)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// marshalResponse сериализует ответ вместе с его типом, чтобы повтор вернул ответ того же типа
+func marshalResponse(resp any) ([]byte, error) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return nil, errors.New("failed to convert response to proto.Message")
+	}
+
+	saved, err := anypb.New(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(saved)
+}
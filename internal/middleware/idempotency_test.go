@@ -0,0 +1,204 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// idempotencyStoreStub хранит ключи идемпотентности одного ПВЗ в памяти
+type idempotencyStoreStub struct {
+	mu   sync.Mutex
+	keys map[string]*domain.IdempotencyKey
+}
+
+func newIdempotencyStoreStub() *idempotencyStoreStub {
+	return &idempotencyStoreStub{keys: make(map[string]*domain.IdempotencyKey)}
+}
+
+func (s *idempotencyStoreStub) CreateIdempotencyKey(_ context.Context, key *domain.IdempotencyKey) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.keys[key.Key]; ok && existing.ExpiresAt.After(key.CreatedAt) {
+		return false, nil
+	}
+
+	s.keys[key.Key] = key
+
+	return true, nil
+}
+
+func (s *idempotencyStoreStub) FindIdempotencyKey(_ context.Context, key string) (*domain.IdempotencyKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.keys[key]
+	if !ok {
+		return nil, storage.ErrIdempotencyKeyNotFound
+	}
+
+	return existing, nil
+}
+
+func (s *idempotencyStoreStub) SaveIdempotencyResponse(_ context.Context, key string, response []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key].Response = response
+
+	return nil
+}
+
+func (s *idempotencyStoreStub) DeleteIdempotencyKey(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+
+	return nil
+}
+
+func TestIdempotency(t *testing.T) {
+	t.Parallel()
+
+	info := &grpc.UnaryServerInfo{FullMethod: order.Order_AcceptOrderFromCourier_FullMethodName}
+
+	keyContext := func(key string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyHeader, key))
+
+		return domain.WithPickupPointID(ctx, 1)
+	}
+
+	// acceptHandler считает вызовы и отвечает номером вызова в сообщении
+	acceptHandler := func(calls *int) grpc.UnaryHandler {
+		return func(_ context.Context, req any) (any, error) {
+			*calls++
+
+			return &order.AcceptOrderResponse{
+				Message: strconv.Itoa(*calls),
+				OrderId: req.(*order.AcceptOrderRequest).GetOrderId(),
+			}, nil
+		}
+	}
+
+	t.Run("retry replays saved response", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := Idempotency(newIdempotencyStoreStub(), time.Hour, info.FullMethod)
+		req := &order.AcceptOrderRequest{OrderId: 7}
+
+		calls := 0
+		first, err := interceptor(keyContext("key-1"), req, info, acceptHandler(&calls))
+		require.NoError(t, err)
+
+		retry, err := interceptor(keyContext("key-1"), req, info, acceptHandler(&calls))
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+		require.IsType(t, &order.AcceptOrderResponse{}, retry)
+		assert.Equal(t, int64(7), retry.(*order.AcceptOrderResponse).GetOrderId())
+		assert.Equal(t, first.(*order.AcceptOrderResponse).GetMessage(), retry.(*order.AcceptOrderResponse).GetMessage())
+	})
+	t.Run("reused key with another payload is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := Idempotency(newIdempotencyStoreStub(), time.Hour, info.FullMethod)
+
+		calls := 0
+		_, err := interceptor(keyContext("key-1"), &order.AcceptOrderRequest{OrderId: 7}, info, acceptHandler(&calls))
+		require.NoError(t, err)
+
+		_, err = interceptor(keyContext("key-1"), &order.AcceptOrderRequest{OrderId: 8}, info, acceptHandler(&calls))
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("failed request releases key", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := Idempotency(newIdempotencyStoreStub(), time.Hour, info.FullMethod)
+		req := &order.AcceptOrderRequest{OrderId: 7}
+
+		failing := func(context.Context, any) (any, error) {
+			return nil, status.Error(codes.Unavailable, "database is unavailable")
+		}
+
+		_, err := interceptor(keyContext("key-1"), req, info, failing)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+
+		calls := 0
+		_, err = interceptor(keyContext("key-1"), req, info, acceptHandler(&calls))
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("request in progress is aborted", func(t *testing.T) {
+		t.Parallel()
+
+		store := newIdempotencyStoreStub()
+		interceptor := Idempotency(store, time.Hour, info.FullMethod)
+		req := &order.AcceptOrderRequest{OrderId: 7}
+
+		calls := 0
+		inner := acceptHandler(&calls)
+
+		var retryErr error
+		handler := func(ctx context.Context, req any) (any, error) {
+			_, retryErr = interceptor(keyContext("key-1"), req, info, inner)
+
+			return inner(ctx, req)
+		}
+
+		_, err := interceptor(keyContext("key-1"), req, info, handler)
+
+		require.NoError(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(retryErr))
+		assert.Equal(t, 1, calls)
+	})
+	t.Run("request without key or for another method is not tracked", func(t *testing.T) {
+		t.Parallel()
+
+		store := newIdempotencyStoreStub()
+		interceptor := Idempotency(store, time.Hour, info.FullMethod)
+		req := &order.AcceptOrderRequest{OrderId: 7}
+
+		calls := 0
+		ctx := domain.WithPickupPointID(context.Background(), 1)
+		listInfo := &grpc.UnaryServerInfo{FullMethod: order.Order_ListOrders_FullMethodName}
+
+		for i := 0; i < 2; i++ {
+			_, err := interceptor(ctx, req, info, acceptHandler(&calls))
+			require.NoError(t, err)
+
+			_, err = interceptor(keyContext("key-1"), req, listInfo, acceptHandler(&calls))
+			require.NoError(t, err)
+		}
+
+		assert.Equal(t, 4, calls)
+		assert.Empty(t, store.keys)
+	})
+	t.Run("empty key is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := Idempotency(newIdempotencyStoreStub(), time.Hour, info.FullMethod)
+
+		calls := 0
+		_, err := interceptor(keyContext(""), &order.AcceptOrderRequest{OrderId: 7}, info, acceptHandler(&calls))
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Zero(t, calls)
+	})
+}
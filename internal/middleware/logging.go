@@ -8,8 +8,14 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// sensitiveFields поля запросов, значения которых не пишутся в журнал
+var sensitiveFields = map[protoreflect.Name]struct{}{
+	"pickup_codes": {},
+}
+
 func Logging(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 	const op = "interceptor.Logging"
 	//md, ok := metadata.FromIncomingContext(ctx)
@@ -23,7 +29,7 @@ func Logging(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler g
 		return nil, fmt.Errorf("failed to convert request to proto.Message")
 	}
 
-	raw, err := protojson.Marshal(message)
+	raw, err := protojson.Marshal(redact(message))
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal proto message: %w", err)
 	}
@@ -40,3 +46,37 @@ func Logging(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler g
 
 	return
 }
+
+// redact возвращает копию сообщения, в которой очищены поля из sensitiveFields на любой вложенности
+func redact(message proto.Message) proto.Message {
+	clone := proto.Clone(message)
+	redactFields(clone.ProtoReflect())
+
+	return clone
+}
+
+func redactFields(message protoreflect.Message) {
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if _, ok := sensitiveFields[field.Name()]; ok {
+			message.Clear(field)
+			return true
+		}
+
+		switch {
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				redactFields(list.Get(i).Message())
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				redactFields(value.Message())
+				return true
+			})
+		case field.Message() != nil && !field.IsMap():
+			redactFields(value.Message())
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/pkg/api/proto/order/v1/order/v1"
+	"google.golang.org/grpc"
+)
+
+func TestLogging(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	req := &order.IssueOrderRequest{
+		OrderIds:    []int64{1},
+		PickupCodes: map[int64]string{1: "123456"},
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/order.Order/IssueOrderToClient"}
+
+	_, err := Logging(context.Background(), req, info, func(_ context.Context, req any) (any, error) {
+		issue, ok := req.(*order.IssueOrderRequest)
+		require.True(t, ok)
+		assert.Equal(t, "123456", issue.GetPickupCodes()[1], "handler must receive the original request")
+
+		return &order.IssueOrderResponse{}, nil
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "/order.Order/IssueOrderToClient")
+	assert.Contains(t, buf.String(), "orderIds")
+	assert.NotContains(t, buf.String(), "123456")
+}
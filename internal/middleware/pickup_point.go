@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PickupPointHeader ключ метаданных запроса с идентификатором ПВЗ вызывающей стороны
+const PickupPointHeader = "x-pvz-id"
+
+type PickupPointRegistry interface {
+	FindPickupPointByID(ctx context.Context, id int64) (*domain.PickupPoint, error)
+}
+
+// PickupPoint определяет ПВЗ вызывающей стороны по метаданным запроса и проверяет, что он зарегистрирован.
+// Дальше по цепочке все операции выполняются от имени этого ПВЗ.
+func PickupPoint(registry PickupPointRegistry) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		values := md.Get(PickupPointHeader)
+		if len(values) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: metadata %q is required", domain.ErrPickupPointRequired, PickupPointHeader)
+		}
+
+		pickupPointID, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil || pickupPointID <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pickup point id: %q", values[0])
+		}
+
+		_, err = registry.FindPickupPointByID(ctx, pickupPointID)
+		if err != nil {
+			if errors.Is(err, storage.ErrPickupPointNotFound) {
+				return nil, status.Errorf(codes.PermissionDenied, "unknown pickup point: %d", pickupPointID)
+			}
+
+			return nil, status.Errorf(codes.Internal, "internal server error: %v", err)
+		}
+
+		return handler(domain.WithPickupPointID(ctx, pickupPointID), req)
+	}
+}
+
+// PickupPointClient добавляет идентификатор ПВЗ в метаданные каждого исходящего запроса
+func PickupPointClient(pickupPointID int64) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, PickupPointHeader, strconv.FormatInt(pickupPointID, 10))
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type pickupPointRegistryStub map[int64]*domain.PickupPoint
+
+func (r pickupPointRegistryStub) FindPickupPointByID(_ context.Context, id int64) (*domain.PickupPoint, error) {
+	pickupPoint, ok := r[id]
+	if !ok {
+		return nil, storage.ErrPickupPointNotFound
+	}
+
+	return pickupPoint, nil
+}
+
+func TestPickupPoint(t *testing.T) {
+	t.Parallel()
+
+	interceptor := PickupPoint(pickupPointRegistryStub{1: {ID: 1, Name: "default"}})
+	info := &grpc.UnaryServerInfo{FullMethod: "/order.Order/ListOrders"}
+
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		wantCode codes.Code
+	}{
+		{name: "registered pickup point", md: metadata.Pairs(PickupPointHeader, "1"), wantCode: codes.OK},
+		{name: "missing metadata", md: metadata.MD{}, wantCode: codes.InvalidArgument},
+		{name: "invalid id", md: metadata.Pairs(PickupPointHeader, "abc"), wantCode: codes.InvalidArgument},
+		{name: "unknown pickup point", md: metadata.Pairs(PickupPointHeader, "2"), wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+
+			var handlerPickupPointID int64
+			handler := func(ctx context.Context, req any) (any, error) {
+				handlerPickupPointID, _ = domain.PickupPointIDFromContext(ctx)
+				return req, nil
+			}
+
+			_, err := interceptor(ctx, nil, info, handler)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.EqualValues(t, 1, handlerPickupPointID)
+			}
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// Результаты приемки отдельного заказа из партии
+const (
+	acceptStatusAccepted               = "accepted"
+	acceptStatusInvalid                = "invalid"
+	acceptStatusDuplicated             = "duplicated"
+	acceptStatusAlreadyExists          = "already_exists"
+	acceptStatusRecipientNotRegistered = "recipient_not_registered"
+	acceptStatusPickupPointFull        = "pickup_point_full"
+	acceptStatusNoFreeCell             = "no_free_cell"
+)
+
+// acceptance заказ, подготовленный к приемке от курьера
+type acceptance struct {
+	order      *domain.Order
+	pickupCode string
+	payload    dto.Order
+	cell       *domain.Cell
+}
+
+// AcceptOrdersBatch принимает от курьера партию заказов за одну операцию.
+// Каждый заказ проверяется так же, как в AcceptOrderCourier, а все принятые заказы сохраняются
+// одним запросом в одной транзакции.
+// В режиме Atomic отказ в приемке любого заказа отменяет приемку всей партии.
+// Иначе принимаются все подходящие заказы, а для остальных в результате возвращается причина отказа.
+// Результаты возвращаются в порядке заказов в партии.
+func (m *Module) AcceptOrdersBatch(ctx context.Context, request *dto.AcceptBatchRequest) ([]*dto.AcceptResult, error) {
+	const op = "module.Module.AcceptOrdersBatch"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	orders, atomic := request.Orders, request.Atomic
+
+	span.SetTag("count", len(orders))
+	span.SetTag("atomic", atomic)
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	results := make([]*dto.AcceptResult, len(orders))
+	pending := make([]*acceptance, len(orders))
+	seen := make(map[int64]struct{}, len(orders))
+
+	for i, order := range orders {
+		results[i] = &dto.AcceptResult{OrderID: order.OrderID}
+
+		_, duplicated := seen[order.OrderID]
+		seen[order.OrderID] = struct{}{}
+
+		if duplicated {
+			err = ErrOrderDuplicated
+		} else {
+			pending[i], err = m.prepareAcceptance(ctx, pvzID, order)
+		}
+
+		if err != nil {
+			if atomic {
+				return nil, fmt.Errorf("%s: order %d: %w", op, order.OrderID, err)
+			}
+
+			rejectResult(results[i], err)
+		}
+	}
+
+	// reject исключает заказ из партии; в режиме atomic отказ отменяет всю партию
+	reject := func(i int, err error) error {
+		if atomic {
+			return fmt.Errorf("order %d: %w", pending[i].order.ID, err)
+		}
+
+		rejectResult(results[i], err)
+		pending[i] = nil
+
+		return nil
+	}
+
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		existing, err := m.findExistingOrders(ctxTX, pending)
+		if err != nil {
+			return err
+		}
+
+		capacity, err := m.lockCapacity(ctxTX, pvzID)
+		if err != nil {
+			return err
+		}
+
+		cells, err := m.orderProvider.LockCells(ctxTX)
+		if err != nil {
+			return err
+		}
+
+		couriers := make(map[int64]struct{})
+		batch := make([]*domain.Order, 0, len(pending))
+
+		for i, a := range pending {
+			if a == nil {
+				continue
+			}
+
+			if _, ok := existing[a.order.ID]; ok {
+				err = ErrOrderExists
+			} else {
+				err = m.admitToBatch(ctxTX, a, capacity, cells, couriers)
+			}
+
+			if err != nil {
+				if !rejected(err) {
+					return err
+				}
+
+				if err := reject(i, err); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			a.order.Seal(m.integrityKey)
+			batch = append(batch, a.order)
+		}
+
+		created, err := m.orderSaver.CreateOrders(ctxTX, batch)
+		if err != nil {
+			return err
+		}
+
+		saved := make(map[int64]struct{}, len(created))
+		for _, id := range created {
+			saved[id] = struct{}{}
+		}
+
+		for i, a := range pending {
+			if a == nil {
+				continue
+			}
+
+			// заказ мог появиться в другом ПВЗ или в параллельной приемке уже после проверки
+			if _, ok := saved[a.order.ID]; !ok {
+				if err := reject(i, ErrOrderExists); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			err = m.saveOrderEvent(ctxTX, a.order, "", domain.OperationAcceptOrderCourier, a.payload)
+			if err != nil {
+				return err
+			}
+
+			err = m.orderSaver.CreateNotification(ctxTX, domain.NewOrderArrivedNotification(a.order, a.pickupCode))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "batch_save_error", "error", err.Error())
+
+		if !rejected(err) {
+			m.logger.Error("error while saving orders batch with transaction", zap.Error(err))
+		}
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	accepted := 0
+	for i, a := range pending {
+		if a == nil {
+			continue
+		}
+
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, a.order.ID), a.order)
+		metrics.AddAcceptedOrders()
+
+		results[i].Status = acceptStatusAccepted
+		results[i].Order = m.acceptedDTO(a)
+		accepted++
+	}
+
+	span.LogKV("event", "batch_accepted", "accepted", accepted, "rejected", len(orders)-accepted)
+	m.logger.Info("orders batch accepted from courier", zap.Int("accepted", accepted), zap.Int("rejected", len(orders)-accepted))
+
+	return results, nil
+}
+
+// prepareAcceptance проверяет заказ, который курьер привез в ПВЗ, и готовит его к сохранению:
+// собирает упаковку, рассчитывает стоимость и генерирует код выдачи
+func (m *Module) prepareAcceptance(ctx context.Context, pvzID int64, order *dto.Order) (*acceptance, error) {
+	span := opentracing.SpanFromContext(ctx)
+
+	if order.StorageUntil.Before(time.Now()) {
+		span.SetTag("error", true)
+		span.LogKV(
+			"event", "storage_time_expired",
+			"order_id", order.OrderID,
+			"storage_until", order.StorageUntil,
+		)
+
+		m.logger.Error("storage time is in the past")
+
+		return nil, ErrOrderStorageTimeExpired
+	}
+
+	if order.PackageType != "" && len(order.PackageLayers) > 0 {
+		span.SetTag("error", true)
+		span.LogKV("event", "package_layers_conflict", "order_id", order.OrderID)
+
+		return nil, ErrPackageLayersConflict
+	}
+
+	layers := order.PackageLayers
+	if len(layers) == 0 {
+		layers = []string{order.PackageType}
+	}
+
+	packageType, err := domain.NewCompositePackageType(layers)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV(
+			"event", "invalid_package_type",
+			"order_id", order.OrderID,
+			"package_layers", layers,
+			"error", err.Error(),
+		)
+
+		m.logger.Error("package type not valid", zap.Error(err))
+
+		return nil, err
+	}
+
+	acceptedOrder, err := domain.NewOrder(order, packageType)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV(
+			"event", "order_creation_error",
+			"order_id", order.OrderID,
+			"error", err.Error(),
+		)
+		m.logger.Error("error creating order", zap.Error(err))
+
+		return nil, err
+	}
+
+	acceptedOrder.PickupPointID = pvzID
+
+	pickupCode, err := acceptedOrder.GeneratePickupCode()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_code_error", "order_id", order.OrderID, "error", err.Error())
+
+		m.logger.Error("error generating pickup code", zap.Error(err))
+
+		return nil, err
+	}
+
+	// в историю попадают слои упаковки в том виде, в каком они сохранены в заказе
+	payload := *order
+	payload.PackageType = packageType.Type()
+	payload.PackageLayers = packageType.Layers()
+
+	return &acceptance{order: acceptedOrder, pickupCode: pickupCode, payload: payload}, nil
+}
+
+// admitToBatch проверяет получателя, вместимость ПВЗ и ячейки для заказа из партии
+// и резервирует за ним место; couriers - курьеры, которые уже сохранены в этой партии
+func (m *Module) admitToBatch(
+	ctx context.Context,
+	a *acceptance,
+	capacity *domain.Capacity,
+	cells []*domain.Cell,
+	couriers map[int64]struct{},
+) error {
+	err := m.checkRecipient(ctx, a.order.RecipientID, a.payload.CreateRecipient)
+	if err != nil {
+		return err
+	}
+
+	if capacity != nil {
+		err = capacity.Reserve(a.order)
+		if err != nil {
+			return err
+		}
+	}
+
+	a.cell, err = placeOrder(cells, a.order)
+	if err != nil {
+		return err
+	}
+
+	if courierID := a.order.CourierID; courierID.Valid {
+		if _, ok := couriers[courierID.Int64]; !ok {
+			err = m.orderSaver.CreateCourier(ctx, domain.NewCourier(courierID.Int64))
+			if err != nil {
+				return err
+			}
+
+			couriers[courierID.Int64] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// findExistingOrders возвращает идентификаторы заказов партии, которые уже есть в ПВЗ
+func (m *Module) findExistingOrders(ctx context.Context, pending []*acceptance) (map[int64]struct{}, error) {
+	ids := make([]int64, 0, len(pending))
+	for _, a := range pending {
+		if a != nil {
+			ids = append(ids, a.order.ID)
+		}
+	}
+
+	existing := make(map[int64]struct{})
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	orders, err := m.orderProvider.FindOrderByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		existing[order.ID] = struct{}{}
+	}
+
+	return existing, nil
+}
+
+// acceptedDTO возвращает принятый заказ вместе с ячейкой хранения.
+// Код выдачи не возвращается: его получает только получатель в уведомлении о прибытии заказа.
+func (m *Module) acceptedDTO(a *acceptance) *dto.Order {
+	result := m.toDTO(a.order)
+
+	if a.cell != nil {
+		result.CellCode = a.cell.Code()
+	}
+
+	return result
+}
+
+// rejectResult записывает в результат приемки причину отказа
+func rejectResult(result *dto.AcceptResult, err error) {
+	result.Reason = err.Error()
+
+	switch {
+	case errors.Is(err, ErrOrderDuplicated):
+		result.Status = acceptStatusDuplicated
+	case errors.Is(err, ErrOrderExists):
+		result.Status = acceptStatusAlreadyExists
+	case errors.Is(err, ErrRecipientNotRegistered):
+		result.Status = acceptStatusRecipientNotRegistered
+	case errors.Is(err, domain.ErrPickupPointFull):
+		result.Status = acceptStatusPickupPointFull
+	case errors.Is(err, domain.ErrCellNotFree):
+		result.Status = acceptStatusNoFreeCell
+	default:
+		result.Status = acceptStatusInvalid
+	}
+}
+
+// rejected сообщает, что заказ не может быть принят по бизнес-правилам,
+// и остальные заказы партии можно принять без него
+func rejected(err error) bool {
+	return errors.Is(err, ErrOrderExists) ||
+		errors.Is(err, ErrRecipientNotRegistered) ||
+		errors.Is(err, domain.ErrPickupPointFull) ||
+		errors.Is(err, domain.ErrCellNotFree)
+}
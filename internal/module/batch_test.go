@@ -0,0 +1,173 @@
+package module
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+func TestModule_AcceptOrdersBatch(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	newBatch := func(atomic bool) *dto.AcceptBatchRequest {
+		storageUntil := time.Now().Add(time.Hour)
+
+		return &dto.AcceptBatchRequest{
+			Orders: []*dto.Order{
+				{OrderID: 10, RecipientID: 1, CourierID: 3, StorageUntil: storageUntil, Weight: 2, PackageType: "box"},
+				{OrderID: 11, RecipientID: 2, CourierID: 3, StorageUntil: storageUntil, Weight: 3, PackageType: "box"},
+				{OrderID: 12, RecipientID: 1, CourierID: 3, StorageUntil: storageUntil.Add(-2 * time.Hour), PackageType: "box"},
+				{OrderID: 10, RecipientID: 1, CourierID: 3, StorageUntil: storageUntil, PackageType: "box"},
+			},
+			Atomic: atomic,
+		}
+	}
+
+	t.Run("should accept valid orders in one statement and report rejected", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{10, 11}).Return(nil, nil)
+		fx.expectCells(&domain.Cell{ID: 5, Zone: "A", Rack: "1", Shelf: "1", MaxOrders: 10, MaxWeight: 30})
+		fx.expectRegisteredRecipient(1)
+		fx.expectRegisteredRecipient(2)
+		fx.mockOrderSaver.EXPECT().CreateCourier(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		fx.mockOrderSaver.EXPECT().
+			CreateOrders(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, orders []*domain.Order) ([]int64, error) {
+				fx.require.Len(orders, 2)
+				for _, order := range orders {
+					fx.assert.Equal(testPickupPointID, order.PickupPointID)
+					fx.assert.Equal(int64(5), order.CellID.Int64)
+					fx.assert.Equal(domain.IntegrityStatusOK, order.CheckIntegrity(testIntegrityKey))
+				}
+				return []int64{10, 11}, nil
+			})
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+		fx.mockOrderSaver.EXPECT().
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *domain.Notification) error {
+				fx.assert.Equal(domain.NotificationOrderArrived, notification.Event)
+				fx.assert.Len(notification.PickupCode, domain.PickupCodeLength)
+				return nil
+			}).
+			Times(2)
+
+		// act
+		results, err := fx.module.AcceptOrdersBatch(ctx, newBatch(false))
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(results, 4)
+		fx.assert.Equal(acceptStatusAccepted, results[0].Status)
+		fx.assert.Equal("A-1-1", results[0].Order.CellCode)
+		fx.assert.Equal(acceptStatusAccepted, results[1].Status)
+		fx.assert.Equal(acceptStatusInvalid, results[2].Status)
+		fx.assert.Equal(ErrOrderStorageTimeExpired.Error(), results[2].Reason)
+		fx.assert.Equal(acceptStatusDuplicated, results[3].Status)
+		fx.assert.Nil(results[3].Order)
+	})
+	t.Run("should reject orders over pickup point capacity", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies.Capacity = domain.CapacityPolicies{Default: domain.CapacityPolicy{MaxOrders: 6}}
+
+		batch := newBatch(false)
+		batch.Orders = batch.Orders[:2]
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{10, 11}).Return(nil, nil)
+		fx.mockOrderProvider.EXPECT().LockPickupPoint(gomock.Any()).Return(nil)
+		fx.mockOrderProvider.EXPECT().
+			FindPickupPointLoad(gomock.Any()).
+			Return(&domain.PickupPointLoad{OrderCount: 5}, nil)
+		fx.expectCells()
+		fx.expectRegisteredRecipient(1)
+		fx.expectRegisteredRecipient(2)
+		fx.mockOrderSaver.EXPECT().CreateCourier(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrders(gomock.Any(), gomock.Len(1)).Return([]int64{10}, nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		results, err := fx.module.AcceptOrdersBatch(ctx, batch)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(acceptStatusAccepted, results[0].Status)
+		fx.assert.Equal(acceptStatusPickupPointFull, results[1].Status)
+	})
+	t.Run("should report orders that already exist", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		batch := newBatch(false)
+		batch.Orders = batch.Orders[:2]
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().
+			FindOrderByIDs(gomock.Any(), []int64{10, 11}).
+			Return([]*domain.Order{{ID: 10}}, nil)
+		fx.expectCells()
+		fx.expectRegisteredRecipient(2)
+		fx.mockOrderSaver.EXPECT().CreateCourier(gomock.Any(), gomock.Any()).Return(nil)
+		// заказ 11 успела сохранить параллельная приемка
+		fx.mockOrderSaver.EXPECT().CreateOrders(gomock.Any(), gomock.Len(1)).Return(nil, nil)
+
+		// act
+		results, err := fx.module.AcceptOrdersBatch(ctx, batch)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(acceptStatusAlreadyExists, results[0].Status)
+		fx.assert.Equal(acceptStatusAlreadyExists, results[1].Status)
+	})
+	t.Run("should reject whole atomic batch when order is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.AcceptOrdersBatch(ctx, newBatch(true))
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderStorageTimeExpired)
+	})
+	t.Run("should roll back atomic batch when recipient is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		batch := newBatch(true)
+		batch.Orders = batch.Orders[:2]
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{10, 11}).Return(nil, nil)
+		fx.expectCells()
+		fx.expectRegisteredRecipient(1)
+		fx.mockOrderSaver.EXPECT().CreateCourier(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), int64(2)).Return(nil, storage.ErrRecipientNotFound)
+
+		// act
+		_, err := fx.module.AcceptOrdersBatch(ctx, batch)
+
+		// assert
+		fx.require.ErrorIs(err, ErrRecipientNotRegistered)
+	})
+}
@@ -0,0 +1,85 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+)
+
+// GetCapacity возвращает текущую заполненность ПВЗ и ее ограничения по Policies.Capacity
+func (m *Module) GetCapacity(ctx context.Context) (*dto.Capacity, error) {
+	const op = "module.Module.GetCapacity"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	load, err := m.orderProvider.FindPickupPointLoad(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_load_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	capacity := domain.Capacity{
+		PickupPointID:   pvzID,
+		PickupPointLoad: *load,
+		CapacityPolicy:  m.policies.Capacity.For(pvzID),
+	}
+
+	span.LogKV("event", "capacity_fetched", "order_count", load.OrderCount, "weight", load.Weight)
+
+	return domain.ToCapacityDTO(capacity), nil
+}
+
+// checkCapacity проверяет, что ПВЗ заказа не переполнится после его приемки.
+// Если ограничения для ПВЗ не заданы, проверка не выполняется.
+func (m *Module) checkCapacity(ctx context.Context, order *domain.Order) error {
+	capacity, err := m.lockCapacity(ctx, order.PickupPointID)
+	if err != nil || capacity == nil {
+		return err
+	}
+
+	return capacity.Admit(order)
+}
+
+// lockCapacity блокирует ПВЗ до конца транзакции, чтобы параллельные приемки не превысили ограничения,
+// и возвращает его заполненность. Если ограничения для ПВЗ не заданы, возвращает nil без блокировки.
+func (m *Module) lockCapacity(ctx context.Context, pvzID int64) (*domain.Capacity, error) {
+	policy := m.policies.Capacity.For(pvzID)
+	if !policy.Limited() {
+		return nil, nil
+	}
+
+	err := m.orderProvider.LockPickupPoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	load, err := m.orderProvider.FindPickupPointLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Capacity{
+		PickupPointID:   pvzID,
+		PickupPointLoad: *load,
+		CapacityPolicy:  policy,
+	}, nil
+}
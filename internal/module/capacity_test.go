@@ -0,0 +1,115 @@
+package module
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+func TestModule_GetCapacity(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	t.Run("should return load with pickup point limits", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies.Capacity = domain.CapacityPolicies{
+			Default:      domain.CapacityPolicy{MaxOrders: 1000},
+			PickupPoints: map[int64]domain.CapacityPolicy{testPickupPointID: {MaxOrders: 100, MaxWeight: 500}},
+		}
+
+		fx.mockOrderProvider.EXPECT().
+			FindPickupPointLoad(gomock.Any()).
+			Return(&domain.PickupPointLoad{OrderCount: 42, Weight: 120.5}, nil)
+
+		// act
+		capacity, err := fx.module.GetCapacity(ctx)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(&dto.Capacity{
+			PickupPointID: testPickupPointID,
+			OrderCount:    42,
+			MaxOrders:     100,
+			Weight:        120.5,
+			MaxWeight:     500,
+		}, capacity)
+	})
+}
+
+func TestModule_AcceptOrderCourier_Capacity(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	newOrder := func() *dto.Order {
+		return &dto.Order{
+			OrderID:      20,
+			RecipientID:  1,
+			StorageUntil: time.Now().Add(time.Hour),
+			Weight:       5.0,
+			PackageType:  "box",
+		}
+	}
+
+	t.Run("should reject order when pickup point is full", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies.Capacity = domain.CapacityPolicies{Default: domain.CapacityPolicy{MaxOrders: 10, MaxWeight: 100}}
+
+		order := newOrder()
+
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().LockPickupPoint(gomock.Any()).Return(nil),
+			fx.mockOrderProvider.EXPECT().
+				FindPickupPointLoad(gomock.Any()).
+				Return(&domain.PickupPointLoad{OrderCount: 10, Weight: 40}, nil),
+		)
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPickupPointFull)
+
+		var capacityErr domain.ErrCapacityExceeded
+		fx.require.ErrorAs(err, &capacityErr)
+		fx.assert.Equal(int64(10), capacityErr.OrderCount)
+	})
+	t.Run("should accept order when pickup point has room", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies.Capacity = domain.CapacityPolicies{Default: domain.CapacityPolicy{MaxWeight: 100}}
+
+		order := newOrder()
+
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.mockOrderProvider.EXPECT().LockPickupPoint(gomock.Any()).Return(nil)
+		fx.mockOrderProvider.EXPECT().
+			FindPickupPointLoad(gomock.Any()).
+			Return(&domain.PickupPointLoad{OrderCount: 10, Weight: 95}, nil)
+		fx.expectCells()
+		fx.mockOrderSaver.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.NoError(err)
+	})
+}
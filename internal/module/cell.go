@@ -0,0 +1,284 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// CreateCell добавляет ячейку хранения в реестр ПВЗ
+func (m *Module) CreateCell(ctx context.Context, cell *dto.Cell) (*dto.Cell, error) {
+	const op = "module.Module.CreateCell"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created, err := domain.NewCell(cell)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "invalid_cell", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	created.PickupPointID = pvzID
+
+	err = m.orderSaver.CreateCell(ctx, created)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "create_cell_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, cellStorageError(err))
+	}
+
+	span.LogKV("event", "cell_created", "cell_id", created.ID, "code", created.Code())
+
+	return domain.ToCellDTO(created), nil
+}
+
+// ListCells возвращает ячейки ПВЗ вместе с их заполненностью
+func (m *Module) ListCells(ctx context.Context) ([]*dto.Cell, error) {
+	const op = "module.Module.ListCells"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	cells, err := m.orderProvider.FindCells(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_cells_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*dto.Cell, 0, len(cells))
+	for _, cell := range cells {
+		result = append(result, domain.ToCellDTO(cell))
+	}
+
+	return result, nil
+}
+
+// ListCellOrders возвращает заказы, которые лежат в ячейке cellID
+func (m *Module) ListCellOrders(ctx context.Context, cellID int64) ([]*dto.Order, error) {
+	const op = "module.Module.ListCellOrders"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("cell_id", cellID)
+
+	cell, err := m.orderProvider.FindCellByID(ctx, cellID)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_cell_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, cellStorageError(err))
+	}
+
+	orders, err := m.orderProvider.FindOrdersByCellID(ctx, cellID)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_orders_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*dto.Order, 0, len(orders))
+	for _, order := range orders {
+		orderDTO := m.toDTO(order)
+		orderDTO.CellCode = cell.Code()
+
+		result = append(result, orderDTO)
+	}
+
+	return result, nil
+}
+
+// MoveOrderToCell перекладывает заказ, который хранится в ПВЗ, в ячейку cellID.
+// Ячейки блокируются на время операции, чтобы не превысить их вместимость; перекладывание пишется в историю заказа.
+func (m *Module) MoveOrderToCell(ctx context.Context, orderID, cellID int64) (*dto.Order, error) {
+	var moved *dto.Order
+
+	err := m.retryOnConflict(ctx, []int64{orderID}, func() (err error) {
+		moved, err = m.moveOrderToCell(ctx, orderID, cellID)
+		return err
+	})
+
+	return moved, err
+}
+
+// moveOrderToCell перекладывает заказ в ячейку за одну попытку
+func (m *Module) moveOrderToCell(ctx context.Context, orderID, cellID int64) (*dto.Order, error) {
+	const op = "module.Module.MoveOrderToCell"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_id", orderID)
+	span.SetTag("cell_id", cellID)
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	order, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, orderID))
+	if !found {
+		order, err = m.orderProvider.FindOrderByID(ctx, orderID)
+		if err != nil {
+			span.SetTag("error", true)
+
+			if errors.Is(err, storage.ErrOrderNotFound) {
+				span.LogKV("event", "order_not_found", "order_id", orderID)
+
+				return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+			}
+
+			span.LogKV("event", "find_order_error", "order_id", orderID, "error", err.Error())
+
+			m.logger.Error("error while finding order", zap.Error(err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		span.LogKV("event", "order_cached", "order_id", orderID)
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), order)
+	}
+
+	var target *domain.Cell
+
+	// заказ из кэша меняется только после успешной записи в БД
+	candidate := *order
+
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		cells, err := m.orderProvider.LockCells(ctxTX)
+		if err != nil {
+			return err
+		}
+
+		target = findCell(cells, cellID)
+		if target == nil {
+			return ErrCellNotFound
+		}
+
+		if candidate.CellID.Valid && candidate.CellID.Int64 == cellID {
+			return nil
+		}
+
+		from := candidate.CellID.Int64
+
+		err = candidate.MoveTo(target)
+		if err != nil {
+			return err
+		}
+
+		err = m.updateOrder(ctxTX, &candidate)
+		if err != nil {
+			return err
+		}
+
+		return m.saveOrderEvent(ctxTX, &candidate, candidate.Status, domain.OperationMoveOrderToCell,
+			map[string]int64{"from_cell_id": from, "cell_id": cellID})
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "move_order_error", "order_id", orderID, "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), &candidate)
+
+	span.LogKV("event", "order_moved", "order_id", orderID, "cell", target.Code())
+
+	result := m.toDTO(&candidate)
+	result.CellCode = target.Code()
+
+	return result, nil
+}
+
+// assignCell выбирает для заказа свободную ячейку и кладет в нее заказ.
+// Если в ПВЗ еще нет ни одной ячейки, заказ принимается без ячейки.
+func (m *Module) assignCell(ctx context.Context, order *domain.Order) (*domain.Cell, error) {
+	cells, err := m.orderProvider.LockCells(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return placeOrder(cells, order)
+}
+
+// placeOrder кладет заказ в подходящую свободную ячейку из cells и учитывает его в ее заполненности.
+// Если ячеек нет, заказ остается без ячейки.
+func placeOrder(cells []*domain.Cell, order *domain.Order) (*domain.Cell, error) {
+	if len(cells) == 0 {
+		return nil, nil
+	}
+
+	cell, err := domain.SelectCell(cells, order)
+	if err != nil {
+		return nil, err
+	}
+
+	err = order.MoveTo(cell)
+	if err != nil {
+		return nil, err
+	}
+
+	return cell, nil
+}
+
+// findCell ищет ячейку cellID среди ячеек ПВЗ
+func findCell(cells []*domain.Cell, cellID int64) *domain.Cell {
+	for _, cell := range cells {
+		if cell.ID == cellID {
+			return cell
+		}
+	}
+
+	return nil
+}
+
+// cellStorageError переводит ошибки хранилища ячеек в ошибки модуля
+func cellStorageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrCellNotFound):
+		return ErrCellNotFound
+	case errors.Is(err, storage.ErrCellExists):
+		return ErrCellExists
+	default:
+		return err
+	}
+}
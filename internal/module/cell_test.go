@@ -0,0 +1,241 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+func TestModule_CreateCell(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	t.Run("should create cell in pickup point", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().
+			CreateCell(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, cell *domain.Cell) error {
+				fx.assert.Equal(testPickupPointID, cell.PickupPointID)
+				cell.ID = 4
+				return nil
+			})
+
+		// act
+		created, err := fx.module.CreateCell(ctx, &dto.Cell{Zone: "A", Rack: "1", Shelf: "2", MaxOrders: 10, MaxWeight: 30})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(int64(4), created.ID)
+		fx.assert.Equal("A-1-2", created.Code)
+	})
+	t.Run("should return error when location is taken", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().CreateCell(gomock.Any(), gomock.Any()).Return(storage.ErrCellExists)
+
+		// act
+		_, err := fx.module.CreateCell(ctx, &dto.Cell{Zone: "A", Rack: "1", Shelf: "2", MaxOrders: 10, MaxWeight: 30})
+
+		// assert
+		fx.require.ErrorIs(err, ErrCellExists)
+	})
+	t.Run("should reject cell without capacity", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.CreateCell(ctx, &dto.Cell{Zone: "A", Rack: "1", Shelf: "2"})
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrCellCapacityInvalid)
+	})
+}
+
+func TestModule_ListCellOrders(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	t.Run("should list orders in cell", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindCellByID(gomock.Any(), int64(2)).
+			Return(&domain.Cell{ID: 2, Zone: "A", Rack: "1", Shelf: "2"}, nil)
+		fx.mockOrderProvider.EXPECT().
+			FindOrdersByCellID(gomock.Any(), int64(2)).
+			Return([]*domain.Order{{ID: 7, CellID: sql.NullInt64{Int64: 2, Valid: true}, Status: domain.OrderStatusAccepted}}, nil)
+
+		// act
+		orders, err := fx.module.ListCellOrders(ctx, 2)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(orders, 1)
+		fx.assert.Equal(int64(7), orders[0].OrderID)
+		fx.assert.Equal("A-1-2", orders[0].CellCode)
+	})
+	t.Run("should return error when cell not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindCellByID(gomock.Any(), int64(2)).Return(nil, storage.ErrCellNotFound)
+
+		// act
+		_, err := fx.module.ListCellOrders(ctx, 2)
+
+		// assert
+		fx.require.ErrorIs(err, ErrCellNotFound)
+	})
+}
+
+func TestModule_MoveOrderToCell(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	newStoredOrder := func() *domain.Order {
+		return &domain.Order{
+			ID:           7,
+			Weight:       4,
+			Status:       domain.OrderStatusAccepted,
+			StorageUntil: time.Now().Add(time.Hour),
+			CellID:       sql.NullInt64{Int64: 1, Valid: true},
+		}
+	}
+
+	cells := func() []*domain.Cell {
+		return []*domain.Cell{
+			{ID: 1, Zone: "A", Rack: "1", Shelf: "1", MaxOrders: 10, MaxWeight: 30, OrderCount: 1, Weight: 4},
+			{ID: 2, Zone: "A", Rack: "1", Shelf: "2", MaxOrders: 2, MaxWeight: 30, OrderCount: 1, Weight: 3},
+			{ID: 3, Zone: "A", Rack: "1", Shelf: "3", MaxOrders: 1, MaxWeight: 30, OrderCount: 1, Weight: 3},
+		}
+	}
+
+	t.Run("should move order to another cell", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), int64(7)).Return(newStoredOrder(), nil)
+		fx.expectTransaction(readCommitted)
+		fx.expectCells(cells()...)
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				fx.assert.Equal(sql.NullInt64{Int64: 2, Valid: true}, order.CellID)
+				fx.assert.Equal(domain.IntegrityStatusOK, order.CheckIntegrity(testIntegrityKey))
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().
+			CreateOrderEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, event *domain.OrderEvent) error {
+				fx.assert.Equal(domain.OperationMoveOrderToCell, event.Operation)
+				fx.assert.Equal(domain.OrderStatusAccepted, event.OldStatus)
+				fx.assert.Equal(domain.OrderStatusAccepted, event.NewStatus)
+				return nil
+			})
+
+		// act
+		moved, err := fx.module.MoveOrderToCell(ctx, 7, 2)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(int64(2), moved.CellID)
+		fx.assert.Equal("A-1-2", moved.CellCode)
+	})
+	t.Run("should keep cached order in its cell when update fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		cached := newStoredOrder()
+
+		fx.expectCachedOrder(cached)
+		fx.expectTransaction(readCommitted)
+		fx.expectCells(cells()...)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		// act
+		_, err := fx.module.MoveOrderToCell(ctx, 7, 2)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		fx.assert.Equal(sql.NullInt64{Int64: 1, Valid: true}, cached.CellID)
+	})
+	t.Run("should return error when cell is full", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), int64(7)).Return(newStoredOrder(), nil)
+		fx.expectTransaction(readCommitted)
+		fx.expectCells(cells()...)
+
+		// act
+		_, err := fx.module.MoveOrderToCell(ctx, 7, 3)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrCellFull)
+	})
+	t.Run("should return error when cell not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), int64(7)).Return(newStoredOrder(), nil)
+		fx.expectTransaction(readCommitted)
+		fx.expectCells(cells()...)
+
+		// act
+		_, err := fx.module.MoveOrderToCell(ctx, 7, 9)
+
+		// assert
+		fx.require.ErrorIs(err, ErrCellNotFound)
+	})
+	t.Run("should not move issued order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		issued := newStoredOrder()
+		issued.Status = domain.OrderStatusIssued
+		issued.CellID = sql.NullInt64{}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), int64(7)).Return(issued, nil)
+		fx.expectTransaction(readCommitted)
+		fx.expectCells(cells()...)
+
+		// act
+		_, err := fx.module.MoveOrderToCell(ctx, 7, 2)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrCellOrderNotStored)
+	})
+}
@@ -0,0 +1,49 @@
+package module
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"go.uber.org/zap"
+)
+
+// retryOnConflict выполняет операцию над заказами orderIDs и повторяет ее один раз, если заказ
+// успел изменить другой запрос. Перед повтором заказы перечитываются из БД, потому что операция
+// берет их из кэша, где осталась устаревшая версия.
+func (m *Module) retryOnConflict(ctx context.Context, orderIDs []int64, operation func() error) error {
+	err := operation()
+	if !errors.Is(err, ErrOrderConflict) {
+		return err
+	}
+
+	m.logger.Warn("order was changed concurrently, retrying with fresh data", zap.Int64s("order_ids", orderIDs))
+
+	refreshErr := m.refreshOrders(ctx, orderIDs)
+	if refreshErr != nil {
+		m.logger.Error("error while refreshing orders after conflict", zap.Error(refreshErr))
+
+		return err
+	}
+
+	return operation()
+}
+
+// refreshOrders перечитывает заказы из БД и заменяет ими заказы в кэше
+func (m *Module) refreshOrders(ctx context.Context, orderIDs []int64) error {
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		return err
+	}
+
+	orders, err := m.orderProvider.FindOrderByIDs(ctx, orderIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, order.ID), order)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// defaultExpiryBatchSize сколько заказов отбирается для возврата курьеру в одной транзакции
+const defaultExpiryBatchSize int32 = 500
+
+// FlagExpiredOrders переводит заказы ПВЗ, срок хранения которых истек к моменту at, в статус ожидания возврата курьеру.
+// Заказы обрабатываются пачками по batchSize, каждая пачка - в отдельной транзакции.
+// Возвращает идентификаторы отобранных заказов и обновляет число заказов ПВЗ, ожидающих возврата.
+func (m *Module) FlagExpiredOrders(ctx context.Context, at time.Time, batchSize int32) ([]int64, error) {
+	const op = "module.Module.FlagExpiredOrders"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("at", at)
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if batchSize <= 0 {
+		batchSize = defaultExpiryBatchSize
+	}
+
+	var (
+		flagged []int64
+		afterID int64
+	)
+
+	for {
+		var batch []*domain.Order
+
+		err := m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+			orders, err := m.orderProvider.FindOrdersDueForReturn(ctxTX, at.UTC(), afterID, batchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, order := range orders {
+				from := order.StatusAt(at)
+
+				err := order.Transition(domain.OrderStatusAwaitingReturn, at)
+				if err != nil {
+					return err
+				}
+
+				err = m.updateOrder(ctxTX, order)
+				if err != nil {
+					return err
+				}
+
+				err = m.saveOrderEvent(ctxTX, order, from, domain.OperationFlagExpiredOrder,
+					map[string]time.Time{"storage_until": order.StorageUntil})
+				if err != nil {
+					return err
+				}
+			}
+
+			batch = orders
+
+			return nil
+		})
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "flag_expired_orders_error", "after_id", afterID, "error", err.Error())
+
+			m.logger.Error("error while flagging expired orders", zap.Error(err))
+
+			return flagged, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, order := range batch {
+			m.cache.Set(ctx, domain.NewOrderKey(pvzID, order.ID), order)
+			flagged = append(flagged, order.ID)
+		}
+
+		if len(batch) < int(batchSize) {
+			break
+		}
+
+		afterID = batch[len(batch)-1].ID
+	}
+
+	awaiting, err := m.orderProvider.CountOrdersByStatus(ctx, domain.OrderStatusAwaitingReturn)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "count_awaiting_orders_error", "error", err.Error())
+
+		m.logger.Error("error while counting orders awaiting courier return", zap.Error(err))
+
+		return flagged, fmt.Errorf("%s: %w", op, err)
+	}
+
+	metrics.SetOrdersAwaitingReturn(pvzID, awaiting)
+
+	span.LogKV("event", "expired_orders_flagged", "orders_count", len(flagged), "awaiting_count", awaiting)
+
+	return flagged, nil
+}
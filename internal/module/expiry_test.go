@@ -0,0 +1,109 @@
+package module
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/testutils"
+)
+
+func TestModule_FlagExpiredOrders(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+		at  = time.Date(2024, 8, 5, 10, 0, 0, 0, time.UTC)
+	)
+
+	newExpiredOrder := func(id int64) *domain.Order {
+		return &domain.Order{ID: id, RecipientID: 1, Status: domain.OrderStatusAccepted, StorageUntil: at.Add(-time.Hour)}
+	}
+
+	t.Run("should flag expired orders batch by batch", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(readCommitted).Times(2)
+
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().
+				FindOrdersDueForReturn(gomock.Any(), at, int64(0), int32(2)).
+				Return([]*domain.Order{newExpiredOrder(3), newExpiredOrder(5)}, nil),
+			fx.mockOrderProvider.EXPECT().
+				FindOrdersDueForReturn(gomock.Any(), at, int64(5), int32(2)).
+				Return([]*domain.Order{newExpiredOrder(8)}, nil),
+			fx.mockOrderProvider.EXPECT().
+				CountOrdersByStatus(gomock.Any(), domain.OrderStatusAwaitingReturn).
+				Return(int64(4), nil),
+		)
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				fx.assert.Equal(domain.OrderStatusAwaitingReturn, order.Status)
+				return nil
+			}).
+			Times(3)
+		fx.mockOrderSaver.EXPECT().
+			CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(3, domain.OrderStatusAwaitingReturn)).
+			DoAndReturn(func(_ context.Context, event *domain.OrderEvent) error {
+				fx.assert.Equal(domain.OrderStatusExpired, event.OldStatus)
+				fx.assert.Equal(domain.OperationFlagExpiredOrder, event.Operation)
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().
+			CreateOrderEvent(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(2)
+
+		// act
+		flagged, err := fx.module.FlagExpiredOrders(ctx, at, 2)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal([]int64{3, 5, 8}, flagged)
+	})
+	t.Run("should stop on failed batch", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().
+			FindOrdersDueForReturn(gomock.Any(), at, int64(0), defaultExpiryBatchSize).
+			Return([]*domain.Order{newExpiredOrder(3)}, nil)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		// act
+		flagged, err := fx.module.FlagExpiredOrders(ctx, at, 0)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		fx.assert.Empty(flagged)
+	})
+	t.Run("should update count when nothing expired", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().
+			FindOrdersDueForReturn(gomock.Any(), at, int64(0), defaultExpiryBatchSize).
+			Return(nil, nil)
+		fx.mockOrderProvider.EXPECT().
+			CountOrdersByStatus(gomock.Any(), domain.OrderStatusAwaitingReturn).
+			Return(int64(0), nil)
+
+		// act
+		flagged, err := fx.module.FlagExpiredOrders(ctx, at, 0)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Empty(flagged)
+	})
+}
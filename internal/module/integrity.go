@@ -0,0 +1,110 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// integrityBatchSize число заказов, которые проверяются за один запрос к БД
+const integrityBatchSize int32 = 500
+
+// VerifyOrderIntegrity сверяет дайджесты заказов ПВЗ с их содержимым в БД.
+// Если orderIDs пуст, проверяются все заказы ПВЗ. Кэш не используется, чтобы увидеть изменения в БД.
+func (m *Module) VerifyOrderIntegrity(ctx context.Context, orderIDs []int64) (*dto.IntegrityReport, error) {
+	const op = "module.Module.VerifyOrderIntegrity"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_ids", orderIDs)
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	if _, err := pickupPointID(ctx); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	report := &dto.IntegrityReport{Violations: make([]*dto.IntegrityViolation, 0)}
+
+	check := func(orders []*domain.Order) {
+		for _, order := range orders {
+			report.Checked++
+
+			status := order.CheckIntegrity(m.integrityKey)
+			if status == domain.IntegrityStatusOK {
+				continue
+			}
+
+			span.LogKV("event", "integrity_violation", "order_id", order.ID, "status", status)
+
+			report.Violations = append(report.Violations, domain.ToIntegrityViolationDTO(domain.IntegrityViolation{
+				OrderID: order.ID,
+				Status:  status,
+			}))
+		}
+	}
+
+	if len(orderIDs) > 0 {
+		orders, err := m.orderProvider.FindOrderByIDs(ctx, orderIDs)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "find_orders_error", "error", err.Error())
+
+			m.logger.Error("error while finding orders", zap.Error(err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if len(orders) == 0 {
+			span.LogKV("event", "orders_not_found", "order_ids", orderIDs)
+
+			return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+		}
+
+		check(orders)
+	} else {
+		var afterID int64
+
+		for {
+			orders, err := m.orderProvider.FindOrdersAfterID(ctx, afterID, integrityBatchSize)
+			if err != nil {
+				span.SetTag("error", true)
+				span.LogKV("event", "find_orders_error", "after_id", afterID, "error", err.Error())
+
+				m.logger.Error("error while finding orders", zap.Error(err))
+
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+
+			check(orders)
+
+			if len(orders) < int(integrityBatchSize) {
+				break
+			}
+
+			afterID = orders[len(orders)-1].ID
+		}
+	}
+
+	if len(report.Violations) > 0 {
+		m.logger.Warn("order integrity violations found",
+			zap.Int("checked", report.Checked),
+			zap.Int("violations", len(report.Violations)),
+		)
+	}
+
+	span.LogKV("event", "integrity_verified", "checked", report.Checked, "violations", len(report.Violations))
+
+	return report, nil
+}
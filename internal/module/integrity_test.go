@@ -0,0 +1,106 @@
+package module
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+)
+
+func TestModule_VerifyOrderIntegrity(t *testing.T) {
+	var (
+		ctx      = pickupPointContext()
+		newOrder = func(id int64) *domain.Order {
+			order := &domain.Order{
+				ID:           id,
+				RecipientID:  1,
+				Status:       domain.OrderStatusAccepted,
+				StorageUntil: time.Now().Add(time.Hour),
+			}
+			order.Seal(testIntegrityKey)
+
+			return order
+		}
+	)
+
+	t.Run("should report tampered and unsealed orders", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		tampered := newOrder(2)
+		tampered.RecipientID = 5
+		unsealed := newOrder(3)
+		unsealed.Hash = "3f2b8c1e-7a4d-4c2e-9b1a-5d6e7f8a9b0c"
+		orders := []*domain.Order{newOrder(1), tampered, unsealed}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{1, 2, 3}).Return(orders, nil)
+
+		// act
+		report, err := fx.module.VerifyOrderIntegrity(ctx, []int64{1, 2, 3})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(3, report.Checked)
+		fx.assert.Equal([]*dto.IntegrityViolation{
+			{OrderID: 2, Status: "tampered"},
+			{OrderID: 3, Status: "unsealed"},
+		}, report.Violations)
+	})
+	t.Run("should check all orders in batches", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		batch := make([]*domain.Order, 0, integrityBatchSize)
+		for id := int64(1); id <= int64(integrityBatchSize); id++ {
+			batch = append(batch, newOrder(id))
+		}
+
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().FindOrdersAfterID(gomock.Any(), int64(0), integrityBatchSize).Return(batch, nil),
+			fx.mockOrderProvider.EXPECT().
+				FindOrdersAfterID(gomock.Any(), int64(integrityBatchSize), integrityBatchSize).
+				Return([]*domain.Order{newOrder(int64(integrityBatchSize) + 1)}, nil),
+		)
+
+		// act
+		report, err := fx.module.VerifyOrderIntegrity(ctx, nil)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(int(integrityBatchSize)+1, report.Checked)
+		fx.assert.Empty(report.Violations)
+	})
+	t.Run("should return error when orders not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{1}).Return(nil, nil)
+
+		// act
+		_, err := fx.module.VerifyOrderIntegrity(ctx, []int64{1})
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+	})
+	t.Run("should fail if unable to find orders", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrdersAfterID(gomock.Any(), int64(0), integrityBatchSize).Return(nil, assert.AnError)
+
+		// act
+		_, err := fx.module.VerifyOrderIntegrity(ctx, nil)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
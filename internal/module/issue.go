@@ -0,0 +1,251 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// IssueOrderClient выдает заказы получателю и возвращает результат по каждому запрошенному заказу.
+// Если получатель не указан, им считается получатель первого найденного заказа.
+// Каждый заказ выдается только по верному коду выдачи из request.PickupCodes.
+// В режиме Atomic заказы выдаются, только если можно выдать все; иначе выдается допустимая часть.
+func (m *Module) IssueOrderClient(ctx context.Context, request *dto.IssueRequest) ([]*dto.IssueResult, error) {
+	var results []*dto.IssueResult
+
+	err := m.retryOnConflict(ctx, request.OrderIDs, func() (err error) {
+		results, err = m.issueOrderClient(ctx, request)
+		return err
+	})
+
+	return results, err
+}
+
+// issueOrderClient выдает заказы за одну попытку
+func (m *Module) issueOrderClient(ctx context.Context, request *dto.IssueRequest) ([]*dto.IssueResult, error) {
+	const op = "module.Module.IssueOrderClient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_ids", request.OrderIDs)
+	span.SetTag("atomic", request.Atomic)
+	span.LogKV("event", "start_issue_order", "order_ids", request.OrderIDs)
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	orders, err := m.findOrdersByIDs(ctx, pvzID, request.OrderIDs)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_orders_error", "order_ids", request.OrderIDs, "error", err.Error())
+
+		m.logger.Error("error while checking if orders exist", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	recipientID := request.RecipientID
+	if recipientID == 0 {
+		for _, id := range request.OrderIDs {
+			if order, ok := orders[id]; ok {
+				recipientID = order.RecipientID
+				break
+			}
+		}
+	}
+
+	now := time.Now()
+	results := make([]domain.IssueResult, 0, len(request.OrderIDs))
+	fromStatuses := make(map[int64]domain.OrderStatus, len(orders))
+	issued := make([]*domain.Order, 0, len(orders))
+	// заказы с неверным кодом выдачи: счетчик попыток сохраняется даже без выдачи
+	rejected := make([]*domain.Order, 0)
+
+	var failure error
+
+	for _, id := range request.OrderIDs {
+		result := domain.IssueResult{OrderID: id}
+
+		order, ok := orders[id]
+		switch {
+		case !ok:
+			result.Status = domain.IssueStatusNotFound
+			result.Err = ErrOrderNotFound
+		case order.RecipientID != recipientID:
+			result.Status = domain.IssueStatusWrongRecipient
+			result.Err = ErrOrdersDifferentClients
+		default:
+			// заказ из кэша меняется только после успешной записи в БД
+			candidate := *order
+
+			result.Err = candidate.VerifyPickupCode(m.policies.PickupCode, request.PickupCodes[id])
+			if result.Err != nil {
+				result.Status = domain.PickupCodeFailureStatus(result.Err)
+				if candidate.PickupCodeAttempts != order.PickupCodeAttempts {
+					rejected = append(rejected, &candidate)
+				}
+				break
+			}
+
+			from := candidate.StatusAt(now)
+
+			result.StorageFee, result.Err = candidate.Issue(m.policies.StorageFee.For(candidate.PackageType), now)
+			if result.Err != nil {
+				result.Status = domain.IssueFailureStatus(from)
+				break
+			}
+
+			result.Status = domain.IssueStatusIssued
+			fromStatuses[id] = from
+			issued = append(issued, &candidate)
+		}
+
+		if !result.Issued() {
+			span.LogKV("event", "order_not_issuable", "order_id", id, "result", result.Status)
+
+			if failure == nil {
+				failure = result.Err
+			}
+		}
+
+		if result.StorageFee.Amount.Amount > 0 {
+			span.LogKV(
+				"event", "storage_fee_charged",
+				"order_id", id,
+				"overdue_days", result.StorageFee.OverdueDays,
+				"amount", result.StorageFee.Amount.Amount,
+			)
+		}
+
+		results = append(results, result)
+	}
+
+	if request.Atomic && failure != nil {
+		issued = issued[:0]
+	}
+
+	if len(issued) > 0 || len(rejected) > 0 {
+		err = m.saveIssuedOrders(ctx, issued, rejected, fromStatuses, request.OrderIDs)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "transaction_error", "error", err.Error())
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, order := range slices.Concat(issued, rejected) {
+			m.cache.Set(ctx, domain.NewOrderKey(pvzID, order.ID), order)
+		}
+	}
+
+	if request.Atomic && failure != nil {
+		span.SetTag("error", true)
+
+		m.logger.Error("orders can not be issued", zap.Error(failure))
+
+		return nil, fmt.Errorf("%s: %w", op, failure)
+	}
+
+	span.LogKV("event", "orders_issued", "issued_count", len(issued), "requested_count", len(request.OrderIDs))
+	m.logger.Info("orders issued", zap.Int("issued", len(issued)), zap.Int("requested", len(request.OrderIDs)))
+	metrics.AddIssuedOrders(len(issued))
+
+	response := make([]*dto.IssueResult, 0, len(results))
+	for _, result := range results {
+		response = append(response, domain.ToIssueResultDTO(result))
+	}
+
+	return response, nil
+}
+
+// findOrdersByIDs возвращает найденные заказы по идентификаторам: сначала из кэша, затем из БД
+func (m *Module) findOrdersByIDs(ctx context.Context, pvzID int64, orderIDs []int64) (map[int64]*domain.Order, error) {
+	orders := make(map[int64]*domain.Order, len(orderIDs))
+	missingOrderIDs := make([]int64, 0, len(orderIDs))
+
+	for _, id := range orderIDs {
+		if order, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, id)); found {
+			orders[id] = order
+		} else {
+			missingOrderIDs = append(missingOrderIDs, id)
+		}
+	}
+
+	if len(missingOrderIDs) == 0 {
+		return orders, nil
+	}
+
+	fetchedOrders, err := m.orderProvider.FindOrderByIDs(ctx, missingOrderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range fetchedOrders {
+		orders[order.ID] = order
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, order.ID), order)
+	}
+
+	return orders, nil
+}
+
+// saveIssuedOrders сохраняет выданные заказы с их историей и счетчики попыток заказов
+// с неверным кодом выдачи в одной транзакции
+func (m *Module) saveIssuedOrders(
+	ctx context.Context,
+	issued []*domain.Order,
+	rejected []*domain.Order,
+	fromStatuses map[int64]domain.OrderStatus,
+	orderIDs []int64,
+) error {
+	return m.transactionManager.RunTransactionalQuery(ctx, repeatableRead, readWrite, func(ctxTX context.Context) error {
+		var errs []error
+
+		for _, order := range issued {
+			err := m.updateOrder(ctxTX, order)
+			if err == nil {
+				err = m.saveOrderEvent(ctxTX, order, fromStatuses[order.ID], domain.OperationIssueOrderClient,
+					map[string][]int64{"order_ids": orderIDs})
+			}
+			if err != nil {
+				m.logger.Error("error while updating order with transaction", zap.Int64("order_id", order.ID), zap.Error(err))
+
+				errs = append(errs, err)
+			}
+		}
+
+		for _, order := range rejected {
+			err := m.updateOrder(ctxTX, order)
+			if err != nil {
+				m.logger.Error("error while saving pickup code attempts", zap.Int64("order_id", order.ID), zap.Error(err))
+
+				errs = append(errs, err)
+			}
+		}
+
+		if len(errs) > 0 {
+			metrics.AddOrdersProcessedError(len(errs))
+
+			return errors.Join(errs...)
+		}
+
+		metrics.AddOrdersProcessedSuccess(len(issued))
+		return nil
+	})
+}
@@ -0,0 +1,390 @@
+package module
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/testutils"
+)
+
+func TestModule_IssueOrderClient(t *testing.T) {
+	pickupCode := "123456"
+	pickupCodeHash, err := domain.HashPickupCode(pickupCode)
+	require.NoError(t, err)
+
+	var (
+		ctx          = pickupPointContext()
+		orderIDs     = []int64{1, 2}
+		storageUntil = time.Now().Add(time.Hour)
+		newOrders    = func() []*domain.Order {
+			return []*domain.Order{
+				{ID: 1, RecipientID: 3, Status: domain.OrderStatusAccepted, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+				{ID: 2, RecipientID: 3, Status: domain.OrderStatusAccepted, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+			}
+		}
+		pickupCodes = func(ids ...int64) map[int64]string {
+			codes := make(map[int64]string, len(ids))
+			for _, id := range ids {
+				codes[id] = pickupCode
+			}
+			return codes
+		}
+		statuses = func(results []*dto.IssueResult) []string {
+			result := make([]string, 0, len(results))
+			for _, r := range results {
+				result = append(result, r.Status)
+			}
+			return result
+		}
+	)
+
+	t.Run("should issue order successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()
+
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1),
+			fx.expectTransaction(repeatableRead),
+			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), testutils.OrderStatusEq(1, domain.OrderStatusIssued)).Return(nil).Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(1, domain.OrderStatusIssued)).
+				Return(nil).
+				Times(1),
+			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), testutils.OrderStatusEq(2, domain.OrderStatusIssued)).Return(nil).Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(2, domain.OrderStatusIssued)).
+				Return(nil).
+				Times(1),
+		)
+
+		// act
+		results, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...)})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal([]string{"issued", "issued"}, statuses(results))
+		fx.require.NotNil(results[0].StorageFee)
+		fx.assert.Zero(results[0].StorageFee.Amount)
+	})
+	t.Run("should keep order versions when issue transaction rolls back", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()
+		for _, order := range orders {
+			order.Version = 1
+		}
+
+		// хранилище поднимает версию сохраненного заказа, как UpdateOrder в postgres
+		bumpVersion := func(_ context.Context, order *domain.Order) error {
+			order.Version++
+			return nil
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil)
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).DoAndReturn(bumpVersion).Times(2)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{
+			OrderIDs:    orderIDs,
+			PickupCodes: pickupCodes(orderIDs...),
+			Atomic:      true,
+		})
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		for _, order := range orders {
+			fx.assert.Equal(int64(1), order.Version)
+			fx.assert.Equal(domain.OrderStatusAccepted, order.Status)
+		}
+	})
+	t.Run("should return result for every requested order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies = Policies{}
+		requested := []int64{1, 2, 3, 4, 5}
+		orders := []*domain.Order{
+			{ID: 1, RecipientID: 3, Status: domain.OrderStatusAccepted, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+			{ID: 2, RecipientID: 4, Status: domain.OrderStatusAccepted, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+			{ID: 4, RecipientID: 3, Status: domain.OrderStatusIssued, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+			{ID: 5, RecipientID: 3, Status: domain.OrderStatusAccepted, StorageUntil: time.Now().Add(-time.Hour), PickupCodeHash: pickupCodeHash},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), requested).Return(orders, nil)
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), testutils.OrderStatusEq(1, domain.OrderStatusIssued)).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(1, domain.OrderStatusIssued)).Return(nil)
+
+		// act
+		results, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{
+			RecipientID: 3,
+			OrderIDs:    requested,
+			PickupCodes: pickupCodes(requested...),
+		})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(
+			[]string{"issued", "wrong_recipient", "not_found", "already_issued", "expired"},
+			statuses(results),
+		)
+		fx.assert.Nil(results[1].StorageFee)
+		fx.assert.Equal(domain.OrderStatusAccepted, orders[0].Status, "cached order must not change before commit")
+	})
+	t.Run("should not panic when no orders found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(nil, nil)
+
+		// act
+		results, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...)})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal([]string{"not_found", "not_found"}, statuses(results))
+	})
+	t.Run("should charge storage fee for overdue order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		overdue := &domain.Order{
+			ID:             1,
+			RecipientID:    3,
+			Status:         domain.OrderStatusAccepted,
+			StorageUntil:   time.Now().Add(-(3*24 - 1) * time.Hour),
+			Currency:       "RUB",
+			PickupCodeHash: pickupCodeHash,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{1}).Return([]*domain.Order{overdue}, nil)
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				fx.assert.Equal(int64(10000), order.StorageFee)
+				fx.assert.Equal(domain.OrderStatusIssued, order.Status)
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		results, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: []int64{1}, PickupCodes: pickupCodes(1)})
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(results, 1)
+		fx.require.NotNil(results[0].StorageFee)
+		fx.assert.Equal(3, results[0].StorageFee.OverdueDays)
+		fx.assert.Equal(2, results[0].StorageFee.ChargeableDays)
+		fx.assert.Equal(int64(10000), results[0].StorageFee.Amount)
+	})
+	t.Run("should not issue overdue order without storage fee policy", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies = Policies{}
+		overdue := &domain.Order{
+			ID:             1,
+			RecipientID:    3,
+			Status:         domain.OrderStatusAccepted,
+			StorageUntil:   time.Now().Add(-time.Hour),
+			PickupCodeHash: pickupCodeHash,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{1}).Return([]*domain.Order{overdue}, nil)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: []int64{1}, PickupCodes: pickupCodes(1), Atomic: true})
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrOrderStatusTransition)
+	})
+	t.Run("should count failed pickup code attempt", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil)
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), testutils.OrderStatusEq(1, domain.OrderStatusIssued)).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(1, domain.OrderStatusIssued)).Return(nil)
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), testutils.OrderStatusEq(2, domain.OrderStatusAccepted)).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				fx.assert.Equal(1, order.PickupCodeAttempts)
+				return nil
+			})
+
+		// act
+		results, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{
+			OrderIDs:    orderIDs,
+			PickupCodes: map[int64]string{1: pickupCode, 2: "000000"},
+		})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal([]string{"issued", "invalid_pickup_code"}, statuses(results))
+	})
+	t.Run("should not issue order with locked pickup code", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.module.policies.PickupCode = domain.PickupCodePolicy{MaxAttempts: 3}
+		locked := &domain.Order{
+			ID:                 1,
+			RecipientID:        3,
+			Status:             domain.OrderStatusAccepted,
+			StorageUntil:       storageUntil,
+			PickupCodeHash:     pickupCodeHash,
+			PickupCodeAttempts: 3,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{1}).Return([]*domain.Order{locked}, nil)
+
+		// act
+		results, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: []int64{1}, PickupCodes: pickupCodes(1)})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal([]string{"pickup_code_locked"}, statuses(results))
+	})
+	t.Run("should save failed attempt when atomic issue fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil)
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), testutils.OrderStatusEq(2, domain.OrderStatusAccepted)).Return(nil)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{
+			OrderIDs:    orderIDs,
+			PickupCodes: map[int64]string{1: pickupCode, 2: "000000"},
+			Atomic:      true,
+		})
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPickupCodeInvalid)
+		fx.assert.Equal(domain.OrderStatusAccepted, orders[0].Status)
+	})
+	t.Run("should return error when orders not exists", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindOrderByIDs(gomock.Any(), orderIDs).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...)})
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+	t.Run("should fail atomic issue when orders have different clients", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := []*domain.Order{
+			{ID: 1, RecipientID: 3, Status: domain.OrderStatusAccepted, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+			{ID: 2, RecipientID: 4, Status: domain.OrderStatusAccepted, StorageUntil: storageUntil, PickupCodeHash: pickupCodeHash},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...), Atomic: true})
+
+		// assert
+		fx.require.Error(err)
+		fx.assert.ErrorIs(err, ErrOrdersDifferentClients)
+	})
+	t.Run("should fail atomic issue when order not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()[:1]
+
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...), Atomic: true})
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+	})
+	t.Run("should fail if unable to update order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()
+
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1),
+			fx.expectTransaction(repeatableRead),
+			fx.mockOrderSaver.EXPECT().
+				UpdateOrder(gomock.Any(), gomock.Any()).
+				Times(2).
+				Return(assert.AnError),
+		)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...)})
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+	t.Run("should fail if transactional query fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		orders := newOrders()
+
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1),
+			fx.mockTransactionManager.EXPECT().
+				RunTransactionalQuery(gomock.Any(), repeatableRead, readWrite, gomock.Any()).
+				Return(assert.AnError).
+				Times(1),
+		)
+
+		// act
+		_, err := fx.module.IssueOrderClient(ctx, &dto.IssueRequest{OrderIDs: orderIDs, PickupCodes: pickupCodes(orderIDs...)})
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
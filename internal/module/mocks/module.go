@@ -37,6 +37,48 @@ func (m *MockOrderSaver) EXPECT() *MockOrderSaverMockRecorder {
 	return m.recorder
 }
 
+// CreateCell mocks base method.
+func (m *MockOrderSaver) CreateCell(ctx context.Context, cell *domain.Cell) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCell", ctx, cell)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCell indicates an expected call of CreateCell.
+func (mr *MockOrderSaverMockRecorder) CreateCell(ctx, cell interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCell", reflect.TypeOf((*MockOrderSaver)(nil).CreateCell), ctx, cell)
+}
+
+// CreateCourier mocks base method.
+func (m *MockOrderSaver) CreateCourier(ctx context.Context, courier *domain.Courier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCourier", ctx, courier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCourier indicates an expected call of CreateCourier.
+func (mr *MockOrderSaverMockRecorder) CreateCourier(ctx, courier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCourier", reflect.TypeOf((*MockOrderSaver)(nil).CreateCourier), ctx, courier)
+}
+
+// CreateNotification mocks base method.
+func (m *MockOrderSaver) CreateNotification(ctx context.Context, notification *domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockOrderSaverMockRecorder) CreateNotification(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockOrderSaver)(nil).CreateNotification), ctx, notification)
+}
+
 // CreateOrder mocks base method.
 func (m *MockOrderSaver) CreateOrder(ctx context.Context, order *domain.Order) error {
 	m.ctrl.T.Helper()
@@ -51,6 +93,91 @@ func (mr *MockOrderSaverMockRecorder) CreateOrder(ctx, order interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderSaver)(nil).CreateOrder), ctx, order)
 }
 
+// CreateOrderEvent mocks base method.
+func (m *MockOrderSaver) CreateOrderEvent(ctx context.Context, event *domain.OrderEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderEvent", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrderEvent indicates an expected call of CreateOrderEvent.
+func (mr *MockOrderSaverMockRecorder) CreateOrderEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderEvent", reflect.TypeOf((*MockOrderSaver)(nil).CreateOrderEvent), ctx, event)
+}
+
+// CreateOrders mocks base method.
+func (m *MockOrderSaver) CreateOrders(ctx context.Context, orders []*domain.Order) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrders", ctx, orders)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrders indicates an expected call of CreateOrders.
+func (mr *MockOrderSaverMockRecorder) CreateOrders(ctx, orders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrders", reflect.TypeOf((*MockOrderSaver)(nil).CreateOrders), ctx, orders)
+}
+
+// CreatePackageType mocks base method.
+func (m *MockOrderSaver) CreatePackageType(ctx context.Context, packageType *domain.PackageType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePackageType", ctx, packageType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePackageType indicates an expected call of CreatePackageType.
+func (mr *MockOrderSaverMockRecorder) CreatePackageType(ctx, packageType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePackageType", reflect.TypeOf((*MockOrderSaver)(nil).CreatePackageType), ctx, packageType)
+}
+
+// CreateRecipient mocks base method.
+func (m *MockOrderSaver) CreateRecipient(ctx context.Context, recipient *domain.Recipient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecipient", ctx, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecipient indicates an expected call of CreateRecipient.
+func (mr *MockOrderSaverMockRecorder) CreateRecipient(ctx, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecipient", reflect.TypeOf((*MockOrderSaver)(nil).CreateRecipient), ctx, recipient)
+}
+
+// DeactivatePackageType mocks base method.
+func (m *MockOrderSaver) DeactivatePackageType(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivatePackageType", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivatePackageType indicates an expected call of DeactivatePackageType.
+func (mr *MockOrderSaverMockRecorder) DeactivatePackageType(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivatePackageType", reflect.TypeOf((*MockOrderSaver)(nil).DeactivatePackageType), ctx, name)
+}
+
+// EnsureRecipient mocks base method.
+func (m *MockOrderSaver) EnsureRecipient(ctx context.Context, recipient *domain.Recipient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureRecipient", ctx, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureRecipient indicates an expected call of EnsureRecipient.
+func (mr *MockOrderSaverMockRecorder) EnsureRecipient(ctx, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureRecipient", reflect.TypeOf((*MockOrderSaver)(nil).EnsureRecipient), ctx, recipient)
+}
+
 // UpdateOrder mocks base method.
 func (m *MockOrderSaver) UpdateOrder(ctx context.Context, order *domain.Order) error {
 	m.ctrl.T.Helper()
@@ -65,6 +192,20 @@ func (mr *MockOrderSaverMockRecorder) UpdateOrder(ctx, order interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockOrderSaver)(nil).UpdateOrder), ctx, order)
 }
 
+// UpdateRecipient mocks base method.
+func (m *MockOrderSaver) UpdateRecipient(ctx context.Context, recipient *domain.Recipient) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecipient", ctx, recipient)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecipient indicates an expected call of UpdateRecipient.
+func (mr *MockOrderSaverMockRecorder) UpdateRecipient(ctx, recipient interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecipient", reflect.TypeOf((*MockOrderSaver)(nil).UpdateRecipient), ctx, recipient)
+}
+
 // MockOrderDeleter is a mock of OrderDeleter interface.
 type MockOrderDeleter struct {
 	ctrl     *gomock.Controller
@@ -88,33 +229,48 @@ func (m *MockOrderDeleter) EXPECT() *MockOrderDeleterMockRecorder {
 	return m.recorder
 }
 
-// DeleteOrder mocks base method.
-func (m *MockOrderDeleter) DeleteOrder(ctx context.Context, orderID int64) error {
+// DeleteClientReturns mocks base method.
+func (m *MockOrderDeleter) DeleteClientReturns(ctx context.Context, ids []int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrder", ctx, orderID)
+	ret := m.ctrl.Call(m, "DeleteClientReturns", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteClientReturns indicates an expected call of DeleteClientReturns.
+func (mr *MockOrderDeleterMockRecorder) DeleteClientReturns(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClientReturns", reflect.TypeOf((*MockOrderDeleter)(nil).DeleteClientReturns), ctx, ids)
+}
+
+// DeleteRecipient mocks base method.
+func (m *MockOrderDeleter) DeleteRecipient(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecipient", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteOrder indicates an expected call of DeleteOrder.
-func (mr *MockOrderDeleterMockRecorder) DeleteOrder(ctx, orderID interface{}) *gomock.Call {
+// DeleteRecipient indicates an expected call of DeleteRecipient.
+func (mr *MockOrderDeleterMockRecorder) DeleteRecipient(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockOrderDeleter)(nil).DeleteOrder), ctx, orderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecipient", reflect.TypeOf((*MockOrderDeleter)(nil).DeleteRecipient), ctx, id)
 }
 
 // DeleteRecipientOrders mocks base method.
-func (m *MockOrderDeleter) DeleteRecipientOrders(ctx context.Context) (int64, error) {
+func (m *MockOrderDeleter) DeleteRecipientOrders(ctx context.Context, before time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRecipientOrders", ctx)
+	ret := m.ctrl.Call(m, "DeleteRecipientOrders", ctx, before)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteRecipientOrders indicates an expected call of DeleteRecipientOrders.
-func (mr *MockOrderDeleterMockRecorder) DeleteRecipientOrders(ctx interface{}) *gomock.Call {
+func (mr *MockOrderDeleterMockRecorder) DeleteRecipientOrders(ctx, before interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecipientOrders", reflect.TypeOf((*MockOrderDeleter)(nil).DeleteRecipientOrders), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecipientOrders", reflect.TypeOf((*MockOrderDeleter)(nil).DeleteRecipientOrders), ctx, before)
 }
 
 // MockOrderProvider is a mock of OrderProvider interface.
@@ -140,6 +296,81 @@ func (m *MockOrderProvider) EXPECT() *MockOrderProviderMockRecorder {
 	return m.recorder
 }
 
+// CountOrdersByStatus mocks base method.
+func (m *MockOrderProvider) CountOrdersByStatus(ctx context.Context, status domain.OrderStatus) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOrdersByStatus", ctx, status)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOrdersByStatus indicates an expected call of CountOrdersByStatus.
+func (mr *MockOrderProviderMockRecorder) CountOrdersByStatus(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOrdersByStatus", reflect.TypeOf((*MockOrderProvider)(nil).CountOrdersByStatus), ctx, status)
+}
+
+// FindCellByID mocks base method.
+func (m *MockOrderProvider) FindCellByID(ctx context.Context, id int64) (*domain.Cell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCellByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Cell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCellByID indicates an expected call of FindCellByID.
+func (mr *MockOrderProviderMockRecorder) FindCellByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCellByID", reflect.TypeOf((*MockOrderProvider)(nil).FindCellByID), ctx, id)
+}
+
+// FindCells mocks base method.
+func (m *MockOrderProvider) FindCells(ctx context.Context) ([]*domain.Cell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCells", ctx)
+	ret0, _ := ret[0].([]*domain.Cell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCells indicates an expected call of FindCells.
+func (mr *MockOrderProviderMockRecorder) FindCells(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCells", reflect.TypeOf((*MockOrderProvider)(nil).FindCells), ctx)
+}
+
+// FindClientReturnsBefore mocks base method.
+func (m *MockOrderProvider) FindClientReturnsBefore(ctx context.Context, before time.Time, afterID int64, limit int32) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindClientReturnsBefore", ctx, before, afterID, limit)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindClientReturnsBefore indicates an expected call of FindClientReturnsBefore.
+func (mr *MockOrderProviderMockRecorder) FindClientReturnsBefore(ctx, before, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindClientReturnsBefore", reflect.TypeOf((*MockOrderProvider)(nil).FindClientReturnsBefore), ctx, before, afterID, limit)
+}
+
+// FindExpiredOrdersByCourierID mocks base method.
+func (m *MockOrderProvider) FindExpiredOrdersByCourierID(ctx context.Context, courierID int64, at time.Time) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredOrdersByCourierID", ctx, courierID, at)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredOrdersByCourierID indicates an expected call of FindExpiredOrdersByCourierID.
+func (mr *MockOrderProviderMockRecorder) FindExpiredOrdersByCourierID(ctx, courierID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredOrdersByCourierID", reflect.TypeOf((*MockOrderProvider)(nil).FindExpiredOrdersByCourierID), ctx, courierID, at)
+}
+
 // FindOrderByID mocks base method.
 func (m *MockOrderProvider) FindOrderByID(ctx context.Context, id int64) (*domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +401,51 @@ func (mr *MockOrderProviderMockRecorder) FindOrderByIDs(ctx, ids interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderByIDs", reflect.TypeOf((*MockOrderProvider)(nil).FindOrderByIDs), ctx, ids)
 }
 
+// FindOrderEventsByOrderID mocks base method.
+func (m *MockOrderProvider) FindOrderEventsByOrderID(ctx context.Context, orderID int64) ([]*domain.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrderEventsByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*domain.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrderEventsByOrderID indicates an expected call of FindOrderEventsByOrderID.
+func (mr *MockOrderProviderMockRecorder) FindOrderEventsByOrderID(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrderEventsByOrderID", reflect.TypeOf((*MockOrderProvider)(nil).FindOrderEventsByOrderID), ctx, orderID)
+}
+
+// FindOrdersAfterID mocks base method.
+func (m *MockOrderProvider) FindOrdersAfterID(ctx context.Context, afterID int64, limit int32) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrdersAfterID", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrdersAfterID indicates an expected call of FindOrdersAfterID.
+func (mr *MockOrderProviderMockRecorder) FindOrdersAfterID(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersAfterID", reflect.TypeOf((*MockOrderProvider)(nil).FindOrdersAfterID), ctx, afterID, limit)
+}
+
+// FindOrdersByCellID mocks base method.
+func (m *MockOrderProvider) FindOrdersByCellID(ctx context.Context, cellID int64) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrdersByCellID", ctx, cellID)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrdersByCellID indicates an expected call of FindOrdersByCellID.
+func (mr *MockOrderProviderMockRecorder) FindOrdersByCellID(ctx, cellID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersByCellID", reflect.TypeOf((*MockOrderProvider)(nil).FindOrdersByCellID), ctx, cellID)
+}
+
 // FindOrdersByRecipientID mocks base method.
 func (m *MockOrderProvider) FindOrdersByRecipientID(ctx context.Context, recipientID int64) ([]*domain.Order, error) {
 	m.ctrl.T.Helper()
@@ -185,19 +461,168 @@ func (mr *MockOrderProviderMockRecorder) FindOrdersByRecipientID(ctx, recipientI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersByRecipientID", reflect.TypeOf((*MockOrderProvider)(nil).FindOrdersByRecipientID), ctx, recipientID)
 }
 
+// FindOrdersDueForReturn mocks base method.
+func (m *MockOrderProvider) FindOrdersDueForReturn(ctx context.Context, at time.Time, afterID int64, limit int32) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrdersDueForReturn", ctx, at, afterID, limit)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrdersDueForReturn indicates an expected call of FindOrdersDueForReturn.
+func (mr *MockOrderProviderMockRecorder) FindOrdersDueForReturn(ctx, at, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersDueForReturn", reflect.TypeOf((*MockOrderProvider)(nil).FindOrdersDueForReturn), ctx, at, afterID, limit)
+}
+
+// FindOrdersExpiringBetween mocks base method.
+func (m *MockOrderProvider) FindOrdersExpiringBetween(ctx context.Context, from, to time.Time) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrdersExpiringBetween", ctx, from, to)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrdersExpiringBetween indicates an expected call of FindOrdersExpiringBetween.
+func (mr *MockOrderProviderMockRecorder) FindOrdersExpiringBetween(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersExpiringBetween", reflect.TypeOf((*MockOrderProvider)(nil).FindOrdersExpiringBetween), ctx, from, to)
+}
+
+// FindOrdersReturnedToCourier mocks base method.
+func (m *MockOrderProvider) FindOrdersReturnedToCourier(ctx context.Context, from, to time.Time) ([]*domain.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOrdersReturnedToCourier", ctx, from, to)
+	ret0, _ := ret[0].([]*domain.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOrdersReturnedToCourier indicates an expected call of FindOrdersReturnedToCourier.
+func (mr *MockOrderProviderMockRecorder) FindOrdersReturnedToCourier(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOrdersReturnedToCourier", reflect.TypeOf((*MockOrderProvider)(nil).FindOrdersReturnedToCourier), ctx, from, to)
+}
+
+// FindPackageTypes mocks base method.
+func (m *MockOrderProvider) FindPackageTypes(ctx context.Context) ([]*domain.PackageType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPackageTypes", ctx)
+	ret0, _ := ret[0].([]*domain.PackageType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPackageTypes indicates an expected call of FindPackageTypes.
+func (mr *MockOrderProviderMockRecorder) FindPackageTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPackageTypes", reflect.TypeOf((*MockOrderProvider)(nil).FindPackageTypes), ctx)
+}
+
+// FindPickupPointLoad mocks base method.
+func (m *MockOrderProvider) FindPickupPointLoad(ctx context.Context) (*domain.PickupPointLoad, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPickupPointLoad", ctx)
+	ret0, _ := ret[0].(*domain.PickupPointLoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPickupPointLoad indicates an expected call of FindPickupPointLoad.
+func (mr *MockOrderProviderMockRecorder) FindPickupPointLoad(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPickupPointLoad", reflect.TypeOf((*MockOrderProvider)(nil).FindPickupPointLoad), ctx)
+}
+
+// FindRecipientByID mocks base method.
+func (m *MockOrderProvider) FindRecipientByID(ctx context.Context, id int64) (*domain.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecipientByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecipientByID indicates an expected call of FindRecipientByID.
+func (mr *MockOrderProviderMockRecorder) FindRecipientByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecipientByID", reflect.TypeOf((*MockOrderProvider)(nil).FindRecipientByID), ctx, id)
+}
+
+// FindRecipientByPhone mocks base method.
+func (m *MockOrderProvider) FindRecipientByPhone(ctx context.Context, phone string) (*domain.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecipientByPhone", ctx, phone)
+	ret0, _ := ret[0].(*domain.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecipientByPhone indicates an expected call of FindRecipientByPhone.
+func (mr *MockOrderProviderMockRecorder) FindRecipientByPhone(ctx, phone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecipientByPhone", reflect.TypeOf((*MockOrderProvider)(nil).FindRecipientByPhone), ctx, phone)
+}
+
+// FindRecipients mocks base method.
+func (m *MockOrderProvider) FindRecipients(ctx context.Context, limit, offset int32) ([]*domain.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecipients", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecipients indicates an expected call of FindRecipients.
+func (mr *MockOrderProviderMockRecorder) FindRecipients(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecipients", reflect.TypeOf((*MockOrderProvider)(nil).FindRecipients), ctx, limit, offset)
+}
+
 // FindReturnedOrdersWithPagination mocks base method.
-func (m *MockOrderProvider) FindReturnedOrdersWithPagination(ctx context.Context, limit, offset int32) ([]*domain.Order, error) {
+func (m *MockOrderProvider) FindReturnedOrdersWithPagination(ctx context.Context, limit, offset int32, condition domain.ReturnCondition) ([]*domain.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindReturnedOrdersWithPagination", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "FindReturnedOrdersWithPagination", ctx, limit, offset, condition)
 	ret0, _ := ret[0].([]*domain.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindReturnedOrdersWithPagination indicates an expected call of FindReturnedOrdersWithPagination.
-func (mr *MockOrderProviderMockRecorder) FindReturnedOrdersWithPagination(ctx, limit, offset interface{}) *gomock.Call {
+func (mr *MockOrderProviderMockRecorder) FindReturnedOrdersWithPagination(ctx, limit, offset, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReturnedOrdersWithPagination", reflect.TypeOf((*MockOrderProvider)(nil).FindReturnedOrdersWithPagination), ctx, limit, offset, condition)
+}
+
+// LockCells mocks base method.
+func (m *MockOrderProvider) LockCells(ctx context.Context) ([]*domain.Cell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCells", ctx)
+	ret0, _ := ret[0].([]*domain.Cell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCells indicates an expected call of LockCells.
+func (mr *MockOrderProviderMockRecorder) LockCells(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCells", reflect.TypeOf((*MockOrderProvider)(nil).LockCells), ctx)
+}
+
+// LockPickupPoint mocks base method.
+func (m *MockOrderProvider) LockPickupPoint(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockPickupPoint", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockPickupPoint indicates an expected call of LockPickupPoint.
+func (mr *MockOrderProviderMockRecorder) LockPickupPoint(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReturnedOrdersWithPagination", reflect.TypeOf((*MockOrderProvider)(nil).FindReturnedOrdersWithPagination), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockPickupPoint", reflect.TypeOf((*MockOrderProvider)(nil).LockPickupPoint), ctx)
 }
 
 // MockTransactionManager is a mock of TransactionManager interface.
@@ -261,7 +686,7 @@ func (m *MockCache) EXPECT() *MockCacheMockRecorder {
 }
 
 // Get mocks base method.
-func (m *MockCache) Get(ctx context.Context, key int64) (*domain.Order, bool) {
+func (m *MockCache) Get(ctx context.Context, key domain.OrderKey) (*domain.Order, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, key)
 	ret0, _ := ret[0].(*domain.Order)
@@ -276,13 +701,13 @@ func (mr *MockCacheMockRecorder) Get(ctx, key interface{}) *gomock.Call {
 }
 
 // Set mocks base method.
-func (m *MockCache) Set(ctx context.Context, key int64, value *domain.Order, now time.Time) {
+func (m *MockCache) Set(ctx context.Context, key domain.OrderKey, value *domain.Order) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Set", ctx, key, value, now)
+	m.ctrl.Call(m, "Set", ctx, key, value)
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockCacheMockRecorder) Set(ctx, key, value, now interface{}) *gomock.Call {
+func (mr *MockCacheMockRecorder) Set(ctx, key, value interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCache)(nil).Set), ctx, key, value, now)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCache)(nil).Set), ctx, key, value)
 }
@@ -3,7 +3,6 @@ package module
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"time"
@@ -20,19 +19,48 @@ import (
 
 type OrderSaver interface {
 	CreateOrder(ctx context.Context, order *domain.Order) error
+	CreateOrders(ctx context.Context, orders []*domain.Order) ([]int64, error)
 	UpdateOrder(ctx context.Context, order *domain.Order) error
+	CreateOrderEvent(ctx context.Context, event *domain.OrderEvent) error
+	CreateCourier(ctx context.Context, courier *domain.Courier) error
+	CreatePackageType(ctx context.Context, packageType *domain.PackageType) error
+	DeactivatePackageType(ctx context.Context, name string) error
+	CreateRecipient(ctx context.Context, recipient *domain.Recipient) error
+	EnsureRecipient(ctx context.Context, recipient *domain.Recipient) error
+	UpdateRecipient(ctx context.Context, recipient *domain.Recipient) error
+	CreateNotification(ctx context.Context, notification *domain.Notification) error
+	CreateCell(ctx context.Context, cell *domain.Cell) error
 }
 
 type OrderDeleter interface {
-	DeleteOrder(ctx context.Context, orderID int64) error
-	DeleteRecipientOrders(ctx context.Context) (int64, error)
+	DeleteRecipientOrders(ctx context.Context, before time.Time) (int64, error)
+	DeleteClientReturns(ctx context.Context, ids []int64) (int64, error)
+	DeleteRecipient(ctx context.Context, id int64) error
 }
 
 type OrderProvider interface {
-	FindReturnedOrdersWithPagination(ctx context.Context, limit, offset int32) ([]*domain.Order, error)
+	FindReturnedOrdersWithPagination(ctx context.Context, limit, offset int32, condition domain.ReturnCondition) ([]*domain.Order, error)
 	FindOrdersByRecipientID(ctx context.Context, recipientID int64) ([]*domain.Order, error)
 	FindOrderByID(ctx context.Context, id int64) (*domain.Order, error)
 	FindOrderByIDs(ctx context.Context, ids []int64) ([]*domain.Order, error)
+	FindOrderEventsByOrderID(ctx context.Context, orderID int64) ([]*domain.OrderEvent, error)
+	FindOrdersReturnedToCourier(ctx context.Context, from, to time.Time) ([]*domain.Order, error)
+	FindClientReturnsBefore(ctx context.Context, before time.Time, afterID int64, limit int32) ([]*domain.Order, error)
+	FindExpiredOrdersByCourierID(ctx context.Context, courierID int64, at time.Time) ([]*domain.Order, error)
+	FindPackageTypes(ctx context.Context) ([]*domain.PackageType, error)
+	FindOrdersAfterID(ctx context.Context, afterID int64, limit int32) ([]*domain.Order, error)
+	FindRecipientByID(ctx context.Context, id int64) (*domain.Recipient, error)
+	FindRecipientByPhone(ctx context.Context, phone string) (*domain.Recipient, error)
+	FindRecipients(ctx context.Context, limit, offset int32) ([]*domain.Recipient, error)
+	FindOrdersExpiringBetween(ctx context.Context, from, to time.Time) ([]*domain.Order, error)
+	FindOrdersDueForReturn(ctx context.Context, at time.Time, afterID int64, limit int32) ([]*domain.Order, error)
+	CountOrdersByStatus(ctx context.Context, status domain.OrderStatus) (int64, error)
+	FindCells(ctx context.Context) ([]*domain.Cell, error)
+	FindCellByID(ctx context.Context, id int64) (*domain.Cell, error)
+	LockCells(ctx context.Context) ([]*domain.Cell, error)
+	FindOrdersByCellID(ctx context.Context, cellID int64) ([]*domain.Order, error)
+	LockPickupPoint(ctx context.Context) error
+	FindPickupPointLoad(ctx context.Context) (*domain.PickupPointLoad, error)
 }
 
 type TransactionManager interface {
@@ -41,8 +69,8 @@ type TransactionManager interface {
 }
 
 type Cache interface {
-	Set(ctx context.Context, key int64, value *domain.Order)
-	Get(ctx context.Context, key int64) (*domain.Order, bool)
+	Set(ctx context.Context, key domain.OrderKey, value *domain.Order)
+	Get(ctx context.Context, key domain.OrderKey) (*domain.Order, bool)
 }
 
 // Transaction isolation levels
@@ -64,26 +92,54 @@ var (
 	ErrOrderExists             = errors.New("order already exists")
 	ErrOrderStorageTimeExpired = errors.New("storage time is in the past")
 	ErrOrderNotExpiredOrIssued = errors.New("order has not expired or has been issued to the client")
-	ErrOrderNotIssuedOrExpired = errors.New("order was not issued or more than 2 days passed")
+	ErrOrderNotIssuedOrExpired = errors.New("order was not issued or its return window has passed")
 	ErrOrdersDifferentClients  = errors.New("orders belong to different clients")
+	ErrInvalidDateRange        = errors.New("start of the date range must be before its end")
+	ErrOrderCourierMismatch    = errors.New("order was accepted from another courier")
+	ErrPackageTypeExists       = errors.New("package type already exists")
+	ErrPackageTypeNotFound     = errors.New("package type not found")
+	ErrPackageLayersConflict   = errors.New("either package type or package layers must be set, not both")
+	ErrRecipientNotRegistered  = errors.New("recipient is not registered")
+	ErrRecipientExists         = errors.New("recipient already exists")
+	ErrRecipientPhoneExists    = errors.New("recipient with this phone already exists")
+	ErrRecipientHasOrders      = errors.New("recipient has orders and can not be deleted")
+	ErrCellNotFound            = errors.New("cell not found")
+	ErrCellExists              = errors.New("cell with this location already exists")
+	ErrOrderDuplicated         = errors.New("order is duplicated in batch")
+	ErrOrderConflict           = errors.New("order was changed concurrently")
 )
 
+// Policies настраиваемые правила обработки заказов в ПВЗ
+type Policies struct {
+	StorageFee       domain.StorageFeePolicies
+	StorageExtension domain.StorageExtensionPolicy
+	PickupCode       domain.PickupCodePolicy
+	ReturnReasons    domain.ReturnReasonPolicy
+	ReturnWindow     domain.ReturnWindowPolicies
+	Capacity         domain.CapacityPolicies
+}
+
 type Module struct {
 	orderProvider      OrderProvider
 	orderDeleter       OrderDeleter
 	orderSaver         OrderSaver
 	transactionManager TransactionManager
 	cache              Cache
+	policies           Policies
+	integrityKey       []byte
 	logger             *zap.Logger
 }
 
-// New - конструктор для создания Module
+// New - конструктор для создания Module.
+// integrityKey - секрет сервера, которым подписывается дайджест каждого сохраняемого заказа.
 func New(
 	orderProvider OrderProvider,
 	orderDeleter OrderDeleter,
 	orderSaver OrderSaver,
 	transactionManager TransactionManager,
 	cache Cache,
+	policies Policies,
+	integrityKey []byte,
 	logger *zap.Logger,
 ) *Module {
 	return &Module{
@@ -92,68 +148,83 @@ func New(
 		orderDeleter:       orderDeleter,
 		transactionManager: transactionManager,
 		cache:              cache,
+		policies:           policies,
+		integrityKey:       integrityKey,
 		logger:             logger,
 	}
 }
 
-// AcceptOrderCourier позволяет принять заказ от курьера
-func (m *Module) AcceptOrderCourier(ctx context.Context, order *dto.Order) error {
+// AcceptOrderCourier позволяет принять заказ от курьера.
+// Заказ не принимается, если ПВЗ заполнен по ограничениям Policies.Capacity.
+// Если в ПВЗ заведены ячейки, заказ сразу получает свободную ячейку по типу упаковки и весу.
+// Возвращает принятый заказ с рассчитанной итоговой стоимостью и ячейкой хранения.
+// Код выдачи вызывающему не возвращается: он уходит получателю в уведомлении о прибытии заказа.
+func (m *Module) AcceptOrderCourier(ctx context.Context, order *dto.Order) (*dto.Order, error) {
 	const op = "module.Module.AcceptOrderCourier"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	span.LogKV(
 		"event", "start_accept_order",
 		"order_id", order.OrderID,
 		"recipient_id", order.RecipientID,
+		"courier_id", order.CourierID,
 	)
 
-	if order.StorageUntil.Before(time.Now()) {
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
 		span.SetTag("error", true)
-		span.LogKV(
-			"event", "storage_time_expired",
-			"order_id", order.OrderID,
-			"storage_until", order.StorageUntil,
-		)
-
-		m.logger.Error("storage time is in the past")
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
 
-		return fmt.Errorf("%s: %w", op, ErrOrderStorageTimeExpired)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	packageType, err := domain.NewPackageType(order.PackageType)
+	acceptance, err := m.prepareAcceptance(ctx, pvzID, order)
 	if err != nil {
-		span.SetTag("error", true)
-		span.LogKV(
-			"event", "invalid_package_type",
-			"order_id", order.OrderID,
-			"package_type", order.PackageType,
-			"error", err.Error(),
-		)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-		m.logger.Error("package type not valid", zap.Error(err))
+	acceptedOrder := acceptance.order
 
-		return fmt.Errorf("%s: %w", op, err)
-	}
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		err := m.checkRecipient(ctxTX, acceptedOrder.RecipientID, order.CreateRecipient)
+		if err != nil {
+			return err
+		}
 
-	acceptedOrder, err := domain.NewOrder(order, packageType)
-	if err != nil {
-		span.SetTag("error", true)
-		span.LogKV(
-			"event", "order_creation_error",
-			"order_id", acceptedOrder.ID,
-			"error", err.Error(),
-		)
-		m.logger.Error("error creating order", zap.Error(err))
+		if acceptedOrder.CourierID.Valid {
+			err := m.orderSaver.CreateCourier(ctxTX, domain.NewCourier(acceptedOrder.CourierID.Int64))
+			if err != nil {
+				return err
+			}
+		}
 
-		return fmt.Errorf("%s: %w", op, err)
-	}
+		err = m.checkCapacity(ctxTX, acceptedOrder)
+		if err != nil {
+			return err
+		}
+
+		acceptance.cell, err = m.assignCell(ctxTX, acceptedOrder)
+		if err != nil {
+			return err
+		}
+
+		err = m.createOrder(ctxTX, acceptedOrder)
+		if err != nil {
+			return err
+		}
 
-	err = m.orderSaver.CreateOrder(ctx, acceptedOrder)
+		err = m.saveOrderEvent(ctxTX, acceptedOrder, "", domain.OperationAcceptOrderCourier, acceptance.payload)
+		if err != nil {
+			return err
+		}
+
+		return m.orderSaver.CreateNotification(ctxTX, domain.NewOrderArrivedNotification(acceptedOrder, acceptance.pickupCode))
+	})
 	if err != nil {
 		if errors.Is(err, storage.ErrOrderExists) {
 			span.SetTag("error", true)
@@ -162,7 +233,28 @@ func (m *Module) AcceptOrderCourier(ctx context.Context, order *dto.Order) error
 				"order_id", acceptedOrder.ID)
 			m.logger.Error("error creating order")
 
-			return fmt.Errorf("%s: %w", op, ErrOrderExists)
+			return nil, fmt.Errorf("%s: %w", op, ErrOrderExists)
+		}
+
+		if errors.Is(err, ErrRecipientNotRegistered) {
+			span.SetTag("error", true)
+			span.LogKV("event", "recipient_not_registered", "recipient_id", acceptedOrder.RecipientID)
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if errors.Is(err, domain.ErrPickupPointFull) {
+			span.SetTag("error", true)
+			span.LogKV("event", "pickup_point_full", "order_id", acceptedOrder.ID, "error", err.Error())
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if errors.Is(err, domain.ErrCellNotFree) {
+			span.SetTag("error", true)
+			span.LogKV("event", "no_free_cell", "order_id", acceptedOrder.ID, "weight", acceptedOrder.Weight)
+
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 
 		span.SetTag("error", true)
@@ -173,14 +265,14 @@ func (m *Module) AcceptOrderCourier(ctx context.Context, order *dto.Order) error
 		)
 		m.logger.Error("error while saving order with transaction", zap.Error(err))
 
-		return fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	span.LogKV(
 		"event", "order_saved_to_cache",
 		"order_id", acceptedOrder.ID,
 	)
-	m.cache.Set(ctx, acceptedOrder.ID, acceptedOrder)
+	m.cache.Set(ctx, domain.NewOrderKey(pvzID, acceptedOrder.ID), acceptedOrder)
 
 	span.LogKV(
 		"event", "order_accepted",
@@ -189,27 +281,42 @@ func (m *Module) AcceptOrderCourier(ctx context.Context, order *dto.Order) error
 	m.logger.Info("accept order from courier was successfully")
 	metrics.AddAcceptedOrders()
 
-	return nil
+	return m.acceptedDTO(acceptance), nil
 }
 
 // ReturnOrderCourier возвращает заказ курьеру
-func (m *Module) ReturnOrderCourier(ctx context.Context, orderID int64) error {
+func (m *Module) ReturnOrderCourier(ctx context.Context, orderID, courierID int64) error {
+	return m.retryOnConflict(ctx, []int64{orderID}, func() error {
+		return m.returnOrderCourier(ctx, orderID, courierID)
+	})
+}
+
+// returnOrderCourier возвращает заказ курьеру за одну попытку
+func (m *Module) returnOrderCourier(ctx context.Context, orderID, courierID int64) error {
 	const op = "module.Module.ReturnOrderCourier"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
 	span.SetTag("order_id", orderID)
+	span.SetTag("courier_id", courierID)
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	span.LogKV("event", "start_return_order", "order_id", orderID)
 
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	// Проверяем наличие заказа в кэше
-	order, found := m.cache.Get(ctx, orderID)
+	order, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, orderID))
 	if !found {
-		var err error
 		order, err = m.orderProvider.FindOrderByID(ctx, orderID)
 		if err != nil {
 			if errors.Is(err, storage.ErrOrderNotFound) {
@@ -235,160 +342,87 @@ func (m *Module) ReturnOrderCourier(ctx context.Context, orderID int64) error {
 
 		// Добавляем заказ в кэш
 		span.LogKV("event", "order_cached", "order_id", orderID)
-		m.cache.Set(ctx, orderID, order)
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), order)
 	}
 
-	returnedOrder := domain.ToDomain(order)
-
-	if returnedOrder.StorageUntil.After(time.Now()) || returnedOrder.IsIssued() {
+	if !order.BelongsToCourier(courierID) {
 		span.SetTag("error", true)
-		span.LogKV("event", "order_not_returnable", "order_id", orderID)
+		span.LogKV(
+			"event", "different_courier_error",
+			"order_id", orderID,
+			"expected_courier_id", order.CourierID.Int64,
+			"actual_courier_id", courierID,
+		)
 
-		m.logger.Error("order already issued or not expired for returned")
+		m.logger.Error("order was accepted from another courier")
 
-		return fmt.Errorf("%s: %w", op, ErrOrderNotExpiredOrIssued)
+		return fmt.Errorf("%s: %w", op, ErrOrderCourierMismatch)
 	}
 
-	span.LogKV("event", "order_returned", "order_id", orderID)
-	m.logger.Info("return order to courier was successfully")
-	metrics.AddReturnedOrders()
+	// заказ из кэша меняется только после успешной записи в БД
+	candidate := *order
+
+	from := candidate.StatusAt(time.Now())
 
-	err := m.orderDeleter.DeleteOrder(ctx, orderID)
+	err = candidate.Transition(domain.OrderStatusReturnedToCourier, time.Now())
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV(
-			"event", "order_deletion_error",
+			"event", "order_not_returnable",
 			"order_id", orderID,
-			"error", err.Error(),
+			"status", order.Status,
 		)
 
-		m.logger.Error("error deleting order", zap.Error(err))
+		m.logger.Error("order already issued or not expired for returned", zap.Error(err))
 
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	return nil
-}
-
-// IssueOrderClient выдает заказ клиенту
-func (m *Module) IssueOrderClient(ctx context.Context, orderIDs []int64) error {
-	const op = "module.Module.IssueOrderClient"
-
-	span, ctx := opentracing.StartSpanFromContext(ctx, op)
-	defer span.Finish()
-
-	span.SetTag("order_ids", orderIDs)
-	span.LogKV("event", "start_issue_order", "order_ids", orderIDs)
-
-	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
-
-	// Кэширование: сначала пытаемся получить заказы из кэша
-	orders := make([]*domain.Order, 0, len(orderIDs))
-	missingOrderIDs := make([]int64, 0, len(orderIDs))
-
-	for _, id := range orderIDs {
-		if order, found := m.cache.Get(ctx, id); found {
-			orders = append(orders, order)
-		} else {
-			missingOrderIDs = append(missingOrderIDs, id)
-		}
+		return fmt.Errorf("%s: %w: %w", op, ErrOrderNotExpiredOrIssued, err)
 	}
 
-	if len(missingOrderIDs) > 0 {
-		// Если есть отсутствующие заказы, получаем их из источника и обновляем кэш
-		fetchedOrders, err := m.orderProvider.FindOrderByIDs(ctx, missingOrderIDs)
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		err := m.updateOrder(ctxTX, &candidate)
 		if err != nil {
-			span.SetTag("error", true)
-			span.LogKV(
-				"event", "find_orders_error",
-				"missing_order_ids", missingOrderIDs,
-				"error", err.Error(),
-			)
-
-			m.logger.Error("error while checking if orders exist", zap.Error(err))
-
-			return fmt.Errorf("%s: %w", op, err)
+			return err
 		}
 
-		for _, order := range fetchedOrders {
-			orders = append(orders, order)
-
-			span.LogKV("event", "order_cached", "order_id", order.ID)
-			m.cache.Set(ctx, order.ID, order)
-		}
-	}
-
-	recipientID := orders[0].RecipientID
-	for _, order := range orders {
-		if order.RecipientID != recipientID {
-			span.SetTag("error", true)
-			span.LogKV(
-				"event", "different_recipients_error",
-				"order_id", order.ID,
-				"expected_recipient_id", recipientID,
-				"actual_recipient_id", order.RecipientID,
-			)
-
-			m.logger.Error("order has the different recipients")
-
-			return fmt.Errorf("%s: %w", op, ErrOrdersDifferentClients)
-		}
-	}
-
-	m.logger.Info("start transactional")
-
-	err := m.transactionManager.RunTransactionalQuery(ctx, repeatableRead, readWrite, func(ctxTX context.Context) error {
-		var errs []error
-
-		for _, order := range orders {
-			order.ReturnedAt = sql.NullTime{}
-			order.IssuedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
-
-			err := m.orderSaver.UpdateOrder(ctxTX, order)
-			if err != nil {
-				span.SetTag("error", true)
-				span.LogKV(
-					"event", "update_order_error",
-					"order_id", order.ID,
-					"error", err.Error(),
-				)
-
-				m.logger.Error("error while updating order with transaction", zap.Error(err))
-
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
-			span.SetTag("error", true)
-			span.LogKV("event", "processing_errors", "errors_count", len(errs))
-
-			metrics.AddOrdersProcessedError(len(errs))
-
-			return fmt.Errorf("%s: %w", op, errors.Join(errs...))
+		err = m.saveOrderEvent(ctxTX, &candidate, from, domain.OperationReturnOrderCourier,
+			map[string]int64{"order_id": orderID, "courier_id": courierID})
+		if err != nil {
+			return err
 		}
 
-		metrics.AddOrdersProcessedSuccess(len(orders) - len(errs))
-		return nil
+		return m.saveNotification(ctxTX, domain.NotificationOrderReturnedToCourier, &candidate)
 	})
-
 	if err != nil {
 		span.SetTag("error", true)
-		span.LogKV("event", "transaction_error", "error", err.Error())
+		span.LogKV(
+			"event", "update_order_error",
+			"order_id", orderID,
+			"error", err.Error(),
+		)
 
-		return err
+		m.logger.Error("error while updating order with transaction", zap.Error(err))
+
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	span.LogKV("event", "orders_issued_successfully", "order_ids", orderIDs)
-	m.logger.Info("orders issued successfully")
-	metrics.AddIssuedOrders()
+	m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), &candidate)
+
+	span.LogKV("event", "order_returned", "order_id", orderID)
+	m.logger.Info("return order to courier was successfully")
+	metrics.AddReturnedOrders()
 
 	return nil
 }
 
 // AcceptReturnClient принимает возврат заказа от клиента
 func (m *Module) AcceptReturnClient(ctx context.Context, order *dto.Order) error {
+	return m.retryOnConflict(ctx, []int64{order.OrderID}, func() error {
+		return m.acceptReturnClient(ctx, order)
+	})
+}
+
+// acceptReturnClient принимает возврат от клиента за одну попытку
+func (m *Module) acceptReturnClient(ctx context.Context, order *dto.Order) error {
 	const op = "module.Module.AcceptReturnClient"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
@@ -398,12 +432,19 @@ func (m *Module) AcceptReturnClient(ctx context.Context, order *dto.Order) error
 	span.SetTag("recipient_id", order.RecipientID)
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-	existedOrder, found := m.cache.Get(ctx, order.OrderID)
+	existedOrder, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, order.OrderID))
 	if !found {
 		// Если в кэше нет, ищем в источнике и обновляем кэш
-		var err error
 		existedOrder, err = m.orderProvider.FindOrderByID(ctx, order.OrderID)
 		if err != nil {
 			if errors.Is(err, storage.ErrOrderNotFound) {
@@ -424,7 +465,7 @@ func (m *Module) AcceptReturnClient(ctx context.Context, order *dto.Order) error
 		}
 
 		span.LogKV("event", "order_cached", "order_id", order.OrderID)
-		m.cache.Set(ctx, order.OrderID, existedOrder)
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, order.OrderID), existedOrder)
 	}
 
 	if existedOrder.RecipientID != order.RecipientID {
@@ -441,24 +482,66 @@ func (m *Module) AcceptReturnClient(ctx context.Context, order *dto.Order) error
 		return fmt.Errorf("%s: %w", op, ErrRecipientNotFound)
 	}
 
-	const validIssuedHours = 48
-	if !existedOrder.IssuedAt.Valid || time.Since(existedOrder.IssuedAt.Time).Hours() > validIssuedHours {
+	returnWindow := m.policies.ReturnWindow.For(existedOrder)
+	if existedOrder.ReturnWindowClosed(returnWindow, time.Now()) {
 		span.SetTag("error", true)
 		span.LogKV(
 			"event", "issued_or_expired_error",
 			"order_id", order.OrderID,
 			"issued_at", existedOrder.IssuedAt.Time,
+			"return_window", returnWindow.String(),
 		)
 
-		m.logger.Error("order was not issued or more than 2 days passed")
+		m.logger.Error("return window has passed", zap.Duration("return_window", returnWindow))
 
 		return fmt.Errorf("%s: %w", op, ErrOrderNotIssuedOrExpired)
 	}
 
-	existedOrder.IssuedAt = sql.NullTime{}
-	existedOrder.ReturnedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	// заказ из кэша меняется только после успешной записи в БД
+	candidate := *existedOrder
+	from := candidate.Status
+
+	err = candidate.AcceptReturn(m.policies.ReturnReasons, domain.ReturnDetails{
+		Reason:         order.ReturnReason,
+		Condition:      domain.ReturnCondition(order.ReturnCondition),
+		Note:           order.ReturnNote,
+		Refund:         order.RefundAmount,
+		RefundCurrency: order.Currency,
+	}, time.Now())
+	if err != nil {
+		span.SetTag("error", true)
 
-	err := m.orderSaver.UpdateOrder(ctx, existedOrder)
+		if errors.Is(err, domain.ErrOrderStatusTransition) {
+			span.LogKV(
+				"event", "issued_or_expired_error",
+				"order_id", order.OrderID,
+				"status", existedOrder.Status,
+			)
+
+			m.logger.Error("order was not issued", zap.Error(err))
+
+			return fmt.Errorf("%s: %w: %w", op, ErrOrderNotIssuedOrExpired, err)
+		}
+
+		span.LogKV(
+			"event", "invalid_return_details",
+			"order_id", order.OrderID,
+			"reason", order.ReturnReason,
+			"condition", order.ReturnCondition,
+			"error", err.Error(),
+		)
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		err := m.updateOrder(ctxTX, &candidate)
+		if err != nil {
+			return err
+		}
+
+		return m.saveOrderEvent(ctxTX, &candidate, from, domain.OperationAcceptReturnClient, order)
+	})
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV(
@@ -470,8 +553,8 @@ func (m *Module) AcceptReturnClient(ctx context.Context, order *dto.Order) error
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	m.cache.Set(ctx, existedOrder.ID, existedOrder)
-	span.LogKV("event", "order_updated_and_cached", "order_id", existedOrder.ID)
+	m.cache.Set(ctx, domain.NewOrderKey(pvzID, candidate.ID), &candidate)
+	span.LogKV("event", "order_updated_and_cached", "order_id", candidate.ID)
 
 	m.logger.Info("return order from client was successfully")
 	metrics.AddAcceptedReturns()
@@ -487,7 +570,7 @@ func (m *Module) ListOrders(ctx context.Context, recipientID int64, limit int32)
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	span.SetTag("recipient_id", recipientID)
 	span.SetTag("limit", limit)
@@ -518,9 +601,8 @@ func (m *Module) ListOrders(ctx context.Context, recipientID int64, limit int32)
 	var recipientOrders []*dto.Order
 
 	for i := len(orders) - 1; i >= 0 && size > 0; i-- {
-		order := domain.ToDomain(orders[i])
-		if order.RecipientID == recipientID && !order.IsIssued() {
-			recipientOrders = append(recipientOrders, order)
+		if orders[i].RecipientID == recipientID && orders[i].Status.IsStored() {
+			recipientOrders = append(recipientOrders, m.toDTO(orders[i]))
 			size--
 		}
 	}
@@ -539,15 +621,16 @@ func (m *Module) ListOrders(ctx context.Context, recipientID int64, limit int32)
 	return recipientOrders, nil
 }
 
-// ListReturnOrders возвращает список всех заказов со склада, которые вернули
-func (m *Module) ListReturnOrders(ctx context.Context, page, limit int32) ([]*dto.Order, error) {
+// ListReturnOrders возвращает список всех заказов со склада, которые вернули.
+// Если condition не пустое, возвращаются только возвраты в этом состоянии.
+func (m *Module) ListReturnOrders(ctx context.Context, page, limit int32, condition string) ([]*dto.Order, error) {
 	const op = "module.Module.ListReturnOrders"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
 
 	offset := (page - 1) * limit
 
@@ -555,7 +638,22 @@ func (m *Module) ListReturnOrders(ctx context.Context, page, limit int32) ([]*dt
 	span.SetTag("limit", limit)
 	span.SetTag("offset", offset)
 
-	orders, err := m.orderProvider.FindReturnedOrdersWithPagination(ctx, limit, offset)
+	span.SetTag("condition", condition)
+
+	var returnCondition domain.ReturnCondition
+	if condition != "" {
+		parsed, err := domain.ParseReturnCondition(condition)
+		if err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "invalid_return_condition", "condition", condition)
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		returnCondition = parsed
+	}
+
+	orders, err := m.orderProvider.FindReturnedOrdersWithPagination(ctx, limit, offset, returnCondition)
 
 	if err != nil {
 		span.SetTag("error", true)
@@ -569,7 +667,7 @@ func (m *Module) ListReturnOrders(ctx context.Context, page, limit int32) ([]*dt
 
 	var returnedOrders []*dto.Order
 	for _, order := range orders {
-		returnedOrders = append(returnedOrders, domain.ToDomain(order))
+		returnedOrders = append(returnedOrders, m.toDTO(order))
 	}
 
 	span.LogKV(
@@ -581,24 +679,397 @@ func (m *Module) ListReturnOrders(ctx context.Context, page, limit int32) ([]*dt
 	return returnedOrders, nil
 }
 
-// DeleteIssuedOrders удаляет заказы из БД, которые забрал клиента больше двух дней назад
-func (m *Module) DeleteIssuedOrders(ctx context.Context) (int64, error) {
-	const op = "module.Module.DeleteIssuedOrders"
+// GetReturnManifest формирует список возврата для курьера: все просроченные и не выданные заказы, принятые от него
+func (m *Module) GetReturnManifest(ctx context.Context, courierID int64) ([]*dto.Order, error) {
+	const op = "module.Module.GetReturnManifest"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("courier_id", courierID)
+
+	orders, err := m.orderProvider.FindExpiredOrdersByCourierID(ctx, courierID, time.Now().UTC())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV(
+			"event", "find_expired_orders_error",
+			"courier_id", courierID,
+			"error", err.Error(),
+		)
+
+		m.logger.Error("error while finding expired orders for courier", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	manifest := make([]*dto.Order, 0, len(orders))
+	for _, order := range orders {
+		manifest = append(manifest, m.toDTO(order))
+	}
+
+	span.LogKV("event", "return_manifest_built", "courier_id", courierID, "orders_count", len(manifest))
+
+	return manifest, nil
+}
+
+// ConfirmReturnManifest отмечает все заказы из списка возврата переданными курьеру.
+// Заказы обновляются в одной транзакции: если хотя бы один заказ нельзя вернуть, не возвращается ни один.
+func (m *Module) ConfirmReturnManifest(ctx context.Context, courierID int64, orderIDs []int64) error {
+	const op = "module.Module.ConfirmReturnManifest"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
 	start := time.Now()
-	defer metrics.ObserveOperationDuration(op, time.Since(start))
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("courier_id", courierID)
+	span.SetTag("order_ids", orderIDs)
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var returnedOrders []*domain.Order
+
+	err = m.transactionManager.RunTransactionalQuery(ctx, repeatableRead, readWrite, func(ctxTX context.Context) error {
+		orders, err := m.orderProvider.FindOrderByIDs(ctxTX, orderIDs)
+		if err != nil {
+			return err
+		}
+
+		if len(orders) != len(orderIDs) {
+			return ErrOrderNotFound
+		}
+
+		now := time.Now()
+
+		for _, order := range orders {
+			if !order.BelongsToCourier(courierID) {
+				span.LogKV(
+					"event", "different_courier_error",
+					"order_id", order.ID,
+					"expected_courier_id", order.CourierID.Int64,
+					"actual_courier_id", courierID,
+				)
+
+				return ErrOrderCourierMismatch
+			}
+
+			from := order.StatusAt(now)
 
-	count, err := m.orderDeleter.DeleteRecipientOrders(ctx)
+			err := order.Transition(domain.OrderStatusReturnedToCourier, now)
+			if err != nil {
+				span.LogKV("event", "order_not_returnable", "order_id", order.ID, "status", from)
+
+				return fmt.Errorf("%w: %w", ErrOrderNotExpiredOrIssued, err)
+			}
+
+			err = m.updateOrder(ctxTX, order)
+			if err != nil {
+				return err
+			}
+
+			err = m.saveOrderEvent(ctxTX, order, from, domain.OperationReturnOrderCourier,
+				map[string]any{"order_ids": orderIDs, "courier_id": courierID})
+			if err != nil {
+				return err
+			}
 
+			err = m.saveNotification(ctxTX, domain.NotificationOrderReturnedToCourier, order)
+			if err != nil {
+				return err
+			}
+		}
+
+		returnedOrders = orders
+
+		return nil
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "confirm_manifest_error", "error", err.Error())
+
+		m.logger.Error("error while confirming return manifest", zap.Error(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, order := range returnedOrders {
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, order.ID), order)
+		metrics.AddReturnedOrders()
+	}
+
+	span.LogKV("event", "return_manifest_confirmed", "courier_id", courierID, "orders_count", len(returnedOrders))
+	m.logger.Info("return manifest confirmed successfully")
+
+	return nil
+}
+
+// ListCourierReturns возвращает заказы, переданные курьерам в промежутке [from, to)
+func (m *Module) ListCourierReturns(ctx context.Context, from, to time.Time) ([]*dto.Order, error) {
+	const op = "module.Module.ListCourierReturns"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("from", from)
+	span.SetTag("to", to)
+
+	if !from.Before(to) {
+		span.SetTag("error", true)
+		span.LogKV("event", "invalid_date_range", "from", from, "to", to)
+
+		return nil, fmt.Errorf("%s: %w", op, ErrInvalidDateRange)
+	}
+
+	orders, err := m.orderProvider.FindOrdersReturnedToCourier(ctx, from.UTC(), to.UTC())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_courier_returns_error", "error", err.Error())
+
+		m.logger.Error("error while finding orders returned to courier", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	courierReturns := make([]*dto.Order, 0, len(orders))
+	for _, order := range orders {
+		courierReturns = append(courierReturns, m.toDTO(order))
+	}
+
+	span.LogKV("event", "courier_returns_listed", "orders_count", len(courierReturns))
+
+	return courierReturns, nil
+}
+
+// cleanupBatchSize число заказов, возвращенных клиентом, которые проверяются за один запрос к БД
+const cleanupBatchSize int32 = 500
+
+// DeleteReturnedOrders удаляет из БД заказы, которые были возвращены курьеру дольше срока хранения назад,
+// и заказы, возвращенные клиентом, у которых с момента возврата прошел срок возврата.
+// Это единственное место, где данные о заказах удаляются.
+func (m *Module) DeleteReturnedOrders(ctx context.Context) (int64, error) {
+	const op = "module.Module.DeleteReturnedOrders"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	now := time.Now().UTC()
+
+	count, err := m.orderDeleter.DeleteRecipientOrders(ctx, now.Add(-m.policies.ReturnWindow.RetentionPeriod()))
 	if err != nil {
 		m.logger.Error("failed to delete old orders", zap.Error(err))
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
-	m.logger.Info("old orders deleted successfully")
+	clientReturns, err := m.deleteClientReturns(ctx, now)
+	if err != nil {
+		m.logger.Error("failed to delete client returns", zap.Error(err))
+		return count + clientReturns, fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.logger.Info("old orders deleted successfully",
+		zap.Int64("returned_to_courier", count),
+		zap.Int64("returned_by_client", clientReturns),
+	)
+
+	return count + clientReturns, nil
+}
+
+// deleteClientReturns удаляет заказы, возвращенные клиентом, у которых к моменту at истек срок возврата,
+// отсчитанный от момента возврата. Срок зависит от категории и типа упаковки заказа,
+// поэтому из БД отбираются заказы старше самого короткого срока, а остальное проверяется здесь.
+func (m *Module) deleteClientReturns(ctx context.Context, at time.Time) (int64, error) {
+	var (
+		before  = at.Add(-m.policies.ReturnWindow.Shortest())
+		afterID int64
+		deleted int64
+	)
+
+	for {
+		orders, err := m.orderProvider.FindClientReturnsBefore(ctx, before, afterID, cleanupBatchSize)
+		if err != nil {
+			return deleted, err
+		}
+
+		ids := make([]int64, 0, len(orders))
+		for _, order := range orders {
+			afterID = order.ID
+
+			if order.ReturnedAt.Valid && at.After(order.ReturnedAt.Time.Add(m.policies.ReturnWindow.For(order))) {
+				ids = append(ids, order.ID)
+			}
+		}
+
+		if len(ids) > 0 {
+			count, err := m.orderDeleter.DeleteClientReturns(ctx, ids)
+			if err != nil {
+				return deleted, err
+			}
+
+			deleted += count
+		}
+
+		if len(orders) < int(cleanupBatchSize) {
+			return deleted, nil
+		}
+	}
+}
+
+// GetOrder возвращает полную запись заказа.
+// Заказ берется из кэша, пока запись в нем не устарела, иначе читается из БД и кладется в кэш.
+func (m *Module) GetOrder(ctx context.Context, orderID int64) (*dto.Order, error) {
+	const op = "module.Module.GetOrder"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("order_id", orderID)
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	order, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, orderID))
+	if !found {
+		order, err = m.orderProvider.FindOrderByID(ctx, orderID)
+		if err != nil {
+			span.SetTag("error", true)
+
+			if errors.Is(err, storage.ErrOrderNotFound) {
+				span.LogKV("event", "order_not_found", "order_id", orderID)
+
+				return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+			}
+
+			span.LogKV("event", "find_order_error", "order_id", orderID, "error", err.Error())
+
+			m.logger.Error("error while finding order", zap.Error(err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), order)
+	}
+
+	span.LogKV("event", "order_found", "order_id", orderID, "cached", found)
+
+	return m.toDTO(order), nil
+}
+
+// GetOrderHistory возвращает историю изменения статусов заказа
+func (m *Module) GetOrderHistory(ctx context.Context, orderID int64) ([]*dto.OrderEvent, error) {
+	const op = "module.Module.GetOrderHistory"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("order_id", orderID)
+
+	events, err := m.orderProvider.FindOrderEventsByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, storage.ErrOrderNotFound) {
+			span.SetTag("error", true)
+			span.LogKV("event", "order_history_not_found", "order_id", orderID)
+
+			return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+		}
+
+		span.SetTag("error", true)
+		span.LogKV(
+			"event", "find_order_events_error",
+			"order_id", orderID,
+			"error", err.Error(),
+		)
+
+		m.logger.Error("error while finding order history", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	history := make([]*dto.OrderEvent, 0, len(events))
+	for _, event := range events {
+		history = append(history, domain.ToOrderEventDTO(event))
+	}
+
+	span.LogKV("event", "order_history_listed", "order_id", orderID, "events_count", len(history))
+
+	return history, nil
+}
+
+// pickupPointID возвращает идентификатор ПВЗ, от имени которого выполняется операция
+func pickupPointID(ctx context.Context) (int64, error) {
+	id, ok := domain.PickupPointIDFromContext(ctx)
+	if !ok {
+		return 0, domain.ErrPickupPointRequired
+	}
+
+	return id, nil
+}
+
+// createOrder подписывает дайджест нового заказа и сохраняет его
+func (m *Module) createOrder(ctx context.Context, order *domain.Order) error {
+	order.Seal(m.integrityKey)
+
+	return m.orderSaver.CreateOrder(ctx, order)
+}
+
+// updateOrder пересчитывает дайджест измененного заказа и сохраняет его,
+// если с момента чтения заказ никто не изменил.
+// Дайджест и версия меняются прямо в order, даже если транзакция потом откатится. Поэтому передается
+// копия заказа из кэша или заказ, прочитанный в той же транзакции, а в кэш он кладется только после коммита.
+func (m *Module) updateOrder(ctx context.Context, order *domain.Order) error {
+	order.Seal(m.integrityKey)
+
+	err := m.orderSaver.UpdateOrder(ctx, order)
+	if errors.Is(err, storage.ErrOrderVersionConflict) {
+		return fmt.Errorf("%w: order %d", ErrOrderConflict, order.ID)
+	}
+
+	return err
+}
+
+// toDTO преобразует заказ в DTO и добавляет срок возврата, который действует для заказа
+func (m *Module) toDTO(order *domain.Order) *dto.Order {
+	orderDTO := domain.ToDomain(order)
+
+	orderDTO.ReturnWindow = m.policies.ReturnWindow.For(order)
+	orderDTO.ReturnDeadline = order.ReturnDeadline(orderDTO.ReturnWindow)
+
+	return orderDTO
+}
+
+// saveOrderEvent записывает переход заказа в историю.
+// Вызывается внутри той же транзакции, что и изменение заказа.
+func (m *Module) saveOrderEvent(ctx context.Context, order *domain.Order, from domain.OrderStatus, operation string, payload any) error {
+	event, err := domain.NewOrderEvent(order, from, operation, payload)
+	if err != nil {
+		return err
+	}
 
-	return count, nil
+	return m.orderSaver.CreateOrderEvent(ctx, event)
 }
@@ -3,7 +3,6 @@ package module
 import (
 	"context"
 	"database/sql"
-	"errors"
 	"testing"
 	"time"
 
@@ -41,10 +40,21 @@ func newFixture(t *testing.T) *fixture {
 	mockOrderDeleter := mock_module.NewMockOrderDeleter(ctrl)
 	mockTransactionManager := mock_module.NewMockTransactionManager(ctrl)
 	mockCache := mock_module.NewMockCache(ctrl)
+	mockCache.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+	mockCache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 	logger := zap.NewNop()
 
-	orderModule := New(mockOrderProvider, mockOrderDeleter, mockOrderSaver, mockTransactionManager, mockCache, logger)
+	orderModule := New(
+		mockOrderProvider,
+		mockOrderDeleter,
+		mockOrderSaver,
+		mockTransactionManager,
+		mockCache,
+		testPolicies,
+		testIntegrityKey,
+		logger,
+	)
 
 	assertions := assert.New(t)
 	reqAssertions := require.New(t)
@@ -64,10 +74,65 @@ func newFixture(t *testing.T) *fixture {
 	}
 }
 
-// TODO: обновить тесты с учетом кэша
+const testPickupPointID int64 = 1
+
+// testIntegrityKey секрет, которым модуль подписывает заказы в тестах
+var testIntegrityKey = []byte("test-secret")
+
+// testPolicies правила, с которыми модуль создается в тестах
+var testPolicies = Policies{
+	StorageFee: domain.StorageFeePolicies{
+		Default: domain.StorageFeePolicy{FreeDays: 1, DailyRate: 5000, Cap: 20000},
+	},
+	StorageExtension: domain.StorageExtensionPolicy{MaxTotal: 5 * 24 * time.Hour, MaxCount: 2},
+	ReturnWindow: domain.ReturnWindowPolicies{
+		Categories: map[string]time.Duration{"electronics": 14 * 24 * time.Hour},
+		Retention:  10 * 24 * time.Hour,
+	},
+}
+
+// pickupPointContext возвращает контекст ПВЗ, от имени которого выполняются операции в тестах
+func pickupPointContext() context.Context {
+	return domain.WithPickupPointID(context.Background(), testPickupPointID)
+}
+
+// expectTransaction ожидает вызов транзакции и выполняет переданную функцию
+func (fx *fixture) expectTransaction(isoLevel transactor.TxIsoLevel) *gomock.Call {
+	return fx.mockTransactionManager.EXPECT().
+		RunTransactionalQuery(gomock.Any(), isoLevel, readWrite, gomock.Any()).
+		DoAndReturn(
+			func(ctx context.Context, isoLevel transactor.TxIsoLevel, accessMode transactor.TxAccessMode, queryFunc transactor.QueryFunc) error {
+				return queryFunc(ctx)
+			}).Times(1)
+}
+
+// expectCachedOrder подменяет кэш модуля: order отдается из кэша, а запись в кэш тестом не ожидается
+func (fx *fixture) expectCachedOrder(order *domain.Order) {
+	cache := mock_module.NewMockCache(fx.ctrl)
+	cache.EXPECT().Get(gomock.Any(), domain.NewOrderKey(testPickupPointID, order.ID)).Return(order, true).AnyTimes()
+
+	fx.module.cache = cache
+}
+
+// expectRegisteredRecipient ожидает проверку, что получатель зарегистрирован
+func (fx *fixture) expectRegisteredRecipient(recipientID int64) *gomock.Call {
+	return fx.mockOrderProvider.EXPECT().
+		FindRecipientByID(gomock.Any(), recipientID).
+		Return(&domain.Recipient{ID: recipientID}, nil).
+		Times(1)
+}
+
+// expectCells ожидает блокировку ячеек ПВЗ и возвращает ячейки cells
+func (fx *fixture) expectCells(cells ...*domain.Cell) *gomock.Call {
+	return fx.mockOrderProvider.EXPECT().
+		LockCells(gomock.Any()).
+		Return(cells, nil).
+		Times(1)
+}
+
 func TestModule_AcceptOrderCourier(t *testing.T) {
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 	)
 
 	// happy path
@@ -83,24 +148,56 @@ func TestModule_AcceptOrderCourier(t *testing.T) {
 		order := &dto.Order{
 			OrderID:      10,
 			RecipientID:  1,
+			CourierID:    3,
 			StorageUntil: time.Now().Add(time.Hour),
 			IssuedAt:     time.Time{},
 			ReturnAt:     time.Time{},
 			Weight:       5.0,
-			Cost:         120,
+			Cost:         12000,
 			PackageType:  "box",
 		}
 
 		orderEntity, err := domain.NewOrder(order, packageType)
 		require.NoError(t, err)
+		orderEntity.PickupPointID = testPickupPointID
 
-		fx.mockOrderSaver.EXPECT().CreateOrder(gomock.Any(), testutils.OrderEq(orderEntity)).Return(nil).Times(1)
+		gomock.InOrder(
+			fx.expectTransaction(readCommitted),
+			fx.expectRegisteredRecipient(order.RecipientID),
+			fx.mockOrderSaver.EXPECT().
+				CreateCourier(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, courier *domain.Courier) error {
+					fx.assert.Equal(order.CourierID, courier.ID)
+					return nil
+				}).
+				Times(1),
+			fx.expectCells(),
+			fx.mockOrderSaver.EXPECT().
+				CreateOrder(gomock.Any(), testutils.OrderEq(orderEntity)).
+				Do(func(_ context.Context, saved *domain.Order) {
+					fx.assert.Equal(domain.IntegrityStatusOK, saved.CheckIntegrity(testIntegrityKey))
+				}).
+				Return(nil).
+				Times(1),
+			fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateNotification(gomock.Any(), testutils.NotificationEq(order.OrderID, domain.NotificationOrderArrived)).
+				Do(func(_ context.Context, notification *domain.Notification) {
+					fx.assert.Len(notification.PickupCode, domain.PickupCodeLength, "first pickup code must be sent to recipient")
+				}).
+				Return(nil).
+				Times(1),
+		)
 
 		// act
-		err = fx.module.AcceptOrderCourier(ctx, order)
+		accepted, err := fx.module.AcceptOrderCourier(ctx, order)
 
 		// assert
 		fx.require.NoError(err)
+		fx.assert.Equal(int64(12000), accepted.Cost)
+		fx.assert.Equal(int64(2000), accepted.PackageCost)
+		fx.assert.Equal(int64(14000), accepted.TotalCost)
+		fx.assert.Equal("RUB", accepted.Currency)
 	})
 	t.Run("should return error when storage time is in the past", func(t *testing.T) {
 		t.Parallel()
@@ -115,12 +212,12 @@ func TestModule_AcceptOrderCourier(t *testing.T) {
 			IssuedAt:     time.Time{},
 			ReturnAt:     time.Time{},
 			Weight:       5.0,
-			Cost:         120,
+			Cost:         12000,
 			PackageType:  "box",
 		}
 
 		// act
-		err := fx.module.AcceptOrderCourier(ctx, order)
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
 
 		// assert
 		fx.require.Error(err)
@@ -140,19 +237,23 @@ func TestModule_AcceptOrderCourier(t *testing.T) {
 			IssuedAt:     time.Time{},
 			ReturnAt:     time.Time{},
 			Weight:       5.0,
-			Cost:         120,
+			Cost:         12000,
 			PackageType:  "box",
 		}
 
 		orderEntity, _ := domain.NewOrder(order, packageType)
+		orderEntity.PickupPointID = testPickupPointID
 
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.expectCells()
 		fx.mockOrderSaver.EXPECT().
 			CreateOrder(gomock.Any(), testutils.OrderEq(orderEntity)).
 			Return(storage.ErrOrderExists).
 			Times(1)
 
 		// act
-		err := fx.module.AcceptOrderCourier(ctx, order)
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
 
 		// assert
 		fx.require.Error(err)
@@ -172,29 +273,257 @@ func TestModule_AcceptOrderCourier(t *testing.T) {
 			IssuedAt:     time.Time{},
 			ReturnAt:     time.Time{},
 			Weight:       5.0,
-			Cost:         120,
+			Cost:         12000,
 			PackageType:  "box",
 		}
 
 		orderEntity, _ := domain.NewOrder(order, packageType)
+		orderEntity.PickupPointID = testPickupPointID
 
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.expectCells()
 		fx.mockOrderSaver.EXPECT().
 			CreateOrder(gomock.Any(), testutils.OrderEq(orderEntity)).
 			Return(assert.AnError).
 			Times(1)
 
 		// act
-		err := fx.module.AcceptOrderCourier(ctx, order)
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+
+	t.Run("should accept order with composite packaging", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:       11,
+			RecipientID:   1,
+			StorageUntil:  time.Now().Add(time.Hour),
+			Weight:        5.0,
+			Cost:          12000,
+			PackageLayers: []string{"box", "film"},
+		}
+
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.expectCells()
+		fx.mockOrderSaver.EXPECT().
+			CreateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				fx.assert.Equal("box+film", order.PackageType.Type())
+				fx.assert.Equal(int64(2000), order.PackageCost)
+				return nil
+			}).
+			Times(1)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.NoError(err)
+	})
+	t.Run("should return error when composite packaging is too heavy", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:       11,
+			RecipientID:   1,
+			StorageUntil:  time.Now().Add(time.Hour),
+			Weight:        12.0,
+			Cost:          12000,
+			PackageLayers: []string{"box", "package"},
+		}
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrWeightExceedsLimit{Weight: 12, Limit: 10})
+	})
+	t.Run("should return error when both package type and layers are set", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:       11,
+			RecipientID:   1,
+			StorageUntil:  time.Now().Add(time.Hour),
+			Weight:        5.0,
+			PackageType:   "box",
+			PackageLayers: []string{"box", "film"},
+		}
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, ErrPackageLayersConflict)
+	})
+	t.Run("should fail if recipient is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:      12,
+			RecipientID:  5,
+			StorageUntil: time.Now().Add(time.Hour),
+			Weight:       5.0,
+			PackageType:  "box",
+		}
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), order.RecipientID).Return(nil, storage.ErrRecipientNotFound)
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, ErrRecipientNotRegistered)
+	})
+	t.Run("should create unregistered recipient when allowed", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:         12,
+			RecipientID:     5,
+			StorageUntil:    time.Now().Add(time.Hour),
+			Weight:          5.0,
+			PackageType:     "box",
+			CreateRecipient: true,
+		}
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderSaver.EXPECT().
+			EnsureRecipient(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, recipient *domain.Recipient) error {
+				fx.assert.Equal(order.RecipientID, recipient.ID)
+				fx.assert.Empty(recipient.Phone)
+				return nil
+			})
+		fx.expectCells()
+		fx.mockOrderSaver.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.NoError(err)
+	})
+	t.Run("should fail when notification is not saved", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:      13,
+			RecipientID:  1,
+			StorageUntil: time.Now().Add(time.Hour),
+			Weight:       5.0,
+			PackageType:  "box",
+		}
+
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.expectCells()
+		fx.mockOrderSaver.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
 
 		// assert
 		fx.require.ErrorIs(err, assert.AnError)
 	})
+	t.Run("should place order in best free cell", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:      14,
+			RecipientID:  1,
+			StorageUntil: time.Now().Add(time.Hour),
+			Weight:       5.0,
+			PackageType:  "box",
+		}
+
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.expectCells(
+			&domain.Cell{ID: 1, Zone: "A", Rack: "1", Shelf: "1", MaxOrders: 10, MaxWeight: 50},
+			&domain.Cell{ID: 2, Zone: "B", Rack: "2", Shelf: "3", MaxOrders: 10, MaxWeight: 50, PackageTypes: []string{"box"}},
+		)
+		fx.mockOrderSaver.EXPECT().
+			CreateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, saved *domain.Order) error {
+				fx.assert.Equal(sql.NullInt64{Int64: 2, Valid: true}, saved.CellID)
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		accepted, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(int64(2), accepted.CellID)
+		fx.assert.Equal("B-2-3", accepted.CellCode)
+	})
+	t.Run("should fail when all cells are full", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:      15,
+			RecipientID:  1,
+			StorageUntil: time.Now().Add(time.Hour),
+			Weight:       5.0,
+			PackageType:  "box",
+		}
+
+		fx.expectTransaction(readCommitted)
+		fx.expectRegisteredRecipient(order.RecipientID)
+		fx.expectCells(&domain.Cell{ID: 1, MaxOrders: 1, MaxWeight: 50, OrderCount: 1})
+
+		// act
+		_, err := fx.module.AcceptOrderCourier(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrCellNotFree)
+	})
 }
 
 func TestModule_ReturnOrderCourier(t *testing.T) {
 	var (
-		ctx               = context.Background()
+		ctx               = pickupPointContext()
 		orderID     int64 = 1
+		courierID   int64 = 3
 		storageTime       = time.Now().Add(-time.Hour)
 		issuedTime        = sql.NullTime{Valid: false}
 	)
@@ -208,21 +537,66 @@ func TestModule_ReturnOrderCourier(t *testing.T) {
 
 		order := &domain.Order{
 			ID:           orderID,
+			CourierID:    sql.NullInt64{Int64: courierID, Valid: true},
+			Status:       domain.OrderStatusAccepted,
 			StorageUntil: storageTime,
 			IssuedAt:     issuedTime,
 		}
 
 		gomock.InOrder(
 			fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil).Times(1),
-			fx.mockOrderDeleter.EXPECT().DeleteOrder(gomock.Any(), orderID).Return(nil).Times(1),
+			fx.expectTransaction(readCommitted),
+			fx.mockOrderSaver.EXPECT().
+				UpdateOrder(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, order *domain.Order) error {
+					fx.assert.Equal(domain.OrderStatusReturnedToCourier, order.Status)
+					fx.assert.True(order.ReturnedToCourierAt.Valid)
+					return nil
+				}).
+				Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(orderID, domain.OrderStatusReturnedToCourier)).
+				Return(nil).
+				Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateNotification(gomock.Any(), testutils.NotificationEq(orderID, domain.NotificationOrderReturnedToCourier)).
+				Return(nil).
+				Times(1),
 		)
 
 		// act
-		err := fx.module.ReturnOrderCourier(ctx, orderID)
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
 
 		// assert
 		fx.require.NoError(err)
 	})
+	t.Run("should keep cached order unchanged when transaction fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		cached := &domain.Order{
+			ID:           orderID,
+			CourierID:    sql.NullInt64{Int64: courierID, Valid: true},
+			Status:       domain.OrderStatusAccepted,
+			StorageUntil: storageTime,
+			Version:      1,
+		}
+
+		fx.expectCachedOrder(cached)
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		// act
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		fx.assert.Equal(domain.OrderStatusAccepted, cached.Status)
+		fx.assert.False(cached.ReturnedToCourierAt.Valid)
+	})
 	t.Run("should return error when order is not found", func(t *testing.T) {
 		t.Parallel()
 
@@ -235,7 +609,7 @@ func TestModule_ReturnOrderCourier(t *testing.T) {
 			Times(1)
 
 		// act
-		err := fx.module.ReturnOrderCourier(ctx, orderID)
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
 
 		// assert
 		fx.require.Error(err)
@@ -253,7 +627,7 @@ func TestModule_ReturnOrderCourier(t *testing.T) {
 			Times(1)
 
 		// act
-		err := fx.module.ReturnOrderCourier(ctx, orderID)
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
 
 		// assert
 		fx.require.Error(err)
@@ -270,7 +644,7 @@ func TestModule_ReturnOrderCourier(t *testing.T) {
 			Times(1)
 
 		// act
-		err := fx.module.ReturnOrderCourier(ctx, orderID)
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
 
 		// assert
 		fx.require.ErrorIs(err, assert.AnError)
@@ -283,6 +657,7 @@ func TestModule_ReturnOrderCourier(t *testing.T) {
 
 		order := &domain.Order{
 			ID:           orderID,
+			Status:       domain.OrderStatusAccepted,
 			StorageUntil: time.Now().Add(time.Hour), // not expired
 			IssuedAt:     issuedTime,
 		}
@@ -290,162 +665,170 @@ func TestModule_ReturnOrderCourier(t *testing.T) {
 		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil).Times(1)
 
 		// act
-		err := fx.module.ReturnOrderCourier(ctx, orderID)
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
 
 		// assert
-		fx.require.Error(err)
-		fx.require.Equal(ErrOrderNotExpiredOrIssued, errors.Unwrap(err))
+		fx.require.ErrorIs(err, ErrOrderNotExpiredOrIssued)
+		fx.require.ErrorIs(err, domain.ErrOrderStatusTransition)
 	})
-}
-
-func TestModule_IssueOrderClient(t *testing.T) {
-	var (
-		ctx      = context.Background()
-		orderIDs = []int64{1, 2}
-		orders   = []*domain.Order{
-			{ID: 1, RecipientID: 3},
-			{ID: 2, RecipientID: 3},
-		}
-		ordersDifferentRecipients = []*domain.Order{
-			{ID: 1, RecipientID: 3},
-			{ID: 2, RecipientID: 4},
-		}
-	)
-
-	t.Run("should issue order successfully", func(t *testing.T) {
+	t.Run("should return error when pickup point is not specified", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
 		fx := newFixture(t)
 
-		gomock.InOrder(
-			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1),
-			fx.mockTransactionManager.EXPECT().
-				RunTransactionalQuery(gomock.Any(), repeatableRead, readWrite, gomock.Any()).
-				DoAndReturn(
-					func(ctx context.Context, isoLevel transactor.TxIsoLevel, accessMode transactor.TxAccessMode, queryFunc transactor.QueryFunc) error {
-						return queryFunc(ctx)
-					}).Times(1),
-			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), orders[0]).Return(nil).Times(1),
-			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), orders[1]).Return(nil).Times(1),
-		)
-
 		// act
-		err := fx.module.IssueOrderClient(ctx, orderIDs)
+		err := fx.module.ReturnOrderCourier(context.Background(), orderID, courierID)
 
 		// assert
-		fx.require.NoError(err)
+		fx.require.ErrorIs(err, domain.ErrPickupPointRequired)
 	})
-	t.Run("should return error when orders not exists", func(t *testing.T) {
+	t.Run("should return error when order was accepted from another courier", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
 		fx := newFixture(t)
 
-		fx.mockOrderProvider.EXPECT().
-			FindOrderByIDs(gomock.Any(), orderIDs).
-			Return(nil, assert.AnError).
-			Times(1)
+		order := &domain.Order{
+			ID:           orderID,
+			CourierID:    sql.NullInt64{Int64: courierID + 1, Valid: true},
+			Status:       domain.OrderStatusAccepted,
+			StorageUntil: storageTime,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil).Times(1)
 
 		// act
-		err := fx.module.IssueOrderClient(context.Background(), orderIDs)
+		err := fx.module.ReturnOrderCourier(ctx, orderID, courierID)
 
 		// assert
-		fx.require.ErrorIs(err, assert.AnError)
+		fx.require.ErrorIs(err, ErrOrderCourierMismatch)
+		fx.assert.Equal(domain.OrderStatusAccepted, order.Status)
 	})
-	t.Run("should return error when orders have different clients", func(t *testing.T) {
+}
+
+func TestModule_AcceptReturnClient(t *testing.T) {
+	var (
+		ctx           = pickupPointContext()
+		orderID int64 = 10
+		order         = &dto.Order{
+			OrderID:         orderID,
+			RecipientID:     1,
+			ReturnReason:    "defective",
+			ReturnCondition: "damaged",
+			ReturnNote:      "broken lid",
+		}
+	)
+
+	t.Run("should accept return successfully", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
 		fx := newFixture(t)
 
-		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(ordersDifferentRecipients, nil).Times(1)
-
-		// act
-		err := fx.module.IssueOrderClient(ctx, orderIDs)
-
-		// assert
-		fx.require.Error(err)
-		fx.assert.ErrorIs(err, ErrOrdersDifferentClients)
-	})
-	t.Run("should fail if unable to update order", func(t *testing.T) {
-		t.Parallel()
+		existedOrder := &domain.Order{
+			ID:          orderID,
+			RecipientID: 1,
+			Status:      domain.OrderStatusIssued,
+			IssuedAt:    sql.NullTime{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+		}
 
-		// assert
-		fx := newFixture(t)
+		var saved *domain.Order
 
 		gomock.InOrder(
-			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1),
-			fx.mockTransactionManager.EXPECT().RunTransactionalQuery(gomock.Any(), repeatableRead, readWrite, gomock.Any()).DoAndReturn(
-				func(ctx context.Context, isoLevel transactor.TxIsoLevel, accessMode transactor.TxAccessMode, queryFunc transactor.QueryFunc) error {
-					return queryFunc(ctx)
-				}).Times(1),
+			fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(existedOrder, nil).Times(1),
+			fx.expectTransaction(readCommitted),
+			fx.mockOrderSaver.EXPECT().
+				UpdateOrder(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, order *domain.Order) error {
+					saved = order
+					return nil
+				}).
+				Times(1),
 			fx.mockOrderSaver.EXPECT().
-				UpdateOrder(gomock.Any(), gomock.AssignableToTypeOf(&domain.Order{IssuedAt: sql.NullTime{Valid: true}})).
-				Times(2).
-				Return(assert.AnError),
+				CreateOrderEvent(gomock.Any(), testutils.OrderEventEq(orderID, domain.OrderStatusReturnedByClient)).
+				Return(nil).
+				Times(1),
 		)
 
 		// act
-		err := fx.module.IssueOrderClient(ctx, orderIDs)
+		err := fx.module.AcceptReturnClient(ctx, order)
 
 		// assert
-		fx.require.ErrorIs(err, assert.AnError)
+		fx.require.NoError(err)
+		fx.require.NotNil(saved)
+		fx.assert.Equal("defective", saved.ReturnReason)
+		fx.assert.Equal(domain.ReturnConditionDamaged, saved.ReturnCondition)
+		fx.assert.Equal("broken lid", saved.ReturnNote)
+		fx.assert.False(saved.RefundAmount.Valid)
 	})
-	t.Run("should fail if transactional query fails", func(t *testing.T) {
+	t.Run("should save refund amount", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
 		fx := newFixture(t)
+		refund := int64(5000)
+		existedOrder := &domain.Order{
+			ID:          orderID,
+			RecipientID: 1,
+			Cost:        12000,
+			Currency:    "RUB",
+			Status:      domain.OrderStatusIssued,
+			IssuedAt:    sql.NullTime{Time: time.Now().Add(-24 * time.Hour), Valid: true},
+		}
 
-		gomock.InOrder(
-			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1),
-			fx.mockTransactionManager.EXPECT().
-				RunTransactionalQuery(gomock.Any(), repeatableRead, readWrite, gomock.Any()).
-				Return(assert.AnError).
-				Times(1),
-		)
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(existedOrder, nil)
+		fx.expectTransaction(readCommitted)
+
+		var saved *domain.Order
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				saved = order
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
 
 		// act
-		err := fx.module.IssueOrderClient(ctx, orderIDs)
+		err := fx.module.AcceptReturnClient(ctx, &dto.Order{
+			OrderID:         orderID,
+			RecipientID:     1,
+			ReturnReason:    "changed_mind",
+			ReturnCondition: "intact",
+			RefundAmount:    &refund,
+			Currency:        "RUB",
+		})
 
 		// assert
-		fx.require.ErrorIs(err, assert.AnError)
+		fx.require.NoError(err)
+		fx.require.NotNil(saved)
+		fx.assert.Equal(sql.NullInt64{Int64: refund, Valid: true}, saved.RefundAmount)
 	})
-}
-
-func TestModule_AcceptReturnClient(t *testing.T) {
-	var (
-		ctx           = context.Background()
-		orderID int64 = 10
-		order         = &dto.Order{
-			OrderID:     orderID,
-			RecipientID: 1,
-		}
-	)
-
-	t.Run("should accept return successfully", func(t *testing.T) {
+	t.Run("should fail if return reason is unknown", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
 		fx := newFixture(t)
-
 		existedOrder := &domain.Order{
 			ID:          orderID,
 			RecipientID: 1,
+			Status:      domain.OrderStatusIssued,
 			IssuedAt:    sql.NullTime{Time: time.Now().Add(-24 * time.Hour), Valid: true},
 		}
 
-		gomock.InOrder(
-			fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(existedOrder, nil).Times(1),
-			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), existedOrder).Return(nil).Times(1),
-		)
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(existedOrder, nil)
 
 		// act
-		err := fx.module.AcceptReturnClient(ctx, order)
+		err := fx.module.AcceptReturnClient(ctx, &dto.Order{
+			OrderID:         orderID,
+			RecipientID:     1,
+			ReturnReason:    "too_expensive",
+			ReturnCondition: "intact",
+		})
 
 		// assert
-		fx.require.NoError(err)
+		fx.require.ErrorIs(err, domain.ErrReturnReasonUnknown)
+		fx.assert.Equal(domain.OrderStatusIssued, existedOrder.Status)
 	})
 	t.Run("should fail if order not found", func(t *testing.T) {
 		t.Parallel()
@@ -484,6 +867,7 @@ func TestModule_AcceptReturnClient(t *testing.T) {
 		existedOrder := &domain.Order{
 			ID:          orderID,
 			RecipientID: 2,
+			Status:      domain.OrderStatusIssued,
 			IssuedAt:    sql.NullTime{Time: time.Now().Add(-24 * time.Hour), Valid: true},
 		}
 
@@ -504,6 +888,7 @@ func TestModule_AcceptReturnClient(t *testing.T) {
 		existedOrder := &domain.Order{
 			ID:          orderID,
 			RecipientID: 1,
+			Status:      domain.OrderStatusIssued,
 			IssuedAt:    sql.NullTime{Time: time.Now().Add(-72 * time.Hour), Valid: true},
 		}
 
@@ -513,46 +898,106 @@ func TestModule_AcceptReturnClient(t *testing.T) {
 		err := fx.module.AcceptReturnClient(ctx, order)
 
 		// assert
-		fx.require.Error(err, ErrOrderNotIssuedOrExpired)
+		fx.require.ErrorIs(err, ErrOrderNotIssuedOrExpired)
 	})
-	t.Run("should fail if update order fails", func(t *testing.T) {
+	t.Run("should accept return within category return window", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
 		fx := newFixture(t)
 
-		order := &dto.Order{
-			OrderID:      10,
-			RecipientID:  1,
+		existedOrder := &domain.Order{
+			ID:          orderID,
+			RecipientID: 1,
+			Category:    "electronics",
+			Status:      domain.OrderStatusIssued,
+			IssuedAt:    sql.NullTime{Time: time.Now().Add(-72 * time.Hour), Valid: true},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), order.OrderID).Return(existedOrder, nil)
+		fx.expectTransaction(readCommitted)
+
+		var saved *domain.Order
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				saved = order
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		err := fx.module.AcceptReturnClient(ctx, order)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.NotNil(saved)
+		fx.assert.Equal(domain.OrderStatusReturnedByClient, saved.Status)
+	})
+	t.Run("should fail if order was not issued", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		existedOrder := &domain.Order{
+			ID:           orderID,
+			RecipientID:  1,
+			Status:       domain.OrderStatusAccepted,
 			StorageUntil: time.Now().Add(time.Hour),
-			IssuedAt:     time.Now().Add(48 * time.Hour),
-			ReturnAt:     time.Time{},
-			Weight:       5.0,
-			Cost:         120,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), order.OrderID).Return(existedOrder, nil).Times(1)
+
+		// act
+		err := fx.module.AcceptReturnClient(ctx, order)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotIssuedOrExpired)
+		fx.require.ErrorIs(err, domain.ErrOrderStatusTransition)
+	})
+	t.Run("should fail if update order fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		order := &dto.Order{
+			OrderID:         10,
+			RecipientID:     1,
+			StorageUntil:    time.Now().Add(time.Hour),
+			IssuedAt:        time.Now().Add(48 * time.Hour),
+			ReturnAt:        time.Time{},
+			Weight:          5.0,
+			Cost:            12000,
+			ReturnReason:    "defective",
+			ReturnCondition: "intact",
 		}
 
 		packageType, _ := domain.NewPackageType("box")
 		existedOrder, _ := domain.NewOrder(order, packageType)
+		fx.require.NoError(existedOrder.Transition(domain.OrderStatusIssued, time.Now()))
 
 		gomock.InOrder(
 			fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), order.OrderID).Return(existedOrder, nil).Times(1),
-			fx.mockOrderSaver.EXPECT().
-				UpdateOrder(gomock.Any(), testutils.OrderEq(existedOrder)).
-				Return(assert.AnError).
-				Times(1),
+			fx.expectTransaction(readCommitted),
+			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(assert.AnError).Times(1),
 		)
 
 		// act
-		err := fx.module.AcceptReturnClient(context.Background(), order)
+		err := fx.module.AcceptReturnClient(pickupPointContext(), order)
 
 		// assert
 		fx.require.ErrorIs(err, assert.AnError)
+		// заказ, который мог попасть в кэш, не меняется, если запись в БД не удалась
+		fx.assert.Equal(domain.OrderStatusIssued, existedOrder.Status)
+		fx.assert.Empty(existedOrder.ReturnReason)
 	})
 }
 
 func TestModule_ListOrders(t *testing.T) {
 	var (
-		ctx               = context.Background()
+		ctx               = pickupPointContext()
 		recipientID int64 = 1
 		limit       int32 = 5
 	)
@@ -564,9 +1009,9 @@ func TestModule_ListOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		orders := []*domain.Order{
-			{ID: 1, RecipientID: 1},
-			{ID: 2, RecipientID: 1},
-			{ID: 3, RecipientID: 2},
+			{ID: 1, RecipientID: 1, Status: domain.OrderStatusAccepted},
+			{ID: 2, RecipientID: 1, Status: domain.OrderStatusAccepted},
+			{ID: 3, RecipientID: 2, Status: domain.OrderStatusAccepted},
 		}
 
 		fx.mockOrderProvider.EXPECT().FindOrdersByRecipientID(gomock.Any(), recipientID).Return(orders, nil).Times(1)
@@ -577,8 +1022,39 @@ func TestModule_ListOrders(t *testing.T) {
 		// assert
 		fx.require.NoError(err)
 		fx.require.Len(result, 2)
-		fx.require.Equal(1, result[0].RecipientID)
-		fx.require.Equal(1, result[1].RecipientID)
+		fx.require.EqualValues(1, result[0].RecipientID)
+		fx.require.EqualValues(1, result[1].RecipientID)
+	})
+	t.Run("should show return window of returned order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		issuedAt := time.Now().Add(-time.Hour).UTC()
+
+		orders := []*domain.Order{
+			{
+				ID:          1,
+				RecipientID: recipientID,
+				Category:    "electronics",
+				Status:      domain.OrderStatusReturnedByClient,
+				IssuedAt:    sql.NullTime{Time: issuedAt, Valid: true},
+			},
+			{ID: 2, RecipientID: recipientID, Status: domain.OrderStatusAccepted},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrdersByRecipientID(gomock.Any(), recipientID).Return(orders, nil)
+
+		// act
+		result, err := fx.module.ListOrders(ctx, recipientID, limit)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(result, 2)
+		fx.assert.Equal(domain.DefaultReturnWindow, result[0].ReturnWindow)
+		fx.assert.True(result[0].ReturnDeadline.IsZero())
+		fx.assert.Equal(14*24*time.Hour, result[1].ReturnWindow)
+		fx.assert.Equal(issuedAt.Add(14*24*time.Hour), result[1].ReturnDeadline)
 	})
 	t.Run("should return error if no orders found", func(t *testing.T) {
 		t.Parallel()
@@ -623,8 +1099,8 @@ func TestModule_ListOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		orders := []*domain.Order{
-			{ID: 1, RecipientID: 1},
-			{ID: 2, RecipientID: 1},
+			{ID: 1, RecipientID: 1, Status: domain.OrderStatusAccepted},
+			{ID: 2, RecipientID: 1, Status: domain.OrderStatusAccepted},
 		}
 
 		fx.mockOrderProvider.EXPECT().FindOrdersByRecipientID(gomock.Any(), recipientID).Return(orders, nil).Times(1)
@@ -643,7 +1119,7 @@ func TestModule_ListOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		orders := []*domain.Order{
-			{ID: 3, RecipientID: 2}, // different recipient
+			{ID: 3, RecipientID: 2, Status: domain.OrderStatusAccepted}, // different recipient
 		}
 
 		fx.mockOrderProvider.EXPECT().FindOrdersByRecipientID(gomock.Any(), recipientID).Return(orders, nil).Times(1)
@@ -662,7 +1138,7 @@ func TestModule_ListOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		orders := []*domain.Order{
-			{ID: 3, RecipientID: recipientID, IssuedAt: sql.NullTime{Valid: true, Time: time.Unix(0, 0)}},
+			{ID: 3, RecipientID: recipientID, Status: domain.OrderStatusIssued, IssuedAt: sql.NullTime{Valid: true, Time: time.Unix(0, 0)}},
 		}
 
 		fx.mockOrderProvider.EXPECT().FindOrdersByRecipientID(gomock.Any(), recipientID).Return(orders, nil).Times(1)
@@ -678,7 +1154,7 @@ func TestModule_ListOrders(t *testing.T) {
 
 func TestModule_ListReturnOrders(t *testing.T) {
 	var (
-		ctx         = context.Background()
+		ctx         = pickupPointContext()
 		page  int32 = 1
 		limit int32 = 10
 	)
@@ -694,10 +1170,10 @@ func TestModule_ListReturnOrders(t *testing.T) {
 			{ID: 2, RecipientID: 1, ReturnedAt: sql.NullTime{Valid: true}},
 		}
 
-		fx.mockOrderProvider.EXPECT().FindReturnedOrdersWithPagination(ctx, limit, 0).Return(orders, nil).Times(1)
+		fx.mockOrderProvider.EXPECT().FindReturnedOrdersWithPagination(gomock.Any(), limit, int32(0), domain.ReturnCondition("")).Return(orders, nil).Times(1)
 
 		// act
-		result, err := fx.module.ListReturnOrders(ctx, page, limit)
+		result, err := fx.module.ListReturnOrders(ctx, page, limit, "")
 
 		// assert
 		fx.require.NoError(err)
@@ -711,12 +1187,12 @@ func TestModule_ListReturnOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		fx.mockOrderProvider.EXPECT().
-			FindReturnedOrdersWithPagination(ctx, limit, 0).
+			FindReturnedOrdersWithPagination(gomock.Any(), limit, int32(0), domain.ReturnCondition("")).
 			Return(nil, assert.AnError).
 			Times(1)
 
 		// act
-		result, err := fx.module.ListReturnOrders(ctx, page, limit)
+		result, err := fx.module.ListReturnOrders(ctx, page, limit, "")
 
 		// assert
 		fx.require.ErrorIs(err, assert.AnError)
@@ -730,25 +1206,62 @@ func TestModule_ListReturnOrders(t *testing.T) {
 		fx := newFixture(t)
 
 		fx.mockOrderProvider.EXPECT().
-			FindReturnedOrdersWithPagination(ctx, limit, 0).
+			FindReturnedOrdersWithPagination(gomock.Any(), limit, int32(0), domain.ReturnCondition("")).
 			Return([]*domain.Order{}, nil).
 			Times(1)
 
 		// act
-		result, err := fx.module.ListReturnOrders(ctx, page, limit)
+		result, err := fx.module.ListReturnOrders(ctx, page, limit, "")
 
 		// assert
 		fx.require.NoError(err)
 		fx.assert.Empty(result)
 	})
+
+	t.Run("should filter return orders by condition", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := []*domain.Order{
+			{ID: 1, RecipientID: 1, ReturnCondition: domain.ReturnConditionDamaged},
+		}
+
+		fx.mockOrderProvider.EXPECT().
+			FindReturnedOrdersWithPagination(gomock.Any(), limit, int32(0), domain.ReturnConditionDamaged).
+			Return(orders, nil)
+
+		// act
+		result, err := fx.module.ListReturnOrders(ctx, page, limit, "damaged")
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(result, 1)
+		fx.assert.Equal("damaged", result[0].ReturnCondition)
+	})
+
+	t.Run("should fail on unknown condition", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		result, err := fx.module.ListReturnOrders(ctx, page, limit, "scratched")
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrReturnConditionUnknown)
+		fx.assert.Nil(result)
+	})
 }
 
-func TestModule_DeleteIssuedOrders(t *testing.T) {
+func TestModule_DeleteReturnedOrders(t *testing.T) {
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 	)
 
-	t.Run("should delete issued orders successfully", func(t *testing.T) {
+	t.Run("should delete returned orders successfully", func(t *testing.T) {
 		t.Parallel()
 
 		// arrange
@@ -756,18 +1269,79 @@ func TestModule_DeleteIssuedOrders(t *testing.T) {
 		var countDeletedOrders int64 = 5
 
 		fx.mockOrderDeleter.EXPECT().
-			DeleteRecipientOrders(ctx).
+			DeleteRecipientOrders(gomock.Any(), gomock.Any()).
 			Return(countDeletedOrders, nil).
 			Times(1)
+		fx.mockOrderProvider.EXPECT().
+			FindClientReturnsBefore(gomock.Any(), gomock.Any(), int64(0), cleanupBatchSize).
+			Return(nil, nil).
+			Times(1)
 
 		// act
-		count, err := fx.module.DeleteIssuedOrders(ctx)
+		count, err := fx.module.DeleteReturnedOrders(ctx)
 
 		// assert
 		fx.require.NoError(err)
 		fx.assert.EqualValues(countDeletedOrders, count)
 	})
 
+	t.Run("should delete orders older than retention period", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		var before time.Time
+
+		fx.mockOrderDeleter.EXPECT().
+			DeleteRecipientOrders(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, at time.Time) (int64, error) {
+				before = at
+				return 1, nil
+			})
+		fx.mockOrderProvider.EXPECT().
+			FindClientReturnsBefore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, nil)
+
+		// act
+		_, err := fx.module.DeleteReturnedOrders(ctx)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.WithinDuration(time.Now().Add(-testPolicies.ReturnWindow.Retention), before, time.Minute)
+	})
+
+	t.Run("should delete client returns whose return window has passed", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		var before time.Time
+
+		returnedAt := sql.NullTime{Time: time.Now().Add(-72 * time.Hour), Valid: true}
+		expired := &domain.Order{ID: 1, Status: domain.OrderStatusReturnedByClient, ReturnedAt: returnedAt}
+		electronics := &domain.Order{ID: 2, Status: domain.OrderStatusReturnedByClient, ReturnedAt: returnedAt, Category: "electronics"}
+
+		fx.mockOrderDeleter.EXPECT().DeleteRecipientOrders(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+		fx.mockOrderProvider.EXPECT().
+			FindClientReturnsBefore(gomock.Any(), gomock.Any(), int64(0), cleanupBatchSize).
+			DoAndReturn(func(_ context.Context, at time.Time, _ int64, _ int32) ([]*domain.Order, error) {
+				before = at
+				return []*domain.Order{expired, electronics}, nil
+			})
+		fx.mockOrderDeleter.EXPECT().
+			DeleteClientReturns(gomock.Any(), []int64{expired.ID}).
+			Return(int64(1), nil).
+			Times(1)
+
+		// act
+		count, err := fx.module.DeleteReturnedOrders(ctx)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.EqualValues(1, count)
+		fx.assert.WithinDuration(time.Now().Add(-domain.DefaultReturnWindow), before, time.Minute)
+	})
+
 	t.Run("should handle error from orderDeleter", func(t *testing.T) {
 		t.Parallel()
 
@@ -775,13 +1349,416 @@ func TestModule_DeleteIssuedOrders(t *testing.T) {
 		fx := newFixture(t)
 		var countDeletedOrders int64 = 0
 
-		fx.mockOrderDeleter.EXPECT().DeleteRecipientOrders(ctx).Return(countDeletedOrders, assert.AnError).Times(1)
+		fx.mockOrderDeleter.EXPECT().DeleteRecipientOrders(gomock.Any(), gomock.Any()).Return(countDeletedOrders, assert.AnError).Times(1)
 
 		// act
-		count, err := fx.module.DeleteIssuedOrders(ctx)
+		count, err := fx.module.DeleteReturnedOrders(ctx)
 
 		// assert
 		fx.require.ErrorIs(err, assert.AnError)
 		fx.assert.EqualValues(countDeletedOrders, count)
 	})
+
+	t.Run("should handle error when deleting client returns", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		returned := &domain.Order{
+			ID:         1,
+			Status:     domain.OrderStatusReturnedByClient,
+			ReturnedAt: sql.NullTime{Time: time.Now().Add(-72 * time.Hour), Valid: true},
+		}
+
+		fx.mockOrderDeleter.EXPECT().DeleteRecipientOrders(gomock.Any(), gomock.Any()).Return(int64(2), nil)
+		fx.mockOrderProvider.EXPECT().
+			FindClientReturnsBefore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*domain.Order{returned}, nil)
+		fx.mockOrderDeleter.EXPECT().DeleteClientReturns(gomock.Any(), gomock.Any()).Return(int64(0), assert.AnError)
+
+		// act
+		count, err := fx.module.DeleteReturnedOrders(ctx)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		fx.assert.EqualValues(2, count)
+	})
+}
+
+func TestModule_GetReturnManifest(t *testing.T) {
+	var (
+		ctx             = pickupPointContext()
+		courierID int64 = 3
+	)
+
+	t.Run("should build return manifest successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := []*domain.Order{
+			{
+				ID:           1,
+				CourierID:    sql.NullInt64{Int64: courierID, Valid: true},
+				Status:       domain.OrderStatusAccepted,
+				StorageUntil: time.Now().Add(-time.Hour),
+			},
+		}
+
+		fx.mockOrderProvider.EXPECT().
+			FindExpiredOrdersByCourierID(gomock.Any(), courierID, gomock.Any()).
+			Return(orders, nil).
+			Times(1)
+
+		// act
+		manifest, err := fx.module.GetReturnManifest(ctx, courierID)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(manifest, 1)
+		fx.assert.Equal(courierID, manifest[0].CourierID)
+	})
+	t.Run("should fail if error occurs while finding orders", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindExpiredOrdersByCourierID(gomock.Any(), courierID, gomock.Any()).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		// act
+		_, err := fx.module.GetReturnManifest(ctx, courierID)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
+
+func TestModule_ConfirmReturnManifest(t *testing.T) {
+	var (
+		ctx             = pickupPointContext()
+		courierID int64 = 3
+		orderIDs        = []int64{1, 2}
+	)
+
+	newOrders := func() []*domain.Order {
+		return []*domain.Order{
+			{
+				ID:           1,
+				CourierID:    sql.NullInt64{Int64: courierID, Valid: true},
+				Status:       domain.OrderStatusAccepted,
+				StorageUntil: time.Now().Add(-time.Hour),
+			},
+			{
+				ID:           2,
+				CourierID:    sql.NullInt64{Int64: courierID, Valid: true},
+				Status:       domain.OrderStatusExpired,
+				StorageUntil: time.Now().Add(-2 * time.Hour),
+			},
+		}
+	}
+
+	t.Run("should confirm return manifest successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(newOrders(), nil).Times(1)
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				fx.assert.Equal(domain.OrderStatusReturnedToCourier, order.Status)
+				return nil
+			}).
+			Times(len(orderIDs))
+		fx.mockOrderSaver.EXPECT().
+			CreateOrderEvent(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(len(orderIDs))
+		fx.mockOrderSaver.EXPECT().
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *domain.Notification) error {
+				fx.assert.Equal(domain.NotificationOrderReturnedToCourier, notification.Event)
+				return nil
+			}).
+			Times(len(orderIDs))
+
+		// act
+		err := fx.module.ConfirmReturnManifest(ctx, courierID, orderIDs)
+
+		// assert
+		fx.require.NoError(err)
+	})
+	t.Run("should return error when some order is missing", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(newOrders()[:1], nil).Times(1)
+
+		// act
+		err := fx.module.ConfirmReturnManifest(ctx, courierID, orderIDs)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+	})
+	t.Run("should return error when order belongs to another courier", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := newOrders()
+		orders[1].CourierID.Int64 = courierID + 1
+
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		fx.mockOrderSaver.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		// act
+		err := fx.module.ConfirmReturnManifest(ctx, courierID, orderIDs)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderCourierMismatch)
+	})
+	t.Run("should return error when order is not expired", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := newOrders()
+		orders[0].StorageUntil = time.Now().Add(time.Hour)
+
+		fx.expectTransaction(repeatableRead)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), orderIDs).Return(orders, nil).Times(1)
+
+		// act
+		err := fx.module.ConfirmReturnManifest(ctx, courierID, orderIDs)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotExpiredOrIssued)
+	})
+}
+
+func TestModule_ListCourierReturns(t *testing.T) {
+	var (
+		ctx  = pickupPointContext()
+		to   = time.Now()
+		from = to.Add(-24 * time.Hour)
+	)
+
+	t.Run("should list courier returns successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := []*domain.Order{
+			{
+				ID:                  1,
+				Status:              domain.OrderStatusReturnedToCourier,
+				ReturnedToCourierAt: sql.NullTime{Time: to.Add(-time.Hour), Valid: true},
+			},
+		}
+
+		fx.mockOrderProvider.EXPECT().
+			FindOrdersReturnedToCourier(gomock.Any(), from.UTC(), to.UTC()).
+			Return(orders, nil).
+			Times(1)
+
+		// act
+		result, err := fx.module.ListCourierReturns(ctx, from, to)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(result, 1)
+		fx.assert.Equal(string(domain.OrderStatusReturnedToCourier), result[0].Status)
+		fx.assert.Equal(orders[0].ReturnedToCourierAt.Time, result[0].ReturnedToCourierAt)
+	})
+	t.Run("should return error when date range is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.ListCourierReturns(ctx, to, from)
+
+		// assert
+		fx.require.ErrorIs(err, ErrInvalidDateRange)
+	})
+	t.Run("should fail if error occurs while finding orders", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindOrdersReturnedToCourier(gomock.Any(), from.UTC(), to.UTC()).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		// act
+		_, err := fx.module.ListCourierReturns(ctx, from, to)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
+
+func TestModule_GetOrder(t *testing.T) {
+	var (
+		ctx           = pickupPointContext()
+		orderID int64 = 1
+	)
+
+	newStoredOrder := func() *domain.Order {
+		return &domain.Order{
+			ID:            orderID,
+			RecipientID:   2,
+			PickupPointID: testPickupPointID,
+			Weight:        4,
+			Cost:          10000,
+			PackageCost:   500,
+			Currency:      "RUB",
+			Status:        domain.OrderStatusIssued,
+			StorageUntil:  time.Now().Add(time.Hour),
+			IssuedAt:      sql.NullTime{Time: time.Now(), Valid: true},
+			Hash:          "digest",
+			Version:       3,
+		}
+	}
+
+	t.Run("should read order from db and cache it", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(newStoredOrder(), nil).Times(1)
+
+		// act
+		order, err := fx.module.GetOrder(ctx, orderID)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(int64(10500), order.TotalCost)
+		fx.assert.False(order.IssuedAt.IsZero())
+		fx.assert.Equal("digest", order.Hash)
+		fx.assert.Equal(int64(3), order.Version)
+	})
+	t.Run("should serve order from cache", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		cache := mock_module.NewMockCache(fx.ctrl)
+		cache.EXPECT().Get(gomock.Any(), domain.NewOrderKey(testPickupPointID, orderID)).Return(newStoredOrder(), true)
+		fx.module.cache = cache
+
+		// act
+		order, err := fx.module.GetOrder(ctx, orderID)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(orderID, order.OrderID)
+	})
+	t.Run("should return error when order not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(nil, storage.ErrOrderNotFound).Times(1)
+
+		// act
+		_, err := fx.module.GetOrder(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+	})
+}
+
+func TestModule_GetOrderHistory(t *testing.T) {
+	var (
+		ctx           = pickupPointContext()
+		orderID int64 = 1
+	)
+
+	t.Run("should return order history successfully", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		events := []*domain.OrderEvent{
+			{ID: 1, OrderID: orderID, NewStatus: domain.OrderStatusAccepted, Operation: domain.OperationAcceptOrderCourier},
+			{
+				ID:        2,
+				OrderID:   orderID,
+				OldStatus: domain.OrderStatusAccepted,
+				NewStatus: domain.OrderStatusIssued,
+				Operation: domain.OperationIssueOrderClient,
+			},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderEventsByOrderID(gomock.Any(), orderID).Return(events, nil).Times(1)
+
+		// act
+		history, err := fx.module.GetOrderHistory(ctx, orderID)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(history, 2)
+		fx.assert.Equal("", history[0].OldStatus)
+		fx.assert.Equal(string(domain.OrderStatusIssued), history[1].NewStatus)
+		fx.assert.Equal(domain.OperationIssueOrderClient, history[1].Operation)
+	})
+	t.Run("should return error when history is empty", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindOrderEventsByOrderID(gomock.Any(), orderID).
+			Return(nil, storage.ErrOrderNotFound).
+			Times(1)
+
+		// act
+		history, err := fx.module.GetOrderHistory(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+		fx.assert.Nil(history)
+	})
+	t.Run("should fail if occurs db error", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindOrderEventsByOrderID(gomock.Any(), orderID).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		// act
+		_, err := fx.module.GetOrderHistory(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
 }
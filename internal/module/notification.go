@@ -0,0 +1,72 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// NotifyExpiringOrders ставит в outbox предупреждения получателям заказов,
+// срок хранения которых заканчивается в течение суток после at.
+// Повторный запуск не дублирует уведомления. Возвращает количество найденных заказов.
+func (m *Module) NotifyExpiringOrders(ctx context.Context, at time.Time) (int, error) {
+	const op = "module.Module.NotifyExpiringOrders"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("at", at)
+
+	if _, err := pickupPointID(ctx); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var count int
+
+	err := m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		orders, err := m.orderProvider.FindOrdersExpiringBetween(ctxTX, at.UTC(), at.Add(domain.ExpiryNoticePeriod).UTC())
+		if err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			err := m.saveNotification(ctxTX, domain.NotificationOrderExpiresTomorrow, order)
+			if err != nil {
+				return err
+			}
+		}
+
+		count = len(orders)
+
+		return nil
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "notify_expiring_orders_error", "error", err.Error())
+
+		m.logger.Error("error while notifying about expiring orders", zap.Error(err))
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	span.LogKV("event", "expiring_orders_notified", "orders_count", count)
+
+	return count, nil
+}
+
+// saveNotification ставит уведомление получателя в outbox.
+// Вызывается внутри той же транзакции, что и изменение заказа; доставка выполняется отдельно.
+func (m *Module) saveNotification(ctx context.Context, event domain.NotificationEvent, order *domain.Order) error {
+	return m.orderSaver.CreateNotification(ctx, domain.NewNotification(event, order))
+}
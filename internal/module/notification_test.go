@@ -0,0 +1,89 @@
+package module
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/testutils"
+)
+
+func TestModule_NotifyExpiringOrders(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+		at  = time.Date(2024, 8, 4, 10, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("should save notification for every expiring order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := []*domain.Order{
+			{ID: 1, RecipientID: 7, Status: domain.OrderStatusAccepted, StorageUntil: at.Add(2 * time.Hour)},
+			{ID: 2, RecipientID: 8, Status: domain.OrderStatusAccepted, StorageUntil: at.Add(20 * time.Hour)},
+		}
+
+		gomock.InOrder(
+			fx.expectTransaction(readCommitted),
+			fx.mockOrderProvider.EXPECT().
+				FindOrdersExpiringBetween(gomock.Any(), at, at.Add(domain.ExpiryNoticePeriod)).
+				Return(orders, nil).
+				Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateNotification(gomock.Any(), testutils.NotificationEq(1, domain.NotificationOrderExpiresTomorrow)).
+				Return(nil).
+				Times(1),
+			fx.mockOrderSaver.EXPECT().
+				CreateNotification(gomock.Any(), testutils.NotificationEq(2, domain.NotificationOrderExpiresTomorrow)).
+				DoAndReturn(func(_ context.Context, notification *domain.Notification) error {
+					fx.assert.Equal(int64(8), notification.RecipientID)
+					fx.assert.Equal(orders[1].StorageUntil, notification.StorageUntil)
+					return nil
+				}).
+				Times(1),
+		)
+
+		// act
+		count, err := fx.module.NotifyExpiringOrders(ctx, at)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(2, count)
+	})
+	t.Run("should return error when orders are not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderProvider.EXPECT().
+			FindOrdersExpiringBetween(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		// act
+		count, err := fx.module.NotifyExpiringOrders(ctx, at)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		fx.assert.Zero(count)
+	})
+	t.Run("should require pickup point", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.NotifyExpiringOrders(context.Background(), at)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPickupPointRequired)
+	})
+}
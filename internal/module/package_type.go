@@ -0,0 +1,162 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// LoadPackageTypes загружает типы упаковки из БД в реестр, из которого читает domain.NewPackageType.
+// Вызывается при старте сервиса.
+func (m *Module) LoadPackageTypes(ctx context.Context) error {
+	const op = "module.Module.LoadPackageTypes"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	packageTypes, err := m.orderProvider.FindPackageTypes(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_package_types_error", "error", err.Error())
+
+		m.logger.Error("error while loading package types", zap.Error(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	domain.PackageTypes().Load(packageTypes)
+
+	span.LogKV("event", "package_types_loaded", "count", len(packageTypes))
+
+	return nil
+}
+
+// ListPackageTypes возвращает все типы упаковки, включая деактивированные
+func (m *Module) ListPackageTypes(ctx context.Context) ([]*dto.PackageType, error) {
+	const op = "module.Module.ListPackageTypes"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	packageTypes, err := m.orderProvider.FindPackageTypes(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_package_types_error", "error", err.Error())
+
+		m.logger.Error("error while listing package types", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*dto.PackageType, 0, len(packageTypes))
+	for _, packageType := range packageTypes {
+		result = append(result, domain.ToPackageTypeDTO(packageType))
+	}
+
+	span.LogKV("event", "package_types_listed", "count", len(result))
+
+	return result, nil
+}
+
+// CreatePackageType добавляет новый тип упаковки и сразу делает его доступным для приема заказов
+func (m *Module) CreatePackageType(ctx context.Context, packageType *dto.PackageType) (*dto.PackageType, error) {
+	const op = "module.Module.CreatePackageType"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("package_type", packageType.Name)
+
+	cost := domain.Money{Amount: packageType.Cost, Currency: packageType.Currency}
+	limits := domain.DimensionLimits{
+		MaxLength:         packageType.MaxLength,
+		MaxWidth:          packageType.MaxWidth,
+		MaxHeight:         packageType.MaxHeight,
+		VolumetricDivisor: packageType.VolumetricDivisor,
+	}
+
+	spec, err := domain.NewPackageTypeSpec(packageType.Name, packageType.MaxWeight, cost, limits)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "invalid_package_type", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = m.orderSaver.CreatePackageType(ctx, spec)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if errors.Is(err, storage.ErrPackageTypeExists) {
+			span.LogKV("event", "package_type_already_exists", "package_type", spec.Name)
+
+			return nil, fmt.Errorf("%s: %w", op, ErrPackageTypeExists)
+		}
+
+		span.LogKV("event", "create_package_type_error", "error", err.Error())
+
+		m.logger.Error("error while creating package type", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	domain.PackageTypes().Register(spec)
+
+	span.LogKV("event", "package_type_created", "package_type", spec.Name)
+
+	return domain.ToPackageTypeDTO(spec), nil
+}
+
+// DeactivatePackageType запрещает принимать заказы с указанным типом упаковки.
+// Уже принятые заказы с этим типом продолжают читаться.
+func (m *Module) DeactivatePackageType(ctx context.Context, name string) error {
+	const op = "module.Module.DeactivatePackageType"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("package_type", name)
+
+	err := m.orderSaver.DeactivatePackageType(ctx, name)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if errors.Is(err, storage.ErrPackageTypeNotFound) {
+			span.LogKV("event", "package_type_not_found", "package_type", name)
+
+			return fmt.Errorf("%s: %w", op, ErrPackageTypeNotFound)
+		}
+
+		span.LogKV("event", "deactivate_package_type_error", "error", err.Error())
+
+		m.logger.Error("error while deactivating package type", zap.Error(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	domain.PackageTypes().Deactivate(name)
+
+	span.LogKV("event", "package_type_deactivated", "package_type", name)
+
+	return nil
+}
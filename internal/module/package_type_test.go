@@ -0,0 +1,171 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+func TestModule_CreatePackageType(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	t.Run("should create package type and register it", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().
+			CreatePackageType(gomock.Any(), gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		// act
+		created, err := fx.module.CreatePackageType(ctx, &dto.PackageType{Name: "test-crate", MaxWeight: 50, Cost: 4000})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.True(created.Active)
+
+		packageType, err := domain.NewPackageType("test-crate")
+		fx.require.NoError(err)
+		fx.assert.Equal(domain.Money{Amount: 4000, Currency: "RUB"}, packageType.GetPackageCost())
+	})
+
+	t.Run("should return error when package type exists", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().
+			CreatePackageType(gomock.Any(), gomock.Any()).
+			Return(storage.ErrPackageTypeExists).
+			Times(1)
+
+		// act
+		_, err := fx.module.CreatePackageType(ctx, &dto.PackageType{Name: "box", MaxWeight: 30, Cost: 2000})
+
+		// assert
+		fx.require.ErrorIs(err, ErrPackageTypeExists)
+	})
+
+	t.Run("should reject invalid package type", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.CreatePackageType(ctx, &dto.PackageType{Name: "test-invalid", MaxWeight: -1})
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPackageTypeInvalid)
+	})
+}
+
+func TestModule_DeactivatePackageType(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	t.Run("should deactivate package type and keep old orders readable", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		spec, err := domain.NewPackageTypeSpec("test-bag", 5, domain.Money{Amount: 100}, domain.DimensionLimits{})
+		fx.require.NoError(err)
+		domain.PackageTypes().Register(spec)
+
+		fx.mockOrderSaver.EXPECT().
+			DeactivatePackageType(gomock.Any(), "test-bag").
+			Return(nil).
+			Times(1)
+
+		// act
+		err = fx.module.DeactivatePackageType(ctx, "test-bag")
+
+		// assert
+		fx.require.NoError(err)
+
+		_, err = domain.NewPackageType("test-bag")
+		fx.require.ErrorIs(err, domain.ErrPackageTypeInactive)
+
+		var scanned domain.OrderPackageType
+		fx.require.NoError(scanned.Scan("test-bag"))
+		fx.assert.Equal(domain.Money{Amount: 100, Currency: "RUB"}, scanned.GetPackageCost())
+	})
+
+	t.Run("should return error when package type not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().
+			DeactivatePackageType(gomock.Any(), "unknown").
+			Return(storage.ErrPackageTypeNotFound).
+			Times(1)
+
+		// act
+		err := fx.module.DeactivatePackageType(ctx, "unknown")
+
+		// assert
+		fx.require.ErrorIs(err, ErrPackageTypeNotFound)
+	})
+}
+
+func TestModule_ListPackageTypes(t *testing.T) {
+	var (
+		ctx = pickupPointContext()
+	)
+
+	t.Run("should list package types including deactivated", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindPackageTypes(gomock.Any()).
+			Return([]*domain.PackageType{
+				{Name: "box", MaxWeight: 30, Cost: 2000, Currency: "RUB", Active: true},
+				{Name: "crate", MaxWeight: 50, Cost: 4000, Currency: "RUB", Active: false},
+			}, nil).
+			Times(1)
+
+		// act
+		packageTypes, err := fx.module.ListPackageTypes(ctx)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(packageTypes, 2)
+		fx.assert.False(packageTypes[1].Active)
+	})
+
+	t.Run("should handle error from orderProvider", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().
+			FindPackageTypes(gomock.Any()).
+			Return(nil, assert.AnError).
+			Times(1)
+
+		// act
+		_, err := fx.module.ListPackageTypes(ctx)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
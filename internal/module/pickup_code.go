@@ -0,0 +1,122 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// RegeneratePickupCode выпускает новый код выдачи заказа и ставит его в outbox уведомлений получателю.
+// Код отправляется только в предпочитаемый канал получателя и вызывающему не возвращается.
+// Прежний код перестает действовать, а заказ, заблокированный после неверных попыток, снова можно выдать;
+// перевыпуск возможен не чаще раза в policies.PickupCode.Interval().
+func (m *Module) RegeneratePickupCode(ctx context.Context, orderID int64) error {
+	return m.retryOnConflict(ctx, []int64{orderID}, func() error {
+		return m.regeneratePickupCode(ctx, orderID)
+	})
+}
+
+// regeneratePickupCode выпускает новый код выдачи за одну попытку
+func (m *Module) regeneratePickupCode(ctx context.Context, orderID int64) error {
+	const op = "module.Module.RegeneratePickupCode"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_id", orderID)
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	order, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, orderID))
+	if !found {
+		order, err = m.orderProvider.FindOrderByID(ctx, orderID)
+		if err != nil {
+			span.SetTag("error", true)
+
+			if errors.Is(err, storage.ErrOrderNotFound) {
+				span.LogKV("event", "order_not_found", "order_id", orderID)
+
+				return fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+			}
+
+			span.LogKV("event", "find_order_error", "order_id", orderID, "error", err.Error())
+
+			m.logger.Error("error while finding order", zap.Error(err))
+
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		span.LogKV("event", "order_cached", "order_id", orderID)
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), order)
+	}
+
+	// заказ из кэша меняется только после успешной записи в БД
+	candidate := *order
+
+	code, err := candidate.RegeneratePickupCode(m.policies.PickupCode, time.Now().UTC())
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_code_not_regenerated", "order_id", orderID, "status", order.Status, "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	recipient, err := m.orderProvider.FindRecipientByID(ctx, order.RecipientID)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_recipient_error", "recipient_id", order.RecipientID, "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	if _, _, ok := recipient.Contact(); !ok {
+		span.SetTag("error", true)
+		span.LogKV("event", "recipient_unreachable", "recipient_id", order.RecipientID)
+
+		return fmt.Errorf("%s: %w", op, domain.ErrPickupCodeUndeliverable)
+	}
+
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		err := m.updateOrder(ctxTX, &candidate)
+		if err != nil {
+			return err
+		}
+
+		err = m.saveOrderEvent(ctxTX, &candidate, candidate.Status, domain.OperationRegeneratePickupCode, nil)
+		if err != nil {
+			return err
+		}
+
+		return m.orderSaver.CreateNotification(ctxTX, domain.NewPickupCodeNotification(&candidate, code))
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "update_order_error", "order_id", orderID, "error", err.Error())
+
+		m.logger.Error("error while regenerating pickup code with transaction", zap.Error(err))
+
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), &candidate)
+
+	span.LogKV("event", "pickup_code_regenerated", "order_id", orderID)
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package module
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+func TestModule_RegeneratePickupCode(t *testing.T) {
+	var (
+		ctx               = pickupPointContext()
+		orderID     int64 = 10
+		recipientID int64 = 2
+		recipient         = &domain.Recipient{ID: recipientID, Phone: "+79991234567", PreferredChannel: domain.ContactChannelSMS}
+	)
+
+	t.Run("should regenerate pickup code, unlock order and send code to recipient", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{
+			ID:                 orderID,
+			RecipientID:        recipientID,
+			Status:             domain.OrderStatusAccepted,
+			StorageUntil:       time.Now().Add(time.Hour),
+			PickupCodeHash:     "old",
+			PickupCodeAttempts: domain.DefaultPickupCodeMaxAttempts,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), recipientID).Return(recipient, nil)
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, updated *domain.Order) error {
+				fx.assert.Zero(updated.PickupCodeAttempts, "regeneration must unlock order")
+				fx.assert.True(updated.PickupCodeRegeneratedAt.Valid)
+				fx.assert.NotEqual("old", updated.PickupCodeHash)
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().
+			CreateOrderEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, event *domain.OrderEvent) error {
+				fx.assert.Equal(domain.OperationRegeneratePickupCode, event.Operation)
+				fx.assert.NotContains(string(event.Payload), "old")
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().
+			CreateNotification(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification *domain.Notification) error {
+				fx.assert.Equal(domain.NotificationPickupCodeRegenerated, notification.Event)
+				fx.assert.Equal(recipientID, notification.RecipientID)
+				fx.assert.Len(notification.PickupCode, domain.PickupCodeLength)
+				return nil
+			})
+
+		// act
+		err := fx.module.RegeneratePickupCode(ctx, orderID)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal("old", order.PickupCodeHash, "cached order must not change before commit")
+	})
+	t.Run("should not regenerate pickup code too often", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{
+			ID:                      orderID,
+			RecipientID:             recipientID,
+			Status:                  domain.OrderStatusAccepted,
+			StorageUntil:            time.Now().Add(time.Hour),
+			PickupCodeAttempts:      domain.DefaultPickupCodeMaxAttempts,
+			PickupCodeRegeneratedAt: sql.NullTime{Time: time.Now().UTC().Add(-time.Minute), Valid: true},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+
+		// act
+		err := fx.module.RegeneratePickupCode(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPickupCodeRegeneratedRecently)
+	})
+	t.Run("should not regenerate pickup code for recipient without contacts", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{ID: orderID, RecipientID: recipientID, Status: domain.OrderStatusAccepted, StorageUntil: time.Now().Add(time.Hour)}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), recipientID).Return(&domain.Recipient{ID: recipientID}, nil)
+
+		// act
+		err := fx.module.RegeneratePickupCode(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPickupCodeUndeliverable)
+	})
+	t.Run("should return error when order not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(nil, storage.ErrOrderNotFound)
+
+		// act
+		err := fx.module.RegeneratePickupCode(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+	})
+	t.Run("should not regenerate pickup code of issued order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{ID: orderID, Status: domain.OrderStatusIssued}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+
+		// act
+		err := fx.module.RegeneratePickupCode(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrPickupCodeNotAllowed)
+	})
+	t.Run("should fail if transactional query fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{ID: orderID, RecipientID: recipientID, Status: domain.OrderStatusAccepted, StorageUntil: time.Now().Add(time.Hour)}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), recipientID).Return(recipient, nil)
+		fx.mockTransactionManager.EXPECT().
+			RunTransactionalQuery(gomock.Any(), readCommitted, readWrite, gomock.Any()).
+			Return(assert.AnError)
+
+		// act
+		err := fx.module.RegeneratePickupCode(ctx, orderID)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
@@ -0,0 +1,237 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// CreateRecipient регистрирует получателя с контактами
+func (m *Module) CreateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error) {
+	const op = "module.Module.CreateRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("recipient_id", recipient.ID)
+
+	created, err := domain.NewRecipient(recipient)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "invalid_recipient", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = m.orderSaver.CreateRecipient(ctx, created)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "create_recipient_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	span.LogKV("event", "recipient_created", "recipient_id", created.ID)
+
+	return domain.ToRecipientDTO(created), nil
+}
+
+// GetRecipient возвращает получателя по идентификатору
+func (m *Module) GetRecipient(ctx context.Context, id int64) (*dto.Recipient, error) {
+	const op = "module.Module.GetRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("recipient_id", id)
+
+	recipient, err := m.orderProvider.FindRecipientByID(ctx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_recipient_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	return domain.ToRecipientDTO(recipient), nil
+}
+
+// ListRecipients возвращает страницу зарегистрированных получателей
+func (m *Module) ListRecipients(ctx context.Context, page, limit int32) ([]*dto.Recipient, error) {
+	const op = "module.Module.ListRecipients"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	offset := (page - 1) * limit
+
+	span.SetTag("page", page)
+	span.SetTag("limit", limit)
+
+	recipients, err := m.orderProvider.FindRecipients(ctx, limit, offset)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_recipients_error", "error", err.Error())
+
+		m.logger.Error("error while listing recipients", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]*dto.Recipient, 0, len(recipients))
+	for _, recipient := range recipients {
+		result = append(result, domain.ToRecipientDTO(recipient))
+	}
+
+	span.LogKV("event", "recipients_listed", "count", len(result))
+
+	return result, nil
+}
+
+// UpdateRecipient заменяет контакты получателя.
+// Так же регистрируется получатель, созданный при приемке заказа без контактов.
+func (m *Module) UpdateRecipient(ctx context.Context, recipient *dto.Recipient) (*dto.Recipient, error) {
+	const op = "module.Module.UpdateRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("recipient_id", recipient.ID)
+
+	existed, err := m.orderProvider.FindRecipientByID(ctx, recipient.ID)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_recipient_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	err = existed.SetContacts(recipient.Name, recipient.Phone, recipient.Email, recipient.PreferredChannel)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "invalid_recipient", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = m.orderSaver.UpdateRecipient(ctx, existed)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "update_recipient_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	span.LogKV("event", "recipient_updated", "recipient_id", existed.ID)
+
+	return domain.ToRecipientDTO(existed), nil
+}
+
+// DeleteRecipient удаляет получателя, у которого нет заказов
+func (m *Module) DeleteRecipient(ctx context.Context, id int64) error {
+	const op = "module.Module.DeleteRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	span.SetTag("recipient_id", id)
+
+	err := m.orderDeleter.DeleteRecipient(ctx, id)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "delete_recipient_error", "error", err.Error())
+
+		return fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	span.LogKV("event", "recipient_deleted", "recipient_id", id)
+
+	return nil
+}
+
+// ListOrdersByPhone возвращает заказы получателя, найденного по номеру телефона
+func (m *Module) ListOrdersByPhone(ctx context.Context, phone string, limit int32) ([]*dto.Order, error) {
+	const op = "module.Module.ListOrdersByPhone"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	normalized, err := domain.NormalizePhone(phone)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "invalid_phone", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	recipient, err := m.orderProvider.FindRecipientByPhone(ctx, normalized)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "find_recipient_error", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, recipientStorageError(err))
+	}
+
+	span.LogKV("event", "recipient_found", "recipient_id", recipient.ID)
+
+	orders, err := m.ListOrders(ctx, recipient.ID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return orders, nil
+}
+
+// checkRecipient проверяет, что получатель заказа зарегистрирован.
+// Если create == true, незарегистрированный получатель сохраняется без контактов.
+func (m *Module) checkRecipient(ctx context.Context, recipientID int64, create bool) error {
+	if create {
+		return m.orderSaver.EnsureRecipient(ctx, domain.NewUnregisteredRecipient(recipientID))
+	}
+
+	_, err := m.orderProvider.FindRecipientByID(ctx, recipientID)
+	if err != nil {
+		return recipientStorageError(err)
+	}
+
+	return nil
+}
+
+// recipientStorageError переводит ошибки хранилища получателей в ошибки модуля
+func recipientStorageError(err error) error {
+	switch {
+	case errors.Is(err, storage.ErrRecipientNotFound):
+		return ErrRecipientNotRegistered
+	case errors.Is(err, storage.ErrRecipientExists):
+		return ErrRecipientExists
+	case errors.Is(err, storage.ErrRecipientPhoneExists):
+		return ErrRecipientPhoneExists
+	case errors.Is(err, storage.ErrRecipientHasOrders):
+		return ErrRecipientHasOrders
+	default:
+		return err
+	}
+}
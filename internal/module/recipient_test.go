@@ -0,0 +1,185 @@
+package module
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+func TestModule_CreateRecipient(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("should create recipient with normalized phone", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().
+			CreateRecipient(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, recipient *domain.Recipient) error {
+				fx.assert.Equal("+79991234567", recipient.Phone)
+				return nil
+			})
+
+		// act
+		created, err := fx.module.CreateRecipient(ctx, &dto.Recipient{ID: 1, Name: "Иван", Phone: "8 (999) 123-45-67"})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal("sms", created.PreferredChannel)
+	})
+
+	t.Run("should return error when phone is taken", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderSaver.EXPECT().CreateRecipient(gomock.Any(), gomock.Any()).Return(storage.ErrRecipientPhoneExists)
+
+		// act
+		_, err := fx.module.CreateRecipient(ctx, &dto.Recipient{ID: 1, Name: "Иван", Phone: "+79991234567"})
+
+		// assert
+		fx.require.ErrorIs(err, ErrRecipientPhoneExists)
+	})
+
+	t.Run("should reject recipient without contacts", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.CreateRecipient(ctx, &dto.Recipient{ID: 1, Name: "Иван"})
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrRecipientContactRequired)
+	})
+}
+
+func TestModule_UpdateRecipient(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("should register recipient created on acceptance", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		existed := domain.NewUnregisteredRecipient(1)
+
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), int64(1)).Return(existed, nil)
+		fx.mockOrderSaver.EXPECT().UpdateRecipient(gomock.Any(), existed).Return(nil)
+
+		// act
+		updated, err := fx.module.UpdateRecipient(ctx, &dto.Recipient{ID: 1, Name: "Иван", Email: "Ivan@Example.com"})
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal("ivan@example.com", updated.Email)
+		fx.assert.Equal("email", updated.PreferredChannel)
+		fx.assert.Equal(existed.CreatedAt, updated.CreatedAt)
+	})
+
+	t.Run("should return error when recipient is not registered", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindRecipientByID(gomock.Any(), int64(1)).Return(nil, storage.ErrRecipientNotFound)
+
+		// act
+		_, err := fx.module.UpdateRecipient(ctx, &dto.Recipient{ID: 1, Name: "Иван", Phone: "+79991234567"})
+
+		// assert
+		fx.require.ErrorIs(err, ErrRecipientNotRegistered)
+	})
+}
+
+func TestModule_DeleteRecipient(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("should not delete recipient with orders", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderDeleter.EXPECT().DeleteRecipient(gomock.Any(), int64(1)).Return(storage.ErrRecipientHasOrders)
+
+		// act
+		err := fx.module.DeleteRecipient(ctx, 1)
+
+		// assert
+		fx.require.ErrorIs(err, ErrRecipientHasOrders)
+	})
+}
+
+func TestModule_ListOrdersByPhone(t *testing.T) {
+	var (
+		ctx         = pickupPointContext()
+		limit int32 = 5
+	)
+
+	t.Run("should list orders of recipient found by phone", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		orders := []*domain.Order{
+			{ID: 1, RecipientID: 7, Status: domain.OrderStatusAccepted},
+		}
+
+		fx.mockOrderProvider.EXPECT().FindRecipientByPhone(gomock.Any(), "+79991234567").Return(&domain.Recipient{ID: 7}, nil)
+		fx.mockOrderProvider.EXPECT().FindOrdersByRecipientID(gomock.Any(), int64(7)).Return(orders, nil)
+
+		// act
+		result, err := fx.module.ListOrdersByPhone(ctx, "8-999-123-45-67", limit)
+
+		// assert
+		fx.require.NoError(err)
+		fx.require.Len(result, 1)
+		fx.assert.Equal(int64(1), result[0].OrderID)
+	})
+
+	t.Run("should return error for unknown phone", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindRecipientByPhone(gomock.Any(), "+79991234567").Return(nil, storage.ErrRecipientNotFound)
+
+		// act
+		_, err := fx.module.ListOrdersByPhone(ctx, "+79991234567", limit)
+
+		// assert
+		fx.require.ErrorIs(err, ErrRecipientNotRegistered)
+	})
+
+	t.Run("should reject invalid phone", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		// act
+		_, err := fx.module.ListOrdersByPhone(ctx, "call me", limit)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrRecipientPhoneInvalid)
+	})
+}
@@ -0,0 +1,121 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/dto"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/metrics"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// ExtendStorage продлевает срок хранения заказа на days дней по правилам Policies.StorageExtension.
+// Каждое продление сохраняется в истории заказа.
+func (m *Module) ExtendStorage(ctx context.Context, orderID int64, days int) (*dto.Order, error) {
+	var extended *dto.Order
+
+	err := m.retryOnConflict(ctx, []int64{orderID}, func() (err error) {
+		extended, err = m.extendStorage(ctx, orderID, days)
+		return err
+	})
+
+	return extended, err
+}
+
+// extendStorage продлевает срок хранения за одну попытку
+func (m *Module) extendStorage(ctx context.Context, orderID int64, days int) (*dto.Order, error) {
+	const op = "module.Module.ExtendStorage"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_id", orderID)
+	span.SetTag("days", days)
+
+	start := time.Now()
+	defer func() { metrics.ObserveOperationDuration(op, time.Since(start)) }()
+
+	pvzID, err := pickupPointID(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	order, found := m.cache.Get(ctx, domain.NewOrderKey(pvzID, orderID))
+	if !found {
+		order, err = m.orderProvider.FindOrderByID(ctx, orderID)
+		if err != nil {
+			span.SetTag("error", true)
+
+			if errors.Is(err, storage.ErrOrderNotFound) {
+				span.LogKV("event", "order_not_found", "order_id", orderID)
+
+				return nil, fmt.Errorf("%s: %w", op, ErrOrderNotFound)
+			}
+
+			span.LogKV("event", "find_order_error", "order_id", orderID, "error", err.Error())
+
+			m.logger.Error("error while finding order", zap.Error(err))
+
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		span.LogKV("event", "order_cached", "order_id", orderID)
+		m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), order)
+	}
+
+	// заказ из кэша меняется только после успешной записи в БД
+	candidate := *order
+
+	now := time.Now()
+	from := candidate.StatusAt(now)
+
+	extension, err := candidate.ExtendStorage(m.policies.StorageExtension, days, now)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV(
+			"event", "storage_not_extendable",
+			"order_id", orderID,
+			"status", from,
+			"extension_count", order.ExtensionCount,
+			"error", err.Error(),
+		)
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = m.transactionManager.RunTransactionalQuery(ctx, readCommitted, readWrite, func(ctxTX context.Context) error {
+		err := m.updateOrder(ctxTX, &candidate)
+		if err != nil {
+			return err
+		}
+
+		return m.saveOrderEvent(ctxTX, &candidate, from, domain.OperationExtendStorage, extension)
+	})
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "update_order_error", "order_id", orderID, "error", err.Error())
+
+		m.logger.Error("error while extending order storage with transaction", zap.Error(err))
+
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	m.cache.Set(ctx, domain.NewOrderKey(pvzID, orderID), &candidate)
+
+	span.LogKV(
+		"event", "storage_extended",
+		"order_id", orderID,
+		"storage_until", extension.To,
+		"extension_count", extension.Count,
+	)
+
+	return m.toDTO(&candidate), nil
+}
@@ -0,0 +1,232 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+func TestModule_ExtendStorage(t *testing.T) {
+	var (
+		ctx           = pickupPointContext()
+		orderID int64 = 10
+	)
+
+	t.Run("should extend storage and record the extension", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		storageUntil := time.Now().Add(time.Hour).UTC()
+		order := &domain.Order{
+			ID:                   orderID,
+			RecipientID:          1,
+			Status:               domain.OrderStatusAccepted,
+			StorageUntil:         storageUntil,
+			OriginalStorageUntil: storageUntil,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+		fx.expectTransaction(readCommitted)
+
+		var saved *domain.Order
+		fx.mockOrderSaver.EXPECT().
+			UpdateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, order *domain.Order) error {
+				saved = order
+				return nil
+			})
+		fx.mockOrderSaver.EXPECT().
+			CreateOrderEvent(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, event *domain.OrderEvent) error {
+				var extension domain.StorageExtension
+				fx.require.NoError(json.Unmarshal(event.Payload, &extension))
+
+				fx.assert.Equal(domain.OperationExtendStorage, event.Operation)
+				fx.assert.Equal(3, extension.Days)
+				fx.assert.True(storageUntil.Equal(extension.From))
+				return nil
+			})
+
+		// act
+		extended, err := fx.module.ExtendStorage(ctx, orderID, 3)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(storageUntil.AddDate(0, 0, 3), extended.StorageUntil)
+		fx.assert.Equal(1, extended.ExtensionCount)
+		fx.require.NotNil(saved)
+		fx.assert.Equal(domain.IntegrityStatusOK, saved.CheckIntegrity(testIntegrityKey), "digest must be recomputed on update")
+		fx.assert.Zero(order.ExtensionCount, "cached order changes only after commit")
+	})
+	t.Run("should retry with fresh order when order was changed concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		storageUntil := time.Now().Add(time.Hour).UTC()
+		newOrder := func(version int64, extensionCount int) *domain.Order {
+			return &domain.Order{
+				ID:                   orderID,
+				Status:               domain.OrderStatusAccepted,
+				StorageUntil:         storageUntil,
+				OriginalStorageUntil: storageUntil,
+				ExtensionCount:       extensionCount,
+				Version:              version,
+			}
+		}
+		fresh := newOrder(2, 1)
+
+		gomock.InOrder(
+			fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(newOrder(1, 0), nil),
+			fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{orderID}).Return([]*domain.Order{fresh}, nil),
+			fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(fresh, nil),
+		)
+		fx.expectTransaction(readCommitted).Times(2)
+		gomock.InOrder(
+			fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(storage.ErrOrderVersionConflict),
+			fx.mockOrderSaver.EXPECT().
+				UpdateOrder(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, order *domain.Order) error {
+					fx.assert.Equal(fresh.Version, order.Version)
+					return nil
+				}),
+		)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(nil)
+
+		// act
+		extended, err := fx.module.ExtendStorage(ctx, orderID, 3)
+
+		// assert
+		fx.require.NoError(err)
+		fx.assert.Equal(2, extended.ExtensionCount)
+	})
+	t.Run("should return conflict when order was changed again after retry", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		storageUntil := time.Now().Add(time.Hour).UTC()
+		newOrder := func() *domain.Order {
+			return &domain.Order{
+				ID:                   orderID,
+				Status:               domain.OrderStatusAccepted,
+				StorageUntil:         storageUntil,
+				OriginalStorageUntil: storageUntil,
+			}
+		}
+
+		fx.mockOrderProvider.EXPECT().
+			FindOrderByID(gomock.Any(), orderID).
+			DoAndReturn(func(context.Context, int64) (*domain.Order, error) { return newOrder(), nil }).
+			Times(2)
+		fx.mockOrderProvider.EXPECT().FindOrderByIDs(gomock.Any(), []int64{orderID}).Return([]*domain.Order{newOrder()}, nil)
+		fx.expectTransaction(readCommitted).Times(2)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(storage.ErrOrderVersionConflict).Times(2)
+
+		// act
+		_, err := fx.module.ExtendStorage(ctx, orderID, 3)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderConflict)
+	})
+	t.Run("should keep cached order unchanged when transaction fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		storageUntil := time.Now().Add(time.Hour).UTC()
+		cached := &domain.Order{
+			ID:                   orderID,
+			Status:               domain.OrderStatusAccepted,
+			StorageUntil:         storageUntil,
+			OriginalStorageUntil: storageUntil,
+		}
+
+		fx.expectCachedOrder(cached)
+		fx.expectTransaction(readCommitted)
+		fx.mockOrderSaver.EXPECT().UpdateOrder(gomock.Any(), gomock.Any()).Return(nil)
+		fx.mockOrderSaver.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+		// act
+		_, err := fx.module.ExtendStorage(ctx, orderID, 3)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+		fx.assert.True(storageUntil.Equal(cached.StorageUntil))
+		fx.assert.Zero(cached.ExtensionCount)
+	})
+	t.Run("should return error when order not found", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(nil, storage.ErrOrderNotFound)
+
+		// act
+		_, err := fx.module.ExtendStorage(ctx, orderID, 3)
+
+		// assert
+		fx.require.ErrorIs(err, ErrOrderNotFound)
+	})
+	t.Run("should not extend storage of issued order", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{ID: orderID, Status: domain.OrderStatusIssued, StorageUntil: time.Now().Add(time.Hour)}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+
+		// act
+		_, err := fx.module.ExtendStorage(ctx, orderID, 3)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrExtensionNotAllowed)
+	})
+	t.Run("should return error when extension limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{
+			ID:             orderID,
+			Status:         domain.OrderStatusAccepted,
+			StorageUntil:   time.Now().Add(time.Hour),
+			ExtensionCount: 2,
+		}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+
+		// act
+		_, err := fx.module.ExtendStorage(ctx, orderID, 1)
+
+		// assert
+		fx.require.ErrorIs(err, domain.ErrExtensionCountLimit)
+	})
+	t.Run("should fail if transaction fails", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		order := &domain.Order{ID: orderID, Status: domain.OrderStatusAccepted, StorageUntil: time.Now().Add(time.Hour)}
+
+		fx.mockOrderProvider.EXPECT().FindOrderByID(gomock.Any(), orderID).Return(order, nil)
+		fx.mockTransactionManager.EXPECT().
+			RunTransactionalQuery(gomock.Any(), readCommitted, readWrite, gomock.Any()).
+			Return(assert.AnError)
+
+		// act
+		_, err := fx.module.ExtendStorage(ctx, orderID, 1)
+
+		// assert
+		fx.require.ErrorIs(err, assert.AnError)
+	})
+}
@@ -0,0 +1,180 @@
+//go:generate mockgen -source ./dispatcher.go -destination=./mocks/dispatcher.go -package=mock_notifier
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// Значения по умолчанию для незаданных настроек рассылки
+const (
+	defaultInterval     = 10 * time.Second
+	defaultBatchSize    = 100
+	defaultMaxRetries   = 5
+	defaultClaimTimeout = 10 * time.Minute
+)
+
+type Outbox interface {
+	ClaimPendingNotifications(ctx context.Context, at time.Time, lease time.Duration, limit int32, maxRetries int) ([]*domain.Notification, error)
+	MarkNotificationProcessed(ctx context.Context, id uuid.UUID) error
+	IncrementNotificationRetry(ctx context.Context, id uuid.UUID) error
+	FindRecipientByID(ctx context.Context, id int64) (*domain.Recipient, error)
+}
+
+// Dispatcher доставляет уведомления из outbox получателям.
+// Неудачная доставка не влияет на операции с заказами: уведомление остается в outbox
+// и повторяется, пока не исчерпаны попытки.
+type Dispatcher struct {
+	outbox       Outbox
+	notifier     Notifier
+	templates    Templates
+	interval     time.Duration
+	batchSize    int32
+	maxRetries   int
+	claimTimeout time.Duration
+	logger       *zap.Logger
+}
+
+func NewDispatcher(
+	outbox Outbox,
+	notifier Notifier,
+	templates Templates,
+	cfg config.NotifierConfig,
+	logger *zap.Logger,
+) *Dispatcher {
+	dispatcher := &Dispatcher{
+		outbox:       outbox,
+		notifier:     notifier,
+		templates:    templates,
+		interval:     cfg.Interval,
+		batchSize:    cfg.BatchSize,
+		maxRetries:   cfg.MaxRetries,
+		claimTimeout: cfg.ClaimTimeout,
+		logger:       logger,
+	}
+
+	if dispatcher.interval <= 0 {
+		dispatcher.interval = defaultInterval
+	}
+
+	if dispatcher.batchSize <= 0 {
+		dispatcher.batchSize = defaultBatchSize
+	}
+
+	if dispatcher.maxRetries <= 0 {
+		dispatcher.maxRetries = defaultMaxRetries
+	}
+
+	if dispatcher.claimTimeout <= 0 {
+		dispatcher.claimTimeout = defaultClaimTimeout
+	}
+
+	return dispatcher
+}
+
+// Run доставляет уведомления с заданным интервалом, пока не отменен контекст
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := d.DispatchPending(ctx)
+			if err != nil {
+				d.logger.Error("error while dispatching notifications", zap.Error(err))
+				continue
+			}
+
+			if sent > 0 {
+				d.logger.Info("notifications dispatched", zap.Int("count", sent))
+			}
+		}
+	}
+}
+
+// DispatchPending доставляет одну пачку уведомлений из outbox и возвращает количество доставленных.
+// Пачка закрепляется за рассыльщиком на claimTimeout, а доставка идет вне транзакции:
+// каждое уведомление отмечается отдельно сразу после отправки, и сетевые задержки не держат блокировки в БД.
+// Уведомления получателям без контактов отмечаются обработанными без отправки.
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	const op = "notifier.Dispatcher.DispatchPending"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	notifications, err := d.outbox.ClaimPendingNotifications(ctx, time.Now().UTC(), d.claimTimeout, d.batchSize, d.maxRetries)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "claim_error", "error", err.Error())
+
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var sent int
+
+	for _, notification := range notifications {
+		delivered, err := d.dispatch(ctx, notification)
+		if err != nil {
+			// уведомление останется закрепленным до истечения claimTimeout и будет доставлено повторно
+			span.LogKV("event", "outbox_error", "notification_id", notification.ID.String(), "error", err.Error())
+
+			d.logger.Error("error while updating notification in outbox",
+				zap.String("notification_id", notification.ID.String()), zap.Error(err))
+		}
+
+		if delivered {
+			sent++
+		}
+	}
+
+	span.LogKV("event", "notifications_dispatched", "count", sent)
+
+	return sent, nil
+}
+
+// dispatch отправляет одно уведомление и сообщает, было ли оно доставлено.
+// Ошибка возвращается только при сбое outbox; ошибка доставки учитывается как неудачная попытка.
+func (d *Dispatcher) dispatch(ctx context.Context, notification *domain.Notification) (bool, error) {
+	recipient, err := d.outbox.FindRecipientByID(ctx, notification.RecipientID)
+	if err != nil {
+		if errors.Is(err, storage.ErrRecipientNotFound) {
+			return false, d.outbox.MarkNotificationProcessed(ctx, notification.ID)
+		}
+
+		return false, err
+	}
+
+	message, ok, err := d.templates.Render(notification, recipient)
+	if err != nil {
+		d.logger.Error("error while rendering notification",
+			zap.String("notification_id", notification.ID.String()), zap.Error(err))
+
+		return false, d.outbox.IncrementNotificationRetry(ctx, notification.ID)
+	}
+
+	if !ok {
+		return false, d.outbox.MarkNotificationProcessed(ctx, notification.ID)
+	}
+
+	err = d.notifier.Notify(ctx, message)
+	if err != nil {
+		d.logger.Warn("notification delivery failed",
+			zap.String("notification_id", notification.ID.String()), zap.Error(err))
+
+		return false, d.outbox.IncrementNotificationRetry(ctx, notification.ID)
+	}
+
+	return true, d.outbox.MarkNotificationProcessed(ctx, notification.ID)
+}
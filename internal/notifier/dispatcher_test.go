@@ -0,0 +1,181 @@
+package notifier
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	mock_notifier "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/notifier/mocks"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+	"go.uber.org/zap"
+)
+
+// fakeNotifier запоминает отправленные сообщения и возвращает заданную ошибку
+type fakeNotifier struct {
+	err      error
+	messages []*Message
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, message *Message) error {
+	if n.err != nil {
+		return n.err
+	}
+
+	n.messages = append(n.messages, message)
+
+	return nil
+}
+
+type fixture struct {
+	mockOutbox *mock_notifier.MockOutbox
+	notifier   *fakeNotifier
+	dispatcher *Dispatcher
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctrl := gomock.NewController(t)
+
+	mockOutbox := mock_notifier.NewMockOutbox(ctrl)
+
+	templates, err := NewTemplates(nil)
+	require.NoError(t, err)
+
+	notifier := &fakeNotifier{}
+
+	return &fixture{
+		mockOutbox: mockOutbox,
+		notifier:   notifier,
+		dispatcher: NewDispatcher(mockOutbox, notifier, templates,
+			config.NotifierConfig{BatchSize: 10, MaxRetries: 3}, zap.NewNop()),
+	}
+}
+
+func newTestNotification(recipientID int64) *domain.Notification {
+	return &domain.Notification{
+		ID:           uuid.New(),
+		Event:        domain.NotificationOrderArrived,
+		OrderID:      10,
+		RecipientID:  recipientID,
+		StorageUntil: time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestDispatcher_DispatchPending(t *testing.T) {
+	var (
+		ctx = context.Background()
+	)
+
+	t.Run("should deliver notification and mark it processed", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		notification := newTestNotification(2)
+
+		gomock.InOrder(
+			fx.mockOutbox.EXPECT().ClaimPendingNotifications(gomock.Any(), gomock.Any(), defaultClaimTimeout, int32(10), 3).
+				Return([]*domain.Notification{notification}, nil),
+			fx.mockOutbox.EXPECT().FindRecipientByID(gomock.Any(), int64(2)).
+				Return(&domain.Recipient{ID: 2, Phone: "+79991234567", PreferredChannel: domain.ContactChannelSMS}, nil),
+			fx.mockOutbox.EXPECT().MarkNotificationProcessed(gomock.Any(), notification.ID).Return(nil),
+		)
+
+		// act
+		sent, err := fx.dispatcher.DispatchPending(ctx)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+		require.Len(t, fx.notifier.messages, 1)
+		assert.Equal(t, "sms", fx.notifier.messages[0].Channel)
+		assert.Equal(t, "+79991234567", fx.notifier.messages[0].Address)
+	})
+	t.Run("should count failed delivery as retry", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		fx.notifier.err = assert.AnError
+		notification := newTestNotification(2)
+
+		fx.mockOutbox.EXPECT().ClaimPendingNotifications(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*domain.Notification{notification}, nil)
+		fx.mockOutbox.EXPECT().FindRecipientByID(gomock.Any(), int64(2)).
+			Return(&domain.Recipient{ID: 2, Email: "ivan@example.com", PreferredChannel: domain.ContactChannelEmail}, nil)
+		fx.mockOutbox.EXPECT().IncrementNotificationRetry(gomock.Any(), notification.ID).Return(nil)
+
+		// act
+		sent, err := fx.dispatcher.DispatchPending(ctx)
+
+		// assert
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+	})
+	t.Run("should skip recipient without contacts", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		withoutContacts := newTestNotification(2)
+		unknown := newTestNotification(3)
+
+		fx.mockOutbox.EXPECT().ClaimPendingNotifications(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*domain.Notification{withoutContacts, unknown}, nil)
+		fx.mockOutbox.EXPECT().FindRecipientByID(gomock.Any(), int64(2)).Return(&domain.Recipient{ID: 2}, nil)
+		fx.mockOutbox.EXPECT().FindRecipientByID(gomock.Any(), int64(3)).Return(nil, storage.ErrRecipientNotFound)
+		fx.mockOutbox.EXPECT().MarkNotificationProcessed(gomock.Any(), withoutContacts.ID).Return(nil)
+		fx.mockOutbox.EXPECT().MarkNotificationProcessed(gomock.Any(), unknown.ID).Return(nil)
+
+		// act
+		sent, err := fx.dispatcher.DispatchPending(ctx)
+
+		// assert
+		require.NoError(t, err)
+		assert.Zero(t, sent)
+		assert.Empty(t, fx.notifier.messages)
+	})
+	t.Run("should mark each notification separately when outbox fails for one of them", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+		first := newTestNotification(2)
+		second := newTestNotification(2)
+		recipient := &domain.Recipient{ID: 2, Phone: "+79991234567", PreferredChannel: domain.ContactChannelSMS}
+
+		fx.mockOutbox.EXPECT().ClaimPendingNotifications(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]*domain.Notification{first, second}, nil)
+		fx.mockOutbox.EXPECT().FindRecipientByID(gomock.Any(), int64(2)).Return(recipient, nil).Times(2)
+		fx.mockOutbox.EXPECT().MarkNotificationProcessed(gomock.Any(), first.ID).Return(assert.AnError)
+		fx.mockOutbox.EXPECT().MarkNotificationProcessed(gomock.Any(), second.ID).Return(nil)
+
+		// act
+		sent, err := fx.dispatcher.DispatchPending(ctx)
+
+		// assert
+		require.NoError(t, err)
+		assert.Equal(t, 2, sent)
+		assert.Len(t, fx.notifier.messages, 2)
+	})
+	t.Run("should return error when outbox is unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		// arrange
+		fx := newFixture(t)
+
+		fx.mockOutbox.EXPECT().ClaimPendingNotifications(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, assert.AnError)
+
+		// act
+		_, err := fx.dispatcher.DispatchPending(ctx)
+
+		// assert
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
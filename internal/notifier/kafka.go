@@ -0,0 +1,44 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	infrakafka "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/infrastructure/kafka"
+)
+
+// KafkaNotifier публикует уведомления в топик Kafka; доставкой получателю занимается потребитель топика
+type KafkaNotifier struct {
+	producer *infrakafka.Producer
+	topic    string
+}
+
+func NewKafkaNotifier(producer *infrakafka.Producer, topic string) *KafkaNotifier {
+	return &KafkaNotifier{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+// Notify публикует уведомление; ключом сообщения служит идентификатор уведомления
+func (n *KafkaNotifier) Notify(_ context.Context, message *Message) error {
+	const op = "notifier.KafkaNotifier.Notify"
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, _, err = n.producer.SendSyncMessage(&sarama.ProducerMessage{
+		Topic: n.topic,
+		Key:   sarama.StringEncoder(message.ID.String()),
+		Value: sarama.ByteEncoder(payload),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
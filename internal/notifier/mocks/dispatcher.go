@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./dispatcher.go
+
+// Package mock_notifier is a generated GoMock package.
+package mock_notifier
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	domain "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+// MockOutbox is a mock of Outbox interface.
+type MockOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMockRecorder
+}
+
+// MockOutboxMockRecorder is the mock recorder for MockOutbox.
+type MockOutboxMockRecorder struct {
+	mock *MockOutbox
+}
+
+// NewMockOutbox creates a new mock instance.
+func NewMockOutbox(ctrl *gomock.Controller) *MockOutbox {
+	mock := &MockOutbox{ctrl: ctrl}
+	mock.recorder = &MockOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutbox) EXPECT() *MockOutboxMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingNotifications mocks base method.
+func (m *MockOutbox) ClaimPendingNotifications(ctx context.Context, at time.Time, lease time.Duration, limit int32, maxRetries int) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingNotifications", ctx, at, lease, limit, maxRetries)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingNotifications indicates an expected call of ClaimPendingNotifications.
+func (mr *MockOutboxMockRecorder) ClaimPendingNotifications(ctx, at, lease, limit, maxRetries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingNotifications", reflect.TypeOf((*MockOutbox)(nil).ClaimPendingNotifications), ctx, at, lease, limit, maxRetries)
+}
+
+// FindRecipientByID mocks base method.
+func (m *MockOutbox) FindRecipientByID(ctx context.Context, id int64) (*domain.Recipient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRecipientByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Recipient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRecipientByID indicates an expected call of FindRecipientByID.
+func (mr *MockOutboxMockRecorder) FindRecipientByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecipientByID", reflect.TypeOf((*MockOutbox)(nil).FindRecipientByID), ctx, id)
+}
+
+// IncrementNotificationRetry mocks base method.
+func (m *MockOutbox) IncrementNotificationRetry(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementNotificationRetry", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementNotificationRetry indicates an expected call of IncrementNotificationRetry.
+func (mr *MockOutboxMockRecorder) IncrementNotificationRetry(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementNotificationRetry", reflect.TypeOf((*MockOutbox)(nil).IncrementNotificationRetry), ctx, id)
+}
+
+// MarkNotificationProcessed mocks base method.
+func (m *MockOutbox) MarkNotificationProcessed(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationProcessed", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationProcessed indicates an expected call of MarkNotificationProcessed.
+func (mr *MockOutboxMockRecorder) MarkNotificationProcessed(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationProcessed", reflect.TypeOf((*MockOutbox)(nil).MarkNotificationProcessed), ctx, id)
+}
@@ -0,0 +1,61 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
+	infrakafka "gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/infrastructure/kafka"
+)
+
+var (
+	ErrBackendNotConfigured = errors.New("notifier backend is not configured")
+	ErrUnknownBackend       = errors.New("unknown notifier backend")
+)
+
+// Message уведомление, подготовленное к отправке получателю
+type Message struct {
+	ID          uuid.UUID `json:"id"`
+	Event       string    `json:"event"`
+	OrderID     int64     `json:"order_id"`
+	RecipientID int64     `json:"recipient_id"`
+	Channel     string    `json:"channel"`
+	Address     string    `json:"address"`
+	Text        string    `json:"text"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Notifier доставляет уведомления получателям.
+// Ошибка означает, что доставку нужно повторить позже.
+type Notifier interface {
+	Notify(ctx context.Context, message *Message) error
+}
+
+// New создает способ доставки уведомлений по настройкам.
+// producer используется только для доставки через Kafka.
+func New(cfg config.NotifierConfig, producer *infrakafka.Producer) (Notifier, error) {
+	const op = "notifier.New"
+
+	switch cfg.Backend {
+	case config.NotifierBackendStdout:
+		return NewStdoutNotifier(), nil
+	case config.NotifierBackendFile:
+		notifier, err := NewFileNotifier(cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		return notifier, nil
+	case config.NotifierBackendKafka:
+		return NewKafkaNotifier(producer, cfg.Topic), nil
+	case config.NotifierBackendWebhook:
+		return NewWebhookNotifier(cfg.WebhookURL, cfg.WebhookTimeout), nil
+	case config.NotifierBackendNone:
+		return nil, fmt.Errorf("%s: %w", op, ErrBackendNotConfigured)
+	default:
+		return nil, fmt.Errorf("%s: %w: %s", op, ErrUnknownBackend, cfg.Backend)
+	}
+}
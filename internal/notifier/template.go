@@ -0,0 +1,112 @@
+package notifier
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+var ErrUnknownEvent = errors.New("unknown notification event")
+
+// DefaultTemplates шаблоны сообщений по умолчанию для каждого события
+var DefaultTemplates = map[domain.NotificationEvent]string{
+	domain.NotificationOrderArrived: "Здравствуйте{{with .Name}}, {{.}}{{end}}! Заказ {{.OrderID}} прибыл в пункт выдачи. " +
+		"Заберите его до {{date .StorageUntil}}.{{with .PickupCode}} Код выдачи: {{.}}.{{end}}",
+	domain.NotificationOrderExpiresTomorrow: "Здравствуйте{{with .Name}}, {{.}}{{end}}! Срок хранения заказа {{.OrderID}} " +
+		"заканчивается {{date .StorageUntil}}. После этого заказ вернется продавцу.",
+	domain.NotificationOrderReturnedToCourier: "Здравствуйте{{with .Name}}, {{.}}{{end}}! Заказ {{.OrderID}} " +
+		"не был получен и возвращен продавцу.",
+	domain.NotificationPickupCodeRegenerated: "Здравствуйте{{with .Name}}, {{.}}{{end}}! Новый код выдачи заказа {{.OrderID}}: " +
+		"{{.PickupCode}}. Прежний код больше не действует.",
+}
+
+// Templates шаблоны сообщений по событиям заказа
+type Templates map[domain.NotificationEvent]*template.Template
+
+// templateData данные, доступные в шаблоне сообщения
+type templateData struct {
+	OrderID      int64
+	Name         string
+	StorageUntil time.Time
+	PickupCode   string
+}
+
+var templateFuncs = template.FuncMap{
+	"date": func(t time.Time) string {
+		return t.Format("02.01.2006")
+	},
+}
+
+// NewTemplates разбирает шаблоны сообщений.
+// overrides заменяет шаблоны по умолчанию для указанных событий.
+func NewTemplates(overrides map[string]string) (Templates, error) {
+	const op = "notifier.NewTemplates"
+
+	sources := make(map[domain.NotificationEvent]string, len(DefaultTemplates))
+	for event, text := range DefaultTemplates {
+		sources[event] = text
+	}
+
+	for event, text := range overrides {
+		if _, ok := DefaultTemplates[domain.NotificationEvent(event)]; !ok {
+			return nil, fmt.Errorf("%s: %w: %s", op, ErrUnknownEvent, event)
+		}
+
+		sources[domain.NotificationEvent(event)] = text
+	}
+
+	templates := make(Templates, len(sources))
+	for event, text := range sources {
+		tmpl, err := template.New(string(event)).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", op, event, err)
+		}
+
+		templates[event] = tmpl
+	}
+
+	return templates, nil
+}
+
+// Render подготавливает сообщение получателю о событии заказа.
+// ok == false, если получатель еще не оставил контактов и уведомлять некуда.
+func (t Templates) Render(notification *domain.Notification, recipient *domain.Recipient) (message *Message, ok bool, err error) {
+	const op = "notifier.Templates.Render"
+
+	channel, address, ok := recipient.Contact()
+	if !ok {
+		return nil, false, nil
+	}
+
+	tmpl, found := t[notification.Event]
+	if !found {
+		return nil, false, fmt.Errorf("%s: %w: %s", op, ErrUnknownEvent, notification.Event)
+	}
+
+	var text strings.Builder
+
+	err = tmpl.Execute(&text, templateData{
+		OrderID:      notification.OrderID,
+		Name:         recipient.Name,
+		StorageUntil: notification.StorageUntil,
+		PickupCode:   notification.PickupCode,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &Message{
+		ID:          notification.ID,
+		Event:       string(notification.Event),
+		OrderID:     notification.OrderID,
+		RecipientID: notification.RecipientID,
+		Channel:     string(channel),
+		Address:     address,
+		Text:        text.String(),
+		CreatedAt:   notification.CreatedAt,
+	}, true, nil
+}
@@ -0,0 +1,111 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+func TestTemplates_Render(t *testing.T) {
+	t.Parallel()
+
+	notification := &domain.Notification{
+		Event:        domain.NotificationOrderExpiresTomorrow,
+		OrderID:      10,
+		RecipientID:  2,
+		StorageUntil: time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC),
+	}
+	recipient := &domain.Recipient{
+		ID:               2,
+		Name:             "Иван",
+		Email:            "ivan@example.com",
+		PreferredChannel: domain.ContactChannelEmail,
+	}
+
+	t.Run("default template", func(t *testing.T) {
+		t.Parallel()
+
+		templates, err := NewTemplates(nil)
+		require.NoError(t, err)
+
+		message, ok, err := templates.Render(notification, recipient)
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "email", message.Channel)
+		assert.Equal(t, "ivan@example.com", message.Address)
+		assert.Equal(t, "order_expires_tomorrow", message.Event)
+		assert.Equal(t, "Здравствуйте, Иван! Срок хранения заказа 10 заканчивается 05.08.2024. "+
+			"После этого заказ вернется продавцу.", message.Text)
+	})
+	t.Run("override", func(t *testing.T) {
+		t.Parallel()
+
+		templates, err := NewTemplates(map[string]string{
+			"order_expires_tomorrow": "Заказ {{.OrderID}} ждет вас до {{date .StorageUntil}}",
+		})
+		require.NoError(t, err)
+
+		message, _, err := templates.Render(notification, recipient)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Заказ 10 ждет вас до 05.08.2024", message.Text)
+	})
+	t.Run("regenerated pickup code", func(t *testing.T) {
+		t.Parallel()
+
+		templates, err := NewTemplates(nil)
+		require.NoError(t, err)
+
+		message, _, err := templates.Render(&domain.Notification{
+			Event:      domain.NotificationPickupCodeRegenerated,
+			OrderID:    10,
+			PickupCode: "123456",
+		}, recipient)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Здравствуйте, Иван! Новый код выдачи заказа 10: 123456. Прежний код больше не действует.", message.Text)
+	})
+	t.Run("arrived order with pickup code", func(t *testing.T) {
+		t.Parallel()
+
+		templates, err := NewTemplates(nil)
+		require.NoError(t, err)
+
+		message, _, err := templates.Render(&domain.Notification{
+			Event:        domain.NotificationOrderArrived,
+			OrderID:      10,
+			StorageUntil: time.Date(2024, 8, 5, 12, 0, 0, 0, time.UTC),
+			PickupCode:   "123456",
+		}, recipient)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Здравствуйте, Иван! Заказ 10 прибыл в пункт выдачи. Заберите его до 05.08.2024. "+
+			"Код выдачи: 123456.", message.Text)
+	})
+	t.Run("recipient without contacts", func(t *testing.T) {
+		t.Parallel()
+
+		templates, err := NewTemplates(nil)
+		require.NoError(t, err)
+
+		message, ok, err := templates.Render(notification, &domain.Recipient{ID: 2})
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, message)
+	})
+}
+
+func TestNewTemplates_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewTemplates(map[string]string{"order_lost": "Заказ {{.OrderID}} потерян"})
+	require.ErrorIs(t, err, ErrUnknownEvent)
+
+	_, err = NewTemplates(map[string]string{"order_arrived": "Заказ {{.OrderID"})
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// defaultWebhookTimeout время ожидания ответа вебхука, если оно не задано в настройках
+const defaultWebhookTimeout = 5 * time.Second
+
+var ErrWebhookStatus = errors.New("webhook responded with unexpected status")
+
+// WebhookNotifier отправляет уведомления POST-запросом с JSON телом на адрес вебхука
+type WebhookNotifier struct {
+	client *http.Client
+	url    string
+}
+
+func NewWebhookNotifier(url string, timeout time.Duration) *WebhookNotifier {
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+
+	return &WebhookNotifier{
+		client: &http.Client{Timeout: timeout},
+		url:    url,
+	}
+}
+
+// Notify отправляет уведомление; любой ответ, кроме 2xx, считается неудачной доставкой
+func (n *WebhookNotifier) Notify(ctx context.Context, message *Message) error {
+	const op = "notifier.WebhookNotifier.Notify"
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Idempotency-Key", message.ID.String())
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s: %w: %d", op, ErrWebhookStatus, response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	message := &Message{ID: uuid.New(), Event: "order_arrived", OrderID: 10, Text: "Заказ 10 прибыл"}
+
+	t.Run("delivered", func(t *testing.T) {
+		t.Parallel()
+
+		var received Message
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, message.ID.String(), r.Header.Get("Idempotency-Key"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL, time.Second).Notify(context.Background(), message)
+
+		require.NoError(t, err)
+		assert.Equal(t, message.Text, received.Text)
+	})
+	t.Run("unexpected status", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(server.URL, time.Second).Notify(context.Background(), message)
+
+		require.ErrorIs(t, err, ErrWebhookStatus)
+	})
+}
+
+func TestWriterNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	notifier := NewWriterNotifier(&buf)
+
+	err := notifier.Notify(context.Background(), &Message{OrderID: 1, Text: "первое"})
+	require.NoError(t, err)
+	err = notifier.Notify(context.Background(), &Message{OrderID: 2, Text: "второе"})
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var second Message
+	require.NoError(t, json.Unmarshal(lines[1], &second))
+	assert.Equal(t, int64(2), second.OrderID)
+}
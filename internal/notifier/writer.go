@@ -0,0 +1,56 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterNotifier записывает уведомления построчно в JSON; используется для локальной отладки
+type WriterNotifier struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterNotifier(w io.Writer) *WriterNotifier {
+	return &WriterNotifier{w: w}
+}
+
+// NewStdoutNotifier записывает уведомления в стандартный вывод
+func NewStdoutNotifier() *WriterNotifier {
+	return NewWriterNotifier(os.Stdout)
+}
+
+// NewFileNotifier дописывает уведомления в конец файла path
+func NewFileNotifier(path string) (*WriterNotifier, error) {
+	const op = "notifier.NewFileNotifier"
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return NewWriterNotifier(file), nil
+}
+
+func (n *WriterNotifier) Notify(_ context.Context, message *Message) error {
+	const op = "notifier.WriterNotifier.Notify"
+
+	line, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, err = n.w.Write(append(line, '\n'))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
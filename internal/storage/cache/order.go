@@ -10,33 +10,35 @@ import (
 )
 
 type OrderCache struct {
-	cache *Cache[int64, *domain.Order]
+	cache *Cache[domain.OrderKey, *domain.Order]
 }
 
 func NewOrderCache(capacity int, evictionStrategy config.EvictionStrategy, ttl time.Duration) *OrderCache {
 	return &OrderCache{
-		cache: NewCache[int64, *domain.Order](capacity, evictionStrategy, ttl),
+		cache: NewCache[domain.OrderKey, *domain.Order](capacity, evictionStrategy, ttl),
 	}
 }
 
-func (oc *OrderCache) Set(ctx context.Context, key int64, value *domain.Order) {
+func (oc *OrderCache) Set(ctx context.Context, key domain.OrderKey, value *domain.Order) {
 	const op = "storage.cache.OrderCache.Set"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	span.SetTag("cache.key", key)
+	span.SetTag("cache.pvz_id", key.PickupPointID)
+	span.SetTag("cache.order_id", key.OrderID)
 
 	oc.cache.Set(ctx, key, value)
 }
 
-func (oc *OrderCache) Get(ctx context.Context, key int64) (*domain.Order, bool) {
+func (oc *OrderCache) Get(ctx context.Context, key domain.OrderKey) (*domain.Order, bool) {
 	const op = "storage.cache.OrderCache.Get"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	span.SetTag("cache.key", key)
+	span.SetTag("cache.pvz_id", key.PickupPointID)
+	span.SetTag("cache.order_id", key.OrderID)
 
 	return oc.cache.Get(ctx, key)
 }
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+const (
+	cellsTable = "cells"
+	// cellOccupancy заполненность ячеек по заказам, которые в них лежат
+	cellOccupancy = "(SELECT cell_id, COUNT(*) AS order_count, SUM(weight) AS weight " +
+		"FROM orders WHERE cell_id IS NOT NULL GROUP BY cell_id) o ON o.cell_id = c.id"
+)
+
+var (
+	cellsColumns = []string{"pvz_id", "zone", "rack", "shelf", "max_orders", "max_weight", "package_types", "created_at"}
+)
+
+// CreateCell сохраняет новую ячейку и заполняет ее идентификатор
+func (s *Storage) CreateCell(ctx context.Context, cell *domain.Cell) error {
+	const op = "storage.postgres.Storage.CreateCell"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("pvz_id", cell.PickupPointID)
+	span.SetTag("table", cellsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(cellsTable).
+		Columns(cellsColumns...).
+		Values(
+			cell.PickupPointID,
+			cell.Zone,
+			cell.Rack,
+			cell.Shelf,
+			cell.MaxOrders,
+			cell.MaxWeight,
+			cell.PackageTypes,
+			cell.CreatedAt,
+		).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	err = db.QueryRow(ctx, rowQuery, args...).Scan(&cell.ID)
+	if err != nil {
+		span.SetTag("error", true)
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueConstraint {
+			span.LogKV("event", "unique_constraint_error", "error", storage.ErrCellExists.Error())
+
+			return storage.ErrCellExists
+		}
+
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "cell_created", "cell_id", cell.ID)
+
+	return nil
+}
+
+// FindCells возвращает ячейки ПВЗ вместе с их заполненностью
+func (s *Storage) FindCells(ctx context.Context) ([]*domain.Cell, error) {
+	const op = "storage.postgres.Storage.FindCells"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return s.findCells(ctx, op, nil, "")
+}
+
+// FindCellByID возвращает ячейку ПВЗ вместе с ее заполненностью
+func (s *Storage) FindCellByID(ctx context.Context, id int64) (*domain.Cell, error) {
+	const op = "storage.postgres.Storage.FindCellByID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("cell_id", id)
+
+	cells, err := s.findCells(ctx, op, sq.Eq{"c.id": id}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cells) == 0 {
+		span.SetTag("error", true)
+		span.LogKV("event", "cell_not_found", "error", storage.ErrCellNotFound.Error())
+
+		return nil, storage.ErrCellNotFound
+	}
+
+	return cells[0], nil
+}
+
+// LockCells возвращает ячейки ПВЗ с их заполненностью и блокирует их до конца транзакции,
+// чтобы параллельные операции не положили заказы в одну и ту же свободную ячейку
+func (s *Storage) LockCells(ctx context.Context) ([]*domain.Cell, error) {
+	const op = "storage.postgres.Storage.LockCells"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return s.findCells(ctx, op, nil, "FOR UPDATE OF c")
+}
+
+// findCells выбирает ячейки ПВЗ, подходящие под условие where, упорядоченные по идентификатору.
+// Пустое условие выбирает все ячейки ПВЗ.
+func (s *Storage) findCells(ctx context.Context, op string, where sq.Sqlizer, suffix string) ([]*domain.Cell, error) {
+	span := opentracing.SpanFromContext(ctx)
+
+	span.SetTag("table", cellsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(
+		"c.id", "c.pvz_id", "c.zone", "c.rack", "c.shelf", "c.max_orders", "c.max_weight", "c.package_types",
+		"c.created_at", "COALESCE(o.order_count, 0) AS order_count", "COALESCE(o.weight, 0) AS weight",
+	).
+		From(cellsTable + " c").
+		LeftJoin(cellOccupancy).
+		Where(scope).
+		Where(where).
+		OrderBy("c.id").
+		PlaceholderFormat(sq.Dollar)
+
+	if suffix != "" {
+		query = query.Suffix(suffix)
+	}
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var cells []*domain.Cell
+
+	err = pgxscan.Select(ctx, db, &cells, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "cells_fetched", "count", len(cells))
+
+	return cells, nil
+}
+
+// FindOrdersByCellID возвращает заказы, которые лежат в ячейке
+func (s *Storage) FindOrdersByCellID(ctx context.Context, cellID int64) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindOrdersByCellID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("cell_id", cellID)
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"cell_id": cellID}).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+const (
+	couriersTable = "couriers"
+)
+
+var (
+	couriersColumns = []string{"id", "created_at"}
+)
+
+// CreateCourier регистрирует курьера, если он еще не был сохранен
+func (s *Storage) CreateCourier(ctx context.Context, courier *domain.Courier) error {
+	const op = "storage.postgres.Storage.CreateCourier"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("courier_id", courier.ID)
+	span.SetTag("table", couriersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(couriersTable).
+		Columns(couriersColumns...).
+		Values(courier.ID, courier.CreatedAt).
+		Suffix("ON CONFLICT (id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "courier_saved", "courier_id", courier.ID, "rows_inserted", commandTag.RowsAffected())
+
+	return nil
+}
@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+const (
+	idempotencyKeysTable = "idempotency_keys"
+	// idempotencyKeyReuse занимает истекший ключ заново; действующий ключ остается без изменений
+	idempotencyKeyReuse = "ON CONFLICT (pvz_id, key) DO UPDATE SET " +
+		"method = EXCLUDED.method, fingerprint = EXCLUDED.fingerprint, response = NULL, " +
+		"created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at " +
+		"WHERE idempotency_keys.expires_at <= EXCLUDED.created_at"
+)
+
+var (
+	idempotencyKeysColumns = []string{"pvz_id", "key", "method", "fingerprint", "response", "created_at", "expires_at"}
+)
+
+// CreateIdempotencyKey занимает ключ идемпотентности до выполнения запроса.
+// Возвращает false, если ключ уже занят и еще не истек.
+func (s *Storage) CreateIdempotencyKey(ctx context.Context, key *domain.IdempotencyKey) (bool, error) {
+	const op = "storage.postgres.Storage.CreateIdempotencyKey"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("pvz_id", key.PickupPointID)
+	span.SetTag("table", idempotencyKeysTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(idempotencyKeysTable).
+		Columns(idempotencyKeysColumns...).
+		Values(key.PickupPointID, key.Key, key.Method, key.Fingerprint, key.Response, key.CreatedAt, key.ExpiresAt).
+		Suffix(idempotencyKeyReuse).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return false, err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return false, err
+	}
+
+	created := commandTag.RowsAffected() > 0
+
+	span.LogKV("event", "idempotency_key_reserved", "created", created)
+
+	return created, nil
+}
+
+// FindIdempotencyKey возвращает ключ идемпотентности ПВЗ вместе с сохраненным ответом
+func (s *Storage) FindIdempotencyKey(ctx context.Context, key string) (*domain.IdempotencyKey, error) {
+	const op = "storage.postgres.Storage.FindIdempotencyKey"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", idempotencyKeysTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(idempotencyKeysColumns...).
+		From(idempotencyKeysTable).
+		Where(scope).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var idempotencyKey domain.IdempotencyKey
+
+	err = pgxscan.Get(ctx, db, &idempotencyKey, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.LogKV("event", "idempotency_key_not_found", "error", storage.ErrIdempotencyKeyNotFound.Error())
+
+			return nil, storage.ErrIdempotencyKeyNotFound
+		}
+
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// SaveIdempotencyResponse сохраняет ответ на запрос, выполненный с ключом идемпотентности
+func (s *Storage) SaveIdempotencyResponse(ctx context.Context, key string, response []byte) error {
+	const op = "storage.postgres.Storage.SaveIdempotencyResponse"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", idempotencyKeysTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return err
+	}
+
+	query := sq.Update(idempotencyKeysTable).
+		Set("response", response).
+		Where(scope).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		span.SetTag("error", true)
+		span.LogKV("event", "idempotency_key_not_found", "error", storage.ErrIdempotencyKeyNotFound.Error())
+
+		return storage.ErrIdempotencyKeyNotFound
+	}
+
+	span.LogKV("event", "idempotency_response_saved")
+
+	return nil
+}
+
+// DeleteIdempotencyKey освобождает ключ идемпотентности ПВЗ
+func (s *Storage) DeleteIdempotencyKey(ctx context.Context, key string) error {
+	const op = "storage.postgres.Storage.DeleteIdempotencyKey"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", idempotencyKeysTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return err
+	}
+
+	query := sq.Delete(idempotencyKeysTable).
+		Where(scope).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	_, err = db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "idempotency_key_deleted")
+
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys удаляет ключи идемпотентности всех ПВЗ, истекшие к моменту before
+func (s *Storage) DeleteExpiredIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	const op = "storage.postgres.Storage.DeleteExpiredIdempotencyKeys"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", idempotencyKeysTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Delete(idempotencyKeysTable).
+		Where(sq.LtOrEq{"expires_at": before}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return 0, err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return 0, err
+	}
+
+	span.LogKV("event", "idempotency_keys_deleted", "count", commandTag.RowsAffected())
+
+	return commandTag.RowsAffected(), nil
+}
@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+// notificationsTable outbox уведомлений получателей; события для Kafka хранятся в отдельной таблице outbox
+const notificationsTable = "notifications"
+
+// CreateNotification сохраняет уведомление в outbox в текущей транзакции.
+// Уведомление, которое уже есть в outbox, повторно не сохраняется.
+func (s *Storage) CreateNotification(ctx context.Context, notification *domain.Notification) error {
+	const op = "storage.postgres.Storage.CreateNotification"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_id", notification.OrderID)
+	span.SetTag("table", notificationsTable)
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "marshal_error", "error", err.Error())
+
+		return err
+	}
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(notificationsTable).
+		Columns("id", "payload", "created_at", "processed", "retry_count").
+		Values(notification.ID, payload, notification.CreatedAt, false, 0).
+		Suffix("ON CONFLICT (id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "notification_saved", "notification_id", notification.ID, "rows_inserted", commandTag.RowsAffected())
+
+	return nil
+}
+
+// ClaimPendingNotifications закрепляет за вызывающим до at+lease недоставленные уведомления,
+// у которых осталось меньше maxRetries попыток, и возвращает их.
+// Закрепление выполняется одним запросом, поэтому доставка идет без открытой транзакции,
+// а другие рассыльщики не берут эти уведомления, пока не истечет lease.
+func (s *Storage) ClaimPendingNotifications(ctx context.Context, at time.Time, lease time.Duration, limit int32, maxRetries int) ([]*domain.Notification, error) {
+	const op = "storage.postgres.Storage.ClaimPendingNotifications"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", notificationsTable)
+	span.SetTag("limit", limit)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	pending := sq.Select("id").
+		From(notificationsTable).
+		Where(sq.Eq{"processed": false}).
+		Where(sq.Lt{"retry_count": maxRetries}).
+		Where(sq.Or{sq.Eq{"claimed_until": nil}, sq.Lt{"claimed_until": at}}).
+		OrderBy("created_at").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query := sq.Update(notificationsTable).
+		Set("claimed_until", at.Add(lease)).
+		Where(sq.Expr("id IN (?)", pending)).
+		Suffix("RETURNING payload").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	rows, err := db.Query(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*domain.Notification
+	for rows.Next() {
+		var payload []byte
+		if err := rows.Scan(&payload); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "db_scan_error", "error", err.Error())
+
+			return nil, err
+		}
+
+		var notification domain.Notification
+		if err := json.Unmarshal(payload, &notification); err != nil {
+			span.SetTag("error", true)
+			span.LogKV("event", "unmarshal_error", "error", err.Error())
+
+			return nil, err
+		}
+
+		notifications = append(notifications, &notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		return nil, err
+	}
+
+	span.LogKV("event", "notifications_claimed", "count", len(notifications))
+
+	return notifications, nil
+}
+
+// MarkNotificationProcessed отмечает уведомление доставленным.
+// Код выдачи стирается из сохраненного уведомления: после доставки он должен быть только у получателя.
+func (s *Storage) MarkNotificationProcessed(ctx context.Context, id uuid.UUID) error {
+	const op = "storage.postgres.Storage.MarkNotificationProcessed"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return s.updateNotification(ctx, op, id, sq.Eq{
+		"processed":     true,
+		"claimed_until": nil,
+		"payload":       sq.Expr("convert_to((convert_from(payload, 'UTF8')::jsonb - 'pickup_code')::text, 'UTF8')"),
+	})
+}
+
+// IncrementNotificationRetry учитывает неудачную попытку доставки уведомления
+// и снимает закрепление, чтобы уведомление повторилось при следующей рассылке
+func (s *Storage) IncrementNotificationRetry(ctx context.Context, id uuid.UUID) error {
+	const op = "storage.postgres.Storage.IncrementNotificationRetry"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return s.updateNotification(ctx, op, id, sq.Eq{"retry_count": sq.Expr("retry_count + 1"), "claimed_until": nil})
+}
+
+func (s *Storage) updateNotification(ctx context.Context, op string, id uuid.UUID, set sq.Eq) error {
+	span := opentracing.SpanFromContext(ctx)
+	span.SetTag("notification_id", id)
+	span.SetTag("table", notificationsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Update(notificationsTable).
+		SetMap(set).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	_, err = db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/pgxscan"
@@ -21,7 +22,11 @@ const (
 
 var (
 	ordersColumns = []string{"id", "recipient_id", "storage_until",
-		"issued_at", "returned_at", "hash", "weight", "order_cost", "package_cost", "package_type"}
+		"issued_at", "returned_at", "hash", "weight", "order_cost", "package_cost", "package_type", "status",
+		"returned_to_courier_at", "courier_id", "pvz_id", "length", "width", "height", "currency",
+		"storage_fee", "original_storage_until", "extension_count", "pickup_code_hash", "pickup_code_attempts",
+		"pickup_code_regenerated_at", "return_reason", "return_condition", "return_note", "refund_amount", "category",
+		"cell_id", "version"}
 )
 
 func (s *Storage) CreateOrder(ctx context.Context, order *domain.Order) error {
@@ -78,62 +83,56 @@ func (s *Storage) CreateOrder(ctx context.Context, order *domain.Order) error {
 	return nil
 }
 
-func (s *Storage) DeleteOrder(ctx context.Context, id int64) error {
-	const op = "storage.postgres.Storage.DeleteOrder"
+// CreateOrders сохраняет заказы одним многострочным запросом.
+// Заказы, которые уже есть в базе, пропускаются; возвращаются идентификаторы сохраненных заказов.
+func (s *Storage) CreateOrders(ctx context.Context, orders []*domain.Order) ([]int64, error) {
+	const op = "storage.postgres.Storage.CreateOrders"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
 	defer span.Finish()
 
-	span.SetTag("order_id", id)
 	span.SetTag("table", ordersTable)
+	span.SetTag("count", len(orders))
+
+	if len(orders) == 0 {
+		return nil, nil
+	}
 
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
-	query := sq.Delete(ordersTable).
-		Where(sq.Eq{"id": id}).
+	query := sq.Insert(ordersTable).
+		Columns(ordersColumns...).
+		Suffix("ON CONFLICT (id) DO NOTHING RETURNING id").
 		PlaceholderFormat(sq.Dollar)
 
+	for _, order := range orders {
+		query = query.Values(getValues(order)...)
+	}
+
 	rowQuery, args, err := query.ToSql()
 	if err != nil {
 		span.SetTag("error", true)
 		span.LogKV("event", "query_build_error", "error", err.Error())
 
 		log.Printf("%s: %v", op, err)
-
-		return err
+		return nil, err
 	}
 
-	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	var created []int64
+
+	err = pgxscan.Select(ctx, db, &created, rowQuery, args...)
 	if err != nil {
 		span.SetTag("error", true)
-
-		if errors.Is(err, pgx.ErrNoRows) {
-			span.LogKV("event", "order_not_found")
-
-			log.Printf("%s: %v", op, storage.ErrOrderNotFound)
-
-			return storage.ErrOrderNotFound
-		}
-
 		span.LogKV("event", "db_exec_error", "error", err.Error())
 
 		log.Printf("%s: %v", op, err)
 
-		return err
-	}
-
-	rowsDeleted := commandTag.RowsAffected()
-
-	if rowsDeleted == 0 {
-		span.SetTag("error", true)
-		span.LogKV("event", "order_not_found")
-
-		return storage.ErrOrderNotFound
+		return nil, err
 	}
 
-	span.LogKV("event", "order_deleted", "rows_deleted", rowsDeleted)
+	span.LogKV("event", "orders_created", "count", len(created))
 
-	return nil
+	return created, nil
 }
 
 func (s *Storage) FindOrdersByRecipientID(ctx context.Context, recipientID int64) ([]*domain.Order, error) {
@@ -147,8 +146,17 @@ func (s *Storage) FindOrdersByRecipientID(ctx context.Context, recipientID int64
 
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
 	query := sq.Select(ordersColumns...).
 		From(ordersTable).
+		Where(scope).
 		OrderBy("storage_until DESC").
 		Where(sq.Eq{"recipient_id": recipientID}).
 		PlaceholderFormat(sq.Dollar)
@@ -187,7 +195,13 @@ func (s *Storage) FindOrdersByRecipientID(ctx context.Context, recipientID int64
 	return orders, nil
 }
 
-func (s *Storage) FindReturnedOrdersWithPagination(ctx context.Context, limit, offset int32) ([]*domain.Order, error) {
+// FindReturnedOrdersWithPagination возвращает страницу заказов, возвращенных клиентами.
+// Если condition не пустое, возвращаются только заказы в этом состоянии.
+func (s *Storage) FindReturnedOrdersWithPagination(
+	ctx context.Context,
+	limit, offset int32,
+	condition domain.ReturnCondition,
+) ([]*domain.Order, error) {
 	const op = "storage.postgres.Storage.FindReturnedOrdersWithPagination"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
@@ -197,14 +211,416 @@ func (s *Storage) FindReturnedOrdersWithPagination(ctx context.Context, limit, o
 
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
 	query := sq.Select(ordersColumns...).
 		From(ordersTable).
+		Where(scope).
 		Where(sq.NotEq{"returned_at": nil}).
+		Where(sq.Eq{"status": domain.OrderStatusReturnedByClient}).
 		OrderBy("storage_until DESC").
 		Limit(uint64(limit)).
 		Offset(uint64(offset)).
 		PlaceholderFormat(sq.Dollar)
 
+	if condition != "" {
+		query = query.Where(sq.Eq{"return_condition": condition})
+	}
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
+
+// FindOrdersAfterID возвращает до limit заказов ПВЗ с идентификатором больше afterID в порядке возрастания
+func (s *Storage) FindOrdersAfterID(ctx context.Context, afterID int64, limit int32) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindOrdersAfterID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", ordersTable)
+	span.SetTag("after_id", afterID)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id ASC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
+
+func (s *Storage) FindOrdersReturnedToCourier(ctx context.Context, from, to time.Time) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindOrdersReturnedToCourier"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", ordersTable)
+	span.SetTag("from", from)
+	span.SetTag("to", to)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"status": domain.OrderStatusReturnedToCourier}).
+		Where(sq.GtOrEq{"returned_to_courier_at": from}).
+		Where(sq.Lt{"returned_to_courier_at": to}).
+		OrderBy("returned_to_courier_at DESC").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
+
+// FindClientReturnsBefore возвращает заказы всех ПВЗ, возвращенные клиентом раньше before, по возрастанию id.
+// Используется командой cleanup, поэтому не ограничивается ПВЗ из контекста.
+func (s *Storage) FindClientReturnsBefore(ctx context.Context, before time.Time, afterID int64, limit int32) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindClientReturnsBefore"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("before", before)
+	span.SetTag("after_id", afterID)
+	span.SetTag("limit", limit)
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(sq.Eq{"status": domain.OrderStatusReturnedByClient}).
+		Where(sq.Lt{"returned_at": before}).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
+
+func (s *Storage) FindExpiredOrdersByCourierID(ctx context.Context, courierID int64, at time.Time) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindExpiredOrdersByCourierID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("courier_id", courierID)
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"courier_id": courierID}).
+		Where(sq.Eq{"status": []domain.OrderStatus{
+			domain.OrderStatusAccepted, domain.OrderStatusExpired, domain.OrderStatusAwaitingReturn,
+		}}).
+		Where(sq.Lt{"storage_until": at}).
+		OrderBy("storage_until", "id").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
+
+// FindOrdersDueForReturn возвращает пачку заказов с истекшим на момент at сроком хранения, которые еще не выданы
+// и не отобраны для возврата курьеру. Заказы упорядочены по идентификатору и начинаются после afterID.
+// Строки блокируются до конца транзакции; заказы, заблокированные другой операцией, пропускаются.
+func (s *Storage) FindOrdersDueForReturn(ctx context.Context, at time.Time, afterID int64, limit int32) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindOrdersDueForReturn"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("after_id", afterID)
+	span.SetTag("limit", limit)
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"status": []domain.OrderStatus{domain.OrderStatusAccepted, domain.OrderStatusExpired}}).
+		Where(sq.Lt{"storage_until": at}).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var orders []*domain.Order
+
+	err = pgxscan.Select(ctx, db, &orders, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "orders_fetched", "count", len(orders))
+
+	return orders, nil
+}
+
+// CountOrdersByStatus возвращает количество заказов ПВЗ в статусе status
+func (s *Storage) CountOrdersByStatus(ctx context.Context, status domain.OrderStatus) (int64, error) {
+	const op = "storage.postgres.Storage.CountOrdersByStatus"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("status", status)
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return 0, err
+	}
+
+	query := sq.Select("COUNT(*)").
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"status": status}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return 0, err
+	}
+
+	var count int64
+
+	err = db.QueryRow(ctx, rowQuery, args...).Scan(&count)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// FindOrdersExpiringBetween возвращает заказы, ожидающие выдачи, срок хранения которых заканчивается в промежутке (from, to]
+func (s *Storage) FindOrdersExpiringBetween(ctx context.Context, from, to time.Time) ([]*domain.Order, error) {
+	const op = "storage.postgres.Storage.FindOrdersExpiringBetween"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("from", from)
+	span.SetTag("to", to)
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(ordersColumns...).
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"status": domain.OrderStatusAccepted}).
+		Where(sq.Gt{"storage_until": from}).
+		Where(sq.LtOrEq{"storage_until": to}).
+		OrderBy("storage_until", "id").
+		PlaceholderFormat(sq.Dollar)
+
 	rowQuery, args, err := query.ToSql()
 	if err != nil {
 		span.SetTag("error", true)
@@ -243,8 +659,17 @@ func (s *Storage) FindOrderByIDs(ctx context.Context, ids []int64) ([]*domain.Or
 
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
 	query := sq.Select(ordersColumns...).
 		From(ordersTable).
+		Where(scope).
 		Where(sq.Eq{"id": ids}).
 		PlaceholderFormat(sq.Dollar)
 
@@ -286,8 +711,17 @@ func (s *Storage) FindOrderByID(ctx context.Context, id int64) (*domain.Order, e
 
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
 	query := sq.Select(ordersColumns...).
 		From(ordersTable).
+		Where(scope).
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar)
 
@@ -338,12 +772,35 @@ func (s *Storage) UpdateOrder(ctx context.Context, order *domain.Order) error {
 
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return err
+	}
+
 	query := sq.Update(ordersTable).
 		Set("recipient_id", order.RecipientID).
 		Set("storage_until", order.StorageUntil).
 		Set("returned_at", order.ReturnedAt).
 		Set("issued_at", order.IssuedAt).
-		Where(sq.Eq{"id": order.ID}).
+		Set("status", order.Status).
+		Set("returned_to_courier_at", order.ReturnedToCourierAt).
+		Set("storage_fee", order.StorageFee).
+		Set("extension_count", order.ExtensionCount).
+		Set("pickup_code_hash", order.PickupCodeHash).
+		Set("pickup_code_attempts", order.PickupCodeAttempts).
+		Set("pickup_code_regenerated_at", order.PickupCodeRegeneratedAt).
+		Set("return_reason", order.ReturnReason).
+		Set("return_condition", order.ReturnCondition).
+		Set("return_note", order.ReturnNote).
+		Set("refund_amount", order.RefundAmount).
+		Set("cell_id", order.CellID).
+		Set("hash", order.Hash).
+		Set("version", order.Version+1).
+		Where(sq.Eq{"id": order.ID, "version": order.Version}).
+		Where(scope).
 		PlaceholderFormat(sq.Dollar)
 
 	rowQuery, args, err := query.ToSql()
@@ -379,17 +836,28 @@ func (s *Storage) UpdateOrder(ctx context.Context, order *domain.Order) error {
 
 	if rowsUpdated == 0 {
 		span.SetTag("error", true)
-		span.LogKV("event", "no_rows_updated", "error", storage.ErrOrderNotFound.Error())
 
-		return storage.ErrOrderNotFound
+		// заказ не обновился: либо его нет, либо его версию уже изменил другой запрос
+		_, err = s.FindOrderByID(ctx, order.ID)
+		if err != nil {
+			span.LogKV("event", "no_rows_updated", "error", err.Error())
+
+			return err
+		}
+
+		span.LogKV("event", "order_version_conflict", "version", order.Version)
+
+		return storage.ErrOrderVersionConflict
 	}
 
-	span.LogKV("event", "order_updated", "rows_updated", rowsUpdated)
+	order.Version++
+
+	span.LogKV("event", "order_updated", "rows_updated", rowsUpdated, "version", order.Version)
 
 	return nil
 }
 
-func (s *Storage) DeleteRecipientOrders(ctx context.Context) (int64, error) {
+func (s *Storage) DeleteRecipientOrders(ctx context.Context, before time.Time) (int64, error) {
 	const op = "storage.postgres.Storage.DeleteRecipientOrders"
 
 	span, ctx := opentracing.StartSpanFromContext(ctx, op)
@@ -398,8 +866,42 @@ func (s *Storage) DeleteRecipientOrders(ctx context.Context) (int64, error) {
 	db := s.QueryEngineProvider.GetQueryEngine(ctx)
 
 	query := sq.Delete(ordersTable).
-		Where(sq.Expr("returned_at IS NOT NULL")).
-		Where(sq.Expr("returned_at < NOW() - INTERVAL '2 days'")).
+		Where(sq.Eq{"status": domain.OrderStatusReturnedToCourier}).
+		Where(sq.Lt{"returned_to_courier_at": before}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		log.Printf("%s: %v", op, err)
+		return 0, err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		log.Printf("%s: %v", op, err)
+		return 0, err
+	}
+
+	rowsDeleted := commandTag.RowsAffected()
+
+	return rowsDeleted, nil
+}
+
+// DeleteClientReturns удаляет заказы с указанными id, если они все еще возвращены клиентом
+func (s *Storage) DeleteClientReturns(ctx context.Context, ids []int64) (int64, error) {
+	const op = "storage.postgres.Storage.DeleteClientReturns"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("count", len(ids))
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Delete(ordersTable).
+		Where(sq.Eq{"id": ids}).
+		Where(sq.Eq{"status": domain.OrderStatusReturnedByClient}).
 		PlaceholderFormat(sq.Dollar)
 
 	rowQuery, args, err := query.ToSql()
@@ -416,6 +918,8 @@ func (s *Storage) DeleteRecipientOrders(ctx context.Context) (int64, error) {
 
 	rowsDeleted := commandTag.RowsAffected()
 
+	span.LogKV("event", "orders_deleted", "rows_deleted", rowsDeleted)
+
 	return rowsDeleted, nil
 }
 
@@ -431,5 +935,26 @@ func getValues(order *domain.Order) []any {
 		order.Cost,
 		order.PackageCost,
 		order.PackageType.Type(),
+		order.Status,
+		order.ReturnedToCourierAt,
+		order.CourierID,
+		order.PickupPointID,
+		order.Length,
+		order.Width,
+		order.Height,
+		order.Currency,
+		order.StorageFee,
+		order.OriginalStorageUntil,
+		order.ExtensionCount,
+		order.PickupCodeHash,
+		order.PickupCodeAttempts,
+		order.PickupCodeRegeneratedAt,
+		order.ReturnReason,
+		order.ReturnCondition,
+		order.ReturnNote,
+		order.RefundAmount,
+		order.Category,
+		order.CellID,
+		order.Version,
 	}
 }
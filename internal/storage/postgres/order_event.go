@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+const (
+	orderEventsTable = "order_events"
+)
+
+var (
+	orderEventsColumns = []string{"order_id", "pvz_id", "old_status", "new_status", "operation", "payload", "created_at"}
+)
+
+func (s *Storage) CreateOrderEvent(ctx context.Context, event *domain.OrderEvent) error {
+	const op = "storage.postgres.Storage.CreateOrderEvent"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_id", event.OrderID)
+	span.SetTag("table", orderEventsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(orderEventsTable).
+		Columns(orderEventsColumns...).
+		Values(event.OrderID, event.PickupPointID, event.OldStatus, event.NewStatus, event.Operation, event.Payload, event.CreatedAt).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	err = db.QueryRow(ctx, rowQuery, args...).Scan(&event.ID)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "order_event_created", "event_id", event.ID)
+
+	return nil
+}
+
+func (s *Storage) FindOrderEventsByOrderID(ctx context.Context, orderID int64) ([]*domain.OrderEvent, error) {
+	const op = "storage.postgres.Storage.FindOrderEventsByOrderID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("order_id", orderID)
+	span.SetTag("table", orderEventsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select(append([]string{"id"}, orderEventsColumns...)...).
+		From(orderEventsTable).
+		Where(scope).
+		Where(sq.Eq{"order_id": orderID}).
+		OrderBy("created_at", "id").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var events []*domain.OrderEvent
+
+	err = pgxscan.Select(ctx, db, &events, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		span.LogKV("event", "order_events_not_found")
+
+		return nil, storage.ErrOrderNotFound
+	}
+
+	span.LogKV("event", "order_events_fetched", "count", len(events))
+
+	return events, nil
+}
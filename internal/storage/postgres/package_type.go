@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+const (
+	packageTypesTable = "package_types"
+)
+
+var (
+	packageTypesColumns = []string{
+		"name", "max_weight", "max_length", "max_width", "max_height", "volumetric_divisor", "cost", "currency", "active",
+		"created_at",
+	}
+)
+
+// FindPackageTypes возвращает все типы упаковки, включая деактивированные
+func (s *Storage) FindPackageTypes(ctx context.Context) ([]*domain.PackageType, error) {
+	const op = "storage.postgres.Storage.FindPackageTypes"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", packageTypesTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Select(packageTypesColumns...).
+		From(packageTypesTable).
+		OrderBy("name").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var packageTypes []*domain.PackageType
+	err = pgxscan.Select(ctx, db, &packageTypes, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "package_types_fetched", "count", len(packageTypes))
+
+	return packageTypes, nil
+}
+
+// CreatePackageType сохраняет новый тип упаковки
+func (s *Storage) CreatePackageType(ctx context.Context, packageType *domain.PackageType) error {
+	const op = "storage.postgres.Storage.CreatePackageType"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("package_type", packageType.Name)
+	span.SetTag("table", packageTypesTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(packageTypesTable).
+		Columns(packageTypesColumns...).
+		Values(
+			packageType.Name,
+			packageType.MaxWeight,
+			packageType.MaxLength,
+			packageType.MaxWidth,
+			packageType.MaxHeight,
+			packageType.VolumetricDivisor,
+			packageType.Cost,
+			packageType.Currency,
+			packageType.Active,
+			packageType.CreatedAt,
+		).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	_, err = db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueConstraint {
+			span.LogKV("event", "unique_constraint_error", "error", storage.ErrPackageTypeExists.Error())
+
+			return storage.ErrPackageTypeExists
+		}
+
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "package_type_created", "package_type", packageType.Name)
+
+	return nil
+}
+
+// DeactivatePackageType запрещает принимать заказы с указанным типом упаковки.
+// Строка не удаляется, чтобы ранее принятые заказы продолжали читаться.
+func (s *Storage) DeactivatePackageType(ctx context.Context, name string) error {
+	const op = "storage.postgres.Storage.DeactivatePackageType"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("package_type", name)
+	span.SetTag("table", packageTypesTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Update(packageTypesTable).
+		Set("active", false).
+		Where(sq.Eq{"name": name}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		span.SetTag("error", true)
+		span.LogKV("event", "package_type_not_found", "error", storage.ErrPackageTypeNotFound.Error())
+
+		return storage.ErrPackageTypeNotFound
+	}
+
+	span.LogKV("event", "package_type_deactivated", "package_type", name)
+
+	return nil
+}
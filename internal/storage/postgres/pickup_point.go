@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+const (
+	pickupPointsTable = "pickup_points"
+)
+
+var (
+	pickupPointsColumns = []string{"id", "name", "address", "created_at"}
+)
+
+func (s *Storage) FindPickupPointByID(ctx context.Context, id int64) (*domain.PickupPoint, error) {
+	const op = "storage.postgres.Storage.FindPickupPointByID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("pvz_id", id)
+	span.SetTag("table", pickupPointsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Select(pickupPointsColumns...).
+		From(pickupPointsTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var pickupPoint domain.PickupPoint
+	err = pgxscan.Get(ctx, db, &pickupPoint, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.LogKV("event", "pickup_point_not_found", "error", storage.ErrPickupPointNotFound.Error())
+
+			return nil, storage.ErrPickupPointNotFound
+		}
+
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "pickup_point_fetched", "pvz_id", id)
+
+	return &pickupPoint, nil
+}
+
+// FindPickupPoints возвращает все зарегистрированные ПВЗ для фоновых задач, которые обходят их по очереди
+func (s *Storage) FindPickupPoints(ctx context.Context) ([]*domain.PickupPoint, error) {
+	const op = "storage.postgres.Storage.FindPickupPoints"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", pickupPointsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Select(pickupPointsColumns...).
+		From(pickupPointsTable).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var pickupPoints []*domain.PickupPoint
+	err = pgxscan.Select(ctx, db, &pickupPoints, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "pickup_points_fetched", "count", len(pickupPoints))
+
+	return pickupPoints, nil
+}
+
+// pickupPointScope возвращает условие, ограничивающее запрос данными ПВЗ из контекста
+func pickupPointScope(ctx context.Context) (sq.Eq, error) {
+	pickupPointID, ok := domain.PickupPointIDFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrPickupPointRequired
+	}
+
+	return sq.Eq{"pvz_id": pickupPointID}, nil
+}
+
+// LockPickupPoint блокирует ПВЗ из контекста до конца транзакции.
+// Операции, меняющие заполненность ПВЗ, выполняются по очереди, чтобы не превысить ее ограничения.
+func (s *Storage) LockPickupPoint(ctx context.Context) error {
+	const op = "storage.postgres.Storage.LockPickupPoint"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", pickupPointsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	pickupPointID, ok := domain.PickupPointIDFromContext(ctx)
+	if !ok {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", domain.ErrPickupPointRequired.Error())
+
+		return domain.ErrPickupPointRequired
+	}
+
+	span.SetTag("pvz_id", pickupPointID)
+
+	query := sq.Select("id").
+		From(pickupPointsTable).
+		Where(sq.Eq{"id": pickupPointID}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	var id int64
+
+	err = db.QueryRow(ctx, rowQuery, args...).Scan(&id)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.LogKV("event", "pickup_point_not_found", "error", storage.ErrPickupPointNotFound.Error())
+
+			return storage.ErrPickupPointNotFound
+		}
+
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "pickup_point_locked", "pvz_id", id)
+
+	return nil
+}
+
+// FindPickupPointLoad возвращает количество и общий вес заказов, которые физически находятся на складе ПВЗ
+func (s *Storage) FindPickupPointLoad(ctx context.Context) (*domain.PickupPointLoad, error) {
+	const op = "storage.postgres.Storage.FindPickupPointLoad"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", ordersTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	scope, err := pickupPointScope(ctx)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "pickup_point_required", "error", err.Error())
+
+		return nil, err
+	}
+
+	query := sq.Select("COUNT(*) AS order_count", "COALESCE(SUM(weight), 0) AS weight").
+		From(ordersTable).
+		Where(scope).
+		Where(sq.Eq{"status": domain.StoredStatuses()}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var load domain.PickupPointLoad
+
+	err = pgxscan.Get(ctx, db, &load, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "pickup_point_load_fetched", "order_count", load.OrderCount, "weight", load.Weight)
+
+	return &load, nil
+}
@@ -0,0 +1,368 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
+)
+
+const (
+	recipientsTable = "recipients"
+	// recipientPhoneIndex уникальный индекс телефона получателя
+	recipientPhoneIndex = "idx_recipients_phone"
+	foreignKeyViolation = "23503"
+)
+
+var (
+	recipientsColumns = []string{"id", "name", "phone", "email", "preferred_channel", "created_at", "updated_at"}
+)
+
+// CreateRecipient сохраняет нового получателя
+func (s *Storage) CreateRecipient(ctx context.Context, recipient *domain.Recipient) error {
+	const op = "storage.postgres.Storage.CreateRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("recipient_id", recipient.ID)
+	span.SetTag("table", recipientsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(recipientsTable).
+		Columns(recipientsColumns...).
+		Values(getRecipientValues(recipient)...).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	_, err = db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if uniqueErr := recipientUniqueError(err); uniqueErr != nil {
+			span.LogKV("event", "unique_constraint_error", "error", uniqueErr.Error())
+
+			return uniqueErr
+		}
+
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "recipient_created", "recipient_id", recipient.ID)
+
+	return nil
+}
+
+// EnsureRecipient сохраняет получателя, если получателя с таким идентификатором еще нет
+func (s *Storage) EnsureRecipient(ctx context.Context, recipient *domain.Recipient) error {
+	const op = "storage.postgres.Storage.EnsureRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("recipient_id", recipient.ID)
+	span.SetTag("table", recipientsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Insert(recipientsTable).
+		Columns(recipientsColumns...).
+		Values(getRecipientValues(recipient)...).
+		Suffix("ON CONFLICT (id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	span.LogKV("event", "recipient_saved", "recipient_id", recipient.ID, "rows_inserted", commandTag.RowsAffected())
+
+	return nil
+}
+
+// UpdateRecipient сохраняет контакты получателя
+func (s *Storage) UpdateRecipient(ctx context.Context, recipient *domain.Recipient) error {
+	const op = "storage.postgres.Storage.UpdateRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("recipient_id", recipient.ID)
+	span.SetTag("table", recipientsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Update(recipientsTable).
+		Set("name", recipient.Name).
+		Set("phone", recipient.Phone).
+		Set("email", recipient.Email).
+		Set("preferred_channel", recipient.PreferredChannel).
+		Set("updated_at", recipient.UpdatedAt).
+		Where(sq.Eq{"id": recipient.ID}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if uniqueErr := recipientUniqueError(err); uniqueErr != nil {
+			span.LogKV("event", "unique_constraint_error", "error", uniqueErr.Error())
+
+			return uniqueErr
+		}
+
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		span.SetTag("error", true)
+		span.LogKV("event", "recipient_not_found", "error", storage.ErrRecipientNotFound.Error())
+
+		return storage.ErrRecipientNotFound
+	}
+
+	span.LogKV("event", "recipient_updated", "recipient_id", recipient.ID)
+
+	return nil
+}
+
+// DeleteRecipient удаляет получателя. Получатель, на которого ссылаются заказы, не удаляется.
+func (s *Storage) DeleteRecipient(ctx context.Context, id int64) error {
+	const op = "storage.postgres.Storage.DeleteRecipient"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("recipient_id", id)
+	span.SetTag("table", recipientsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Delete(recipientsTable).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	commandTag, err := db.Exec(ctx, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+			span.LogKV("event", "recipient_has_orders", "error", storage.ErrRecipientHasOrders.Error())
+
+			return storage.ErrRecipientHasOrders
+		}
+
+		span.LogKV("event", "db_exec_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return err
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		span.SetTag("error", true)
+		span.LogKV("event", "recipient_not_found", "error", storage.ErrRecipientNotFound.Error())
+
+		return storage.ErrRecipientNotFound
+	}
+
+	span.LogKV("event", "recipient_deleted", "recipient_id", id)
+
+	return nil
+}
+
+// FindRecipientByID возвращает получателя по идентификатору
+func (s *Storage) FindRecipientByID(ctx context.Context, id int64) (*domain.Recipient, error) {
+	const op = "storage.postgres.Storage.FindRecipientByID"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("recipient_id", id)
+
+	return s.findRecipient(ctx, op, sq.Eq{"id": id})
+}
+
+// FindRecipientByPhone возвращает получателя по телефону в виде +<код страны><номер>
+func (s *Storage) FindRecipientByPhone(ctx context.Context, phone string) (*domain.Recipient, error) {
+	const op = "storage.postgres.Storage.FindRecipientByPhone"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	return s.findRecipient(ctx, op, sq.Eq{"phone": phone})
+}
+
+// FindRecipients возвращает страницу получателей, упорядоченных по идентификатору
+func (s *Storage) FindRecipients(ctx context.Context, limit, offset int32) ([]*domain.Recipient, error) {
+	const op = "storage.postgres.Storage.FindRecipients"
+
+	span, ctx := opentracing.StartSpanFromContext(ctx, op)
+	defer span.Finish()
+
+	span.SetTag("table", recipientsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Select(recipientsColumns...).
+		From(recipientsTable).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var recipients []*domain.Recipient
+	err = pgxscan.Select(ctx, db, &recipients, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	span.LogKV("event", "recipients_fetched", "count", len(recipients))
+
+	return recipients, nil
+}
+
+func (s *Storage) findRecipient(ctx context.Context, op string, where sq.Eq) (*domain.Recipient, error) {
+	span := opentracing.SpanFromContext(ctx)
+	span.SetTag("table", recipientsTable)
+
+	db := s.QueryEngineProvider.GetQueryEngine(ctx)
+
+	query := sq.Select(recipientsColumns...).
+		From(recipientsTable).
+		Where(where).
+		PlaceholderFormat(sq.Dollar)
+
+	rowQuery, args, err := query.ToSql()
+	if err != nil {
+		span.SetTag("error", true)
+		span.LogKV("event", "query_build_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	var recipient domain.Recipient
+	err = pgxscan.Get(ctx, db, &recipient, rowQuery, args...)
+	if err != nil {
+		span.SetTag("error", true)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			span.LogKV("event", "recipient_not_found", "error", storage.ErrRecipientNotFound.Error())
+
+			return nil, storage.ErrRecipientNotFound
+		}
+
+		span.LogKV("event", "db_select_error", "error", err.Error())
+
+		log.Printf("%s: %v", op, err)
+
+		return nil, err
+	}
+
+	return &recipient, nil
+}
+
+// recipientUniqueError переводит нарушение уникальности в ошибку хранилища
+func recipientUniqueError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != uniqueConstraint {
+		return nil
+	}
+
+	if pgErr.ConstraintName == recipientPhoneIndex {
+		return storage.ErrRecipientPhoneExists
+	}
+
+	return storage.ErrRecipientExists
+}
+
+func getRecipientValues(recipient *domain.Recipient) []any {
+	return []any{
+		recipient.ID,
+		recipient.Name,
+		recipient.Phone,
+		recipient.Email,
+		recipient.PreferredChannel,
+		recipient.CreatedAt,
+		recipient.UpdatedAt,
+	}
+}
@@ -3,7 +3,23 @@ package storage
 import "errors"
 
 var (
-	ErrOrderNotFound   = errors.New("order not found")
-	ErrOrderExists     = errors.New("order already exists")
-	ErrOrderNotCreated = errors.New("order not created")
+	ErrOrderNotFound        = errors.New("order not found")
+	ErrOrderExists          = errors.New("order already exists")
+	ErrOrderNotCreated      = errors.New("order not created")
+	ErrOrderVersionConflict = errors.New("order version conflict")
+
+	ErrPickupPointNotFound = errors.New("pickup point not found")
+
+	ErrPackageTypeNotFound = errors.New("package type not found")
+	ErrPackageTypeExists   = errors.New("package type already exists")
+
+	ErrRecipientNotFound    = errors.New("recipient not found")
+	ErrRecipientExists      = errors.New("recipient already exists")
+	ErrRecipientPhoneExists = errors.New("recipient with this phone already exists")
+	ErrRecipientHasOrders   = errors.New("recipient has orders")
+
+	ErrCellNotFound = errors.New("cell not found")
+	ErrCellExists   = errors.New("cell already exists")
+
+	ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
 )
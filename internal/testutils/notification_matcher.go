@@ -0,0 +1,31 @@
+package testutils
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+type NotificationMatcher struct {
+	orderID int64
+	event   domain.NotificationEvent
+}
+
+func (m *NotificationMatcher) Matches(x interface{}) bool {
+	notification, ok := x.(*domain.Notification)
+	if !ok {
+		return false
+	}
+
+	// Сравниваем только заказ и событие
+	return m.orderID == notification.OrderID && m.event == notification.Event
+}
+
+func (m *NotificationMatcher) String() string {
+	return fmt.Sprintf("is notification %q of order %d", m.event, m.orderID)
+}
+
+func NotificationEq(orderID int64, event domain.NotificationEvent) gomock.Matcher {
+	return &NotificationMatcher{orderID: orderID, event: event}
+}
@@ -0,0 +1,31 @@
+package testutils
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+type OrderEventMatcher struct {
+	orderID   int64
+	newStatus domain.OrderStatus
+}
+
+func (m *OrderEventMatcher) Matches(x interface{}) bool {
+	event, ok := x.(*domain.OrderEvent)
+	if !ok {
+		return false
+	}
+
+	// Сравниваем только заказ и новый статус
+	return m.orderID == event.OrderID && m.newStatus == event.NewStatus
+}
+
+func (m *OrderEventMatcher) String() string {
+	return fmt.Sprintf("is event of order %d with new status %q", m.orderID, m.newStatus)
+}
+
+func OrderEventEq(orderID int64, newStatus domain.OrderStatus) gomock.Matcher {
+	return &OrderEventMatcher{orderID: orderID, newStatus: newStatus}
+}
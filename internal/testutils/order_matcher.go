@@ -20,10 +20,14 @@ func (m *OrderMatcher) Matches(x interface{}) bool {
 	// Сравниваем все поля, кроме Hash
 	return m.expected.ID == order.ID &&
 		m.expected.RecipientID == order.RecipientID &&
+		m.expected.CourierID == order.CourierID &&
+		m.expected.PickupPointID == order.PickupPointID &&
 		m.expected.Weight == order.Weight &&
 		m.expected.Cost == order.Cost &&
 		m.expected.PackageCost == order.PackageCost &&
+		m.expected.Currency == order.Currency &&
 		m.expected.PackageType.Type() == order.PackageType.Type() &&
+		m.expected.Status == order.Status &&
 		m.expected.StorageUntil.Equal(order.StorageUntil) &&
 		m.expected.IssuedAt.Time.Equal(order.IssuedAt.Time) &&
 		m.expected.IssuedAt.Valid == order.IssuedAt.Valid &&
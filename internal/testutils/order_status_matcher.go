@@ -0,0 +1,31 @@
+package testutils
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
+)
+
+type OrderStatusMatcher struct {
+	orderID int64
+	status  domain.OrderStatus
+}
+
+func (m *OrderStatusMatcher) Matches(x interface{}) bool {
+	order, ok := x.(*domain.Order)
+	if !ok {
+		return false
+	}
+
+	// Сравниваем только заказ и его статус
+	return m.orderID == order.ID && m.status == order.Status
+}
+
+func (m *OrderStatusMatcher) String() string {
+	return fmt.Sprintf("is order %d with status %q", m.orderID, m.status)
+}
+
+func OrderStatusEq(orderID int64, status domain.OrderStatus) gomock.Matcher {
+	return &OrderStatusMatcher{orderID: orderID, status: status}
+}
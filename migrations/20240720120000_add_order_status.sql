@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT 'accepted';
+
+-- Раньше при приеме заказа issued_at и returned_at заполнялись нулевой датой
+UPDATE orders
+SET issued_at = NULL
+WHERE issued_at = '0001-01-01 00:00:00';
+
+UPDATE orders
+SET returned_at = NULL
+WHERE returned_at = '0001-01-01 00:00:00';
+
+UPDATE orders
+SET status = CASE
+                 WHEN returned_at IS NOT NULL THEN 'returned_by_client'
+                 WHEN issued_at IS NOT NULL THEN 'issued'
+                 WHEN storage_until < NOW() THEN 'expired'
+                 ELSE 'accepted'
+    END;
+
+ALTER TABLE orders
+    ADD CONSTRAINT orders_status_check
+        CHECK (status IN ('accepted', 'issued', 'returned_by_client', 'returned_to_courier', 'expired'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP CONSTRAINT orders_status_check,
+    DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE order_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    order_id   BIGINT       NOT NULL,
+    old_status VARCHAR(32),
+    new_status VARCHAR(32)  NOT NULL,
+    operation  VARCHAR(255) NOT NULL,
+    payload    JSONB,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_events_order_id ON order_events USING BTREE (order_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE order_events;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY idx_partial_returned_to_courier_at ON orders (returned_to_courier_at DESC) WHERE returned_to_courier_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose NO TRANSACTION
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY idx_partial_returned_to_courier_at;
+-- +goose StatementEnd
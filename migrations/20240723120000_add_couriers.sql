@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE couriers
+(
+    id         BIGINT PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE orders
+    ADD COLUMN courier_id BIGINT REFERENCES couriers (id);
+
+CREATE INDEX idx_orders_courier_id ON orders USING BTREE (courier_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN courier_id;
+
+DROP TABLE couriers;
+-- +goose StatementEnd
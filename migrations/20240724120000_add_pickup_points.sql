@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pickup_points
+(
+    id         BIGINT PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    address    TEXT         NOT NULL DEFAULT '',
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+-- Все существующие заказы относятся к единственному ПВЗ, который работал до появления реестра
+INSERT INTO pickup_points (id, name)
+VALUES (1, 'default');
+
+ALTER TABLE orders
+    ADD COLUMN pvz_id BIGINT NOT NULL DEFAULT 1 REFERENCES pickup_points (id);
+
+ALTER TABLE orders
+    ALTER COLUMN pvz_id DROP DEFAULT;
+
+ALTER TABLE order_events
+    ADD COLUMN pvz_id BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE order_events
+    ALTER COLUMN pvz_id DROP DEFAULT;
+
+CREATE INDEX idx_orders_pvz_id_recipient_id ON orders USING BTREE (pvz_id, recipient_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_orders_pvz_id_recipient_id;
+
+ALTER TABLE order_events
+    DROP COLUMN pvz_id;
+
+ALTER TABLE orders
+    DROP COLUMN pvz_id;
+
+DROP TABLE pickup_points;
+-- +goose StatementEnd
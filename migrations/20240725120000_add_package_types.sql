@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE package_types
+(
+    name       VARCHAR(255) PRIMARY KEY,
+    max_weight DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (max_weight >= 0),
+    cost       DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (cost >= 0),
+    active     BOOLEAN          NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP        NOT NULL DEFAULT NOW()
+);
+
+-- Типы упаковки, которые раньше были зашиты в код. max_weight = 0 означает отсутствие ограничения
+INSERT INTO package_types (name, max_weight, cost)
+VALUES ('without package', 0, 0),
+       ('package', 10, 5),
+       ('box', 30, 20),
+       ('film', 0, 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE package_types;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Составная упаковка хранится списком слоев через "+", например 'box+film'
+ALTER TABLE orders
+    ALTER COLUMN package_type TYPE TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    ALTER COLUMN package_type TYPE VARCHAR(255);
+-- +goose StatementEnd
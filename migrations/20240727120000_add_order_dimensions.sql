@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Габариты в сантиметрах; 0 у заказов, принятых до появления габаритов
+ALTER TABLE orders
+    ADD COLUMN length DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (length >= 0),
+    ADD COLUMN width  DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (width >= 0),
+    ADD COLUMN height DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (height >= 0);
+
+-- Ограничения по габаритам; 0 означает отсутствие ограничения
+ALTER TABLE package_types
+    ADD COLUMN max_length         DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (max_length >= 0),
+    ADD COLUMN max_width          DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (max_width >= 0),
+    ADD COLUMN max_height         DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (max_height >= 0),
+    ADD COLUMN volumetric_divisor DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (volumetric_divisor >= 0);
+
+UPDATE package_types
+SET max_length = 60, max_width = 40, max_height = 20, volumetric_divisor = 5000
+WHERE name = 'package';
+
+UPDATE package_types
+SET max_length = 120, max_width = 80, max_height = 80, volumetric_divisor = 5000
+WHERE name = 'box';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE package_types
+    DROP COLUMN max_length,
+    DROP COLUMN max_width,
+    DROP COLUMN max_height,
+    DROP COLUMN volumetric_divisor;
+
+ALTER TABLE orders
+    DROP COLUMN length,
+    DROP COLUMN width,
+    DROP COLUMN height;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Стоимости хранятся в минимальных единицах валюты (копейках), чтобы не терять точность при сверке
+ALTER TABLE orders
+    ALTER COLUMN order_cost TYPE BIGINT USING round(order_cost * 100)::BIGINT,
+    ALTER COLUMN package_cost TYPE BIGINT USING round(package_cost * 100)::BIGINT,
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+
+ALTER TABLE package_types
+    ALTER COLUMN cost TYPE BIGINT USING round(cost * 100)::BIGINT,
+    ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE package_types
+    DROP COLUMN currency,
+    ALTER COLUMN cost TYPE DOUBLE PRECISION USING cost / 100.0;
+
+ALTER TABLE orders
+    DROP COLUMN currency,
+    ALTER COLUMN package_cost TYPE DOUBLE PRECISION USING package_cost / 100.0,
+    ALTER COLUMN order_cost TYPE DOUBLE PRECISION USING order_cost / 100.0;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Плата за хранение сверх срока, начисленная при выдаче заказа, в минимальных единицах валюты заказа
+ALTER TABLE orders
+    ADD COLUMN storage_fee BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN storage_fee;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Исходный срок хранения нужен, чтобы ограничить суммарное продление; сами продления пишутся в историю заказа
+ALTER TABLE orders
+    ADD COLUMN original_storage_until TIMESTAMP,
+    ADD COLUMN extension_count INT NOT NULL DEFAULT 0;
+
+UPDATE orders SET original_storage_until = storage_until;
+
+ALTER TABLE orders
+    ALTER COLUMN original_storage_until SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN extension_count,
+    DROP COLUMN original_storage_until;
+-- +goose StatementEnd
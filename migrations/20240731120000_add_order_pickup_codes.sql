@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Код выдачи хранится только в виде хэша с солью; заказы, принятые раньше, получают код через перевыпуск
+ALTER TABLE orders
+    ADD COLUMN pickup_code_hash TEXT NOT NULL DEFAULT '',
+    ADD COLUMN pickup_code_attempts INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN pickup_code_attempts,
+    DROP COLUMN pickup_code_hash;
+-- +goose StatementEnd
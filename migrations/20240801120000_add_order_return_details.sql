@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Причина возврата, состояние заказа при осмотре и сумма возврата в минимальных единицах валюты заказа
+ALTER TABLE orders
+    ADD COLUMN return_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN return_condition TEXT NOT NULL DEFAULT ''
+        CHECK (return_condition IN ('', 'intact', 'damaged', 'opened')),
+    ADD COLUMN return_note TEXT NOT NULL DEFAULT '',
+    ADD COLUMN refund_amount BIGINT CHECK (refund_amount >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN refund_amount,
+    DROP COLUMN return_note,
+    DROP COLUMN return_condition,
+    DROP COLUMN return_reason;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY idx_partial_return_condition ON orders (pvz_id, return_condition) WHERE status = 'returned_by_client';
+-- +goose StatementEnd
+
+-- +goose NO TRANSACTION
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY idx_partial_return_condition;
+-- +goose StatementEnd
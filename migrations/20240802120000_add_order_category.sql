@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Тег категории товара; по нему выбирается срок возврата заказа клиентом
+ALTER TABLE orders
+    ADD COLUMN category TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN category;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE recipients
+(
+    id                BIGINT PRIMARY KEY,
+    name              TEXT      NOT NULL DEFAULT '',
+    phone             TEXT      NOT NULL DEFAULT '',
+    email             TEXT      NOT NULL DEFAULT '',
+    preferred_channel TEXT      NOT NULL DEFAULT ''
+        CHECK (preferred_channel IN ('', 'sms', 'email')),
+    created_at        TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- получатель ищется по телефону, поэтому телефон не может повторяться; у незарегистрированных получателей его нет
+CREATE UNIQUE INDEX idx_recipients_phone ON recipients (phone) WHERE phone <> '';
+
+-- получатели уже принятых заказов сохраняются без контактов
+INSERT INTO recipients (id)
+SELECT DISTINCT recipient_id
+FROM orders
+ON CONFLICT (id) DO NOTHING;
+
+-- получатель с заказами не удаляется
+ALTER TABLE orders
+    ADD CONSTRAINT fk_orders_recipient_id FOREIGN KEY (recipient_id) REFERENCES recipients (id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP CONSTRAINT fk_orders_recipient_id;
+
+DROP TABLE recipients;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP CONSTRAINT orders_status_check,
+    ADD CONSTRAINT orders_status_check
+        CHECK (status IN ('accepted', 'issued', 'returned_by_client', 'returned_to_courier', 'expired',
+                          'awaiting_courier_return'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE orders
+SET status = 'expired'
+WHERE status = 'awaiting_courier_return';
+
+ALTER TABLE orders
+    DROP CONSTRAINT orders_status_check,
+    ADD CONSTRAINT orders_status_check
+        CHECK (status IN ('accepted', 'issued', 'returned_by_client', 'returned_to_courier', 'expired'));
+-- +goose StatementEnd
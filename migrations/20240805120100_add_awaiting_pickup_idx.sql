@@ -0,0 +1,11 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY idx_partial_awaiting_pickup ON orders (pvz_id, id) WHERE status IN ('accepted', 'expired');
+-- +goose StatementEnd
+
+-- +goose NO TRANSACTION
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY idx_partial_awaiting_pickup;
+-- +goose StatementEnd
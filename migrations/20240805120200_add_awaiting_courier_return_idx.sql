@@ -0,0 +1,11 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY idx_partial_awaiting_courier_return ON orders (pvz_id, courier_id) WHERE status = 'awaiting_courier_return';
+-- +goose StatementEnd
+
+-- +goose NO TRANSACTION
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY idx_partial_awaiting_courier_return;
+-- +goose StatementEnd
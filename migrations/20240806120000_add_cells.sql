@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cells
+(
+    id            BIGSERIAL PRIMARY KEY,
+    pvz_id        BIGINT           NOT NULL REFERENCES pickup_points (id),
+    zone          TEXT             NOT NULL,
+    rack          TEXT             NOT NULL,
+    shelf         TEXT             NOT NULL,
+    max_orders    INTEGER          NOT NULL CHECK (max_orders > 0),
+    max_weight    DOUBLE PRECISION NOT NULL CHECK (max_weight > 0),
+    -- пустой список означает, что ячейка принимает заказы в любой упаковке
+    package_types TEXT[]           NOT NULL DEFAULT '{}',
+    created_at    TIMESTAMP        NOT NULL DEFAULT NOW(),
+    UNIQUE (pvz_id, zone, rack, shelf)
+);
+
+-- заказ, который уже выдан или возвращен курьеру, ячейку не занимает
+ALTER TABLE orders
+    ADD COLUMN cell_id BIGINT REFERENCES cells (id);
+
+CREATE INDEX idx_orders_cell_id ON orders USING BTREE (cell_id) WHERE cell_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN cell_id;
+
+DROP TABLE cells;
+-- +goose StatementEnd
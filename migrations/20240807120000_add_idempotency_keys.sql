@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE idempotency_keys
+(
+    pvz_id      BIGINT    NOT NULL REFERENCES pickup_points (id),
+    key         TEXT      NOT NULL,
+    method      TEXT      NOT NULL,
+    -- fingerprint хэш метода и тела запроса, по которому повтор отличается от другого запроса с тем же ключом
+    fingerprint TEXT      NOT NULL,
+    -- response пустой, пока запрос выполняется
+    response    BYTEA,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMP NOT NULL,
+    PRIMARY KEY (pvz_id, key)
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys USING BTREE (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE idempotency_keys;
+-- +goose StatementEnd
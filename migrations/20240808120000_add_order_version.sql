@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- версия растет при каждом изменении заказа и защищает от перезаписи параллельных изменений
+ALTER TABLE orders
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN version;
+-- +goose StatementEnd
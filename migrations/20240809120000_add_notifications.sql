@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Уведомления получателей хранятся отдельно от outbox событий Kafka,
+-- чтобы ни один из рассыльщиков не забирал чужие строки
+CREATE TABLE notifications
+(
+    id            UUID PRIMARY KEY,
+    payload       BYTEA       NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL,
+    processed     BOOLEAN     NOT NULL DEFAULT FALSE,
+    retry_count   INT         NOT NULL DEFAULT 0,
+    -- claimed_until до этого момента уведомление доставляет один из рассыльщиков
+    claimed_until TIMESTAMPTZ
+);
+
+INSERT INTO notifications (id, payload, created_at, processed, retry_count)
+SELECT id, payload, created_at, COALESCE(processed, FALSE), COALESCE(retry_count, 0)
+FROM outbox
+WHERE topic = 'notifications';
+
+DELETE FROM outbox WHERE topic = 'notifications';
+
+CREATE INDEX idx_partial_notifications_pending ON notifications (created_at) WHERE processed = FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+INSERT INTO outbox (id, payload, topic, created_at, processed, retry_count)
+SELECT id, payload, 'notifications', created_at, processed, retry_count
+FROM notifications;
+
+DROP TABLE notifications;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX CONCURRENTLY idx_partial_outbox_pending;
+-- +goose StatementEnd
+
+-- +goose NO TRANSACTION
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX CONCURRENTLY idx_partial_outbox_pending ON outbox (topic, created_at) WHERE processed = FALSE;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- перевыпуск кода снимает блокировку, поэтому его частота ограничивается по времени последнего перевыпуска
+ALTER TABLE orders
+    ADD COLUMN pickup_code_regenerated_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN pickup_code_regenerated_at;
+-- +goose StatementEnd
@@ -63,6 +63,254 @@ func (m *OrderEntity) validate(all bool) error {
 
 	// no validation rules for StorageUntil
 
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetReturnedToCourierAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "ReturnedToCourierAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "ReturnedToCourierAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnedToCourierAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "ReturnedToCourierAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CourierId
+
+	if all {
+		switch v := interface{}(m.GetOrderCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrderCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "OrderCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackageCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "PackageCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "PackageCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "PackageCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "TotalCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "StorageFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExtensionCount
+
+	// no validation rules for ReturnReason
+
+	// no validation rules for ReturnCondition
+
+	// no validation rules for ReturnNote
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Category
+
+	if all {
+		switch v := interface{}(m.GetReturnWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "ReturnWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "ReturnWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "ReturnWindow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEntityValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEntityValidationError{
+				field:  "ReturnDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CellId
+
 	if len(errors) > 0 {
 		return OrderEntityMultiError(errors)
 	}
@@ -229,6 +477,156 @@ func (m *AcceptOrderRequest) validate(all bool) error {
 
 	// no validation rules for Cost
 
+	if m.GetCourierId() <= 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "CourierId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPackageLayers()) > 5 {
+		err := AcceptOrderRequestValidationError{
+			field:  "PackageLayers",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_AcceptOrderRequest_PackageLayers_Unique := make(map[string]struct{}, len(m.GetPackageLayers()))
+
+	for idx, item := range m.GetPackageLayers() {
+		_, _ = idx, item
+
+		if _, exists := _AcceptOrderRequest_PackageLayers_Unique[item]; exists {
+			err := AcceptOrderRequestValidationError{
+				field:  fmt.Sprintf("PackageLayers[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_AcceptOrderRequest_PackageLayers_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := AcceptOrderRequestValidationError{
+				field:  fmt.Sprintf("PackageLayers[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AcceptOrderRequest_PackageLayers_Pattern.MatchString(item) {
+			err := AcceptOrderRequestValidationError{
+				field:  fmt.Sprintf("PackageLayers[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetLength() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Length",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWidth() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Width",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHeight() < 0 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Height",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrderCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderRequestValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrderCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderRequestValidationError{
+				field:  "OrderCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetCategory()) > 64 {
+		err := AcceptOrderRequestValidationError{
+			field:  "Category",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AcceptOrderRequest_Category_Pattern.MatchString(m.GetCategory()) {
+		err := AcceptOrderRequestValidationError{
+			field:  "Category",
+			reason: "value does not match regex pattern \"^[a-z0-9_-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CreateRecipient
+
 	if m.PackageType != nil {
 
 		if m.GetPackageType() != "" {
@@ -330,6 +728,10 @@ var _ interface {
 
 var _AcceptOrderRequest_PackageType_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]*$")
 
+var _AcceptOrderRequest_PackageLayers_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+var _AcceptOrderRequest_Category_Pattern = regexp.MustCompile("^[a-z0-9_-]*$")
+
 // Validate checks the field values on AcceptOrderResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -356,6 +758,97 @@ func (m *AcceptOrderResponse) validate(all bool) error {
 
 	// no validation rules for OrderId
 
+	if all {
+		switch v := interface{}(m.GetOrderCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrderCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderResponseValidationError{
+				field:  "OrderCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackageCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "PackageCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "PackageCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderResponseValidationError{
+				field:  "PackageCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderResponseValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderResponseValidationError{
+				field:  "TotalCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CellId
+
+	// no validation rules for CellCode
+
 	if len(errors) > 0 {
 		return AcceptOrderResponseMultiError(errors)
 	}
@@ -436,32 +929,32 @@ var _ interface {
 	ErrorName() string
 } = AcceptOrderResponseValidationError{}
 
-// Validate checks the field values on ReturnOrderRequest with the rules
+// Validate checks the field values on AcceptOrdersBatchRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReturnOrderRequest) Validate() error {
+func (m *AcceptOrdersBatchRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AcceptOrdersBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReturnOrderRequestMultiError, or nil if none found.
-func (m *ReturnOrderRequest) ValidateAll() error {
+// AcceptOrdersBatchRequestMultiError, or nil if none found.
+func (m *AcceptOrdersBatchRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnOrderRequest) validate(all bool) error {
+func (m *AcceptOrdersBatchRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderId() <= 0 {
-		err := ReturnOrderRequestValidationError{
-			field:  "OrderId",
-			reason: "value must be greater than 0",
+	if l := len(m.GetOrders()); l < 1 || l > 500 {
+		err := AcceptOrdersBatchRequestValidationError{
+			field:  "Orders",
+			reason: "value must contain between 1 and 500 items, inclusive",
 		}
 		if !all {
 			return err
@@ -469,20 +962,29 @@ func (m *ReturnOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		// skipping validation for orders
+
+	}
+
+	// no validation rules for Atomic
+
 	if len(errors) > 0 {
-		return ReturnOrderRequestMultiError(errors)
+		return AcceptOrdersBatchRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by ReturnOrderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ReturnOrderRequestMultiError []error
+// AcceptOrdersBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptOrdersBatchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptOrdersBatchRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnOrderRequestMultiError) Error() string {
+func (m AcceptOrdersBatchRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -491,11 +993,11 @@ func (m ReturnOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnOrderRequestMultiError) AllErrors() []error { return m }
+func (m AcceptOrdersBatchRequestMultiError) AllErrors() []error { return m }
 
-// ReturnOrderRequestValidationError is the validation error returned by
-// ReturnOrderRequest.Validate if the designated constraints aren't met.
-type ReturnOrderRequestValidationError struct {
+// AcceptOrdersBatchRequestValidationError is the validation error returned by
+// AcceptOrdersBatchRequest.Validate if the designated constraints aren't met.
+type AcceptOrdersBatchRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -503,24 +1005,24 @@ type ReturnOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnOrderRequestValidationError) Field() string { return e.field }
+func (e AcceptOrdersBatchRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnOrderRequestValidationError) Reason() string { return e.reason }
+func (e AcceptOrdersBatchRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnOrderRequestValidationError) Cause() error { return e.cause }
+func (e AcceptOrdersBatchRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnOrderRequestValidationError) Key() bool { return e.key }
+func (e AcceptOrdersBatchRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnOrderRequestValidationError) ErrorName() string {
-	return "ReturnOrderRequestValidationError"
+func (e AcceptOrdersBatchRequestValidationError) ErrorName() string {
+	return "AcceptOrdersBatchRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReturnOrderRequestValidationError) Error() string {
+func (e AcceptOrdersBatchRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -532,14 +1034,14 @@ func (e ReturnOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnOrderRequest.%s: %s%s",
+		"invalid %sAcceptOrdersBatchRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnOrderRequestValidationError{}
+var _ error = AcceptOrdersBatchRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -547,24 +1049,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnOrderRequestValidationError{}
+} = AcceptOrdersBatchRequestValidationError{}
 
-// Validate checks the field values on ReturnOrderResponse with the rules
+// Validate checks the field values on AcceptOrdersBatchResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReturnOrderResponse) Validate() error {
+func (m *AcceptOrdersBatchResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AcceptOrdersBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReturnOrderResponseMultiError, or nil if none found.
-func (m *ReturnOrderResponse) ValidateAll() error {
+// AcceptOrdersBatchResponseMultiError, or nil if none found.
+func (m *AcceptOrdersBatchResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnOrderResponse) validate(all bool) error {
+func (m *AcceptOrdersBatchResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -573,22 +1075,56 @@ func (m *ReturnOrderResponse) validate(all bool) error {
 
 	// no validation rules for Message
 
-	// no validation rules for OrderId
+	// no validation rules for AcceptedCount
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AcceptOrdersBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AcceptOrdersBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AcceptOrdersBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return ReturnOrderResponseMultiError(errors)
+		return AcceptOrdersBatchResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnOrderResponseMultiError is an error wrapping multiple validation
-// errors returned by ReturnOrderResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ReturnOrderResponseMultiError []error
+// AcceptOrdersBatchResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptOrdersBatchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AcceptOrdersBatchResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnOrderResponseMultiError) Error() string {
+func (m AcceptOrdersBatchResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -597,11 +1133,11 @@ func (m ReturnOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnOrderResponseMultiError) AllErrors() []error { return m }
+func (m AcceptOrdersBatchResponseMultiError) AllErrors() []error { return m }
 
-// ReturnOrderResponseValidationError is the validation error returned by
-// ReturnOrderResponse.Validate if the designated constraints aren't met.
-type ReturnOrderResponseValidationError struct {
+// AcceptOrdersBatchResponseValidationError is the validation error returned by
+// AcceptOrdersBatchResponse.Validate if the designated constraints aren't met.
+type AcceptOrdersBatchResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -609,24 +1145,24 @@ type ReturnOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnOrderResponseValidationError) Field() string { return e.field }
+func (e AcceptOrdersBatchResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnOrderResponseValidationError) Reason() string { return e.reason }
+func (e AcceptOrdersBatchResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnOrderResponseValidationError) Cause() error { return e.cause }
+func (e AcceptOrdersBatchResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnOrderResponseValidationError) Key() bool { return e.key }
+func (e AcceptOrdersBatchResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnOrderResponseValidationError) ErrorName() string {
-	return "ReturnOrderResponseValidationError"
+func (e AcceptOrdersBatchResponseValidationError) ErrorName() string {
+	return "AcceptOrdersBatchResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReturnOrderResponseValidationError) Error() string {
+func (e AcceptOrdersBatchResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -638,14 +1174,14 @@ func (e ReturnOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnOrderResponse.%s: %s%s",
+		"invalid %sAcceptOrdersBatchResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnOrderResponseValidationError{}
+var _ error = AcceptOrdersBatchResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -653,76 +1189,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnOrderResponseValidationError{}
+} = AcceptOrdersBatchResponseValidationError{}
 
-// Validate checks the field values on IssueOrderRequest with the rules defined
+// Validate checks the field values on AcceptOrderResult with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *IssueOrderRequest) Validate() error {
+func (m *AcceptOrderResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueOrderRequest with the rules
+// ValidateAll checks the field values on AcceptOrderResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueOrderRequestMultiError, or nil if none found.
-func (m *IssueOrderRequest) ValidateAll() error {
+// AcceptOrderResultMultiError, or nil if none found.
+func (m *AcceptOrderResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueOrderRequest) validate(all bool) error {
+func (m *AcceptOrderResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetOrderIds()) < 1 {
-		err := IssueOrderRequestValidationError{
-			field:  "OrderIds",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for OrderId
 
-	_IssueOrderRequest_OrderIds_Unique := make(map[int64]struct{}, len(m.GetOrderIds()))
+	// no validation rules for Status
 
-	for idx, item := range m.GetOrderIds() {
-		_, _ = idx, item
+	// no validation rules for Reason
 
-		if _, exists := _IssueOrderRequest_OrderIds_Unique[item]; exists {
-			err := IssueOrderRequestValidationError{
-				field:  fmt.Sprintf("OrderIds[%v]", idx),
-				reason: "repeated value must contain unique items",
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderResultValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderResultValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderResultValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
-		} else {
-			_IssueOrderRequest_OrderIds_Unique[item] = struct{}{}
 		}
-
-		// no validation rules for OrderIds[idx]
 	}
 
 	if len(errors) > 0 {
-		return IssueOrderRequestMultiError(errors)
+		return AcceptOrderResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by IssueOrderRequest.ValidateAll() if the designated constraints
+// AcceptOrderResultMultiError is an error wrapping multiple validation errors
+// returned by AcceptOrderResult.ValidateAll() if the designated constraints
 // aren't met.
-type IssueOrderRequestMultiError []error
+type AcceptOrderResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueOrderRequestMultiError) Error() string {
+func (m AcceptOrderResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -731,11 +1270,11 @@ func (m IssueOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueOrderRequestMultiError) AllErrors() []error { return m }
+func (m AcceptOrderResultMultiError) AllErrors() []error { return m }
 
-// IssueOrderRequestValidationError is the validation error returned by
-// IssueOrderRequest.Validate if the designated constraints aren't met.
-type IssueOrderRequestValidationError struct {
+// AcceptOrderResultValidationError is the validation error returned by
+// AcceptOrderResult.Validate if the designated constraints aren't met.
+type AcceptOrderResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -743,24 +1282,24 @@ type IssueOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueOrderRequestValidationError) Field() string { return e.field }
+func (e AcceptOrderResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueOrderRequestValidationError) Reason() string { return e.reason }
+func (e AcceptOrderResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueOrderRequestValidationError) Cause() error { return e.cause }
+func (e AcceptOrderResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueOrderRequestValidationError) Key() bool { return e.key }
+func (e AcceptOrderResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueOrderRequestValidationError) ErrorName() string {
-	return "IssueOrderRequestValidationError"
+func (e AcceptOrderResultValidationError) ErrorName() string {
+	return "AcceptOrderResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueOrderRequestValidationError) Error() string {
+func (e AcceptOrderResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -772,14 +1311,14 @@ func (e IssueOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueOrderRequest.%s: %s%s",
+		"invalid %sAcceptOrderResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueOrderRequestValidationError{}
+var _ error = AcceptOrderResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -787,48 +1326,7911 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueOrderRequestValidationError{}
+} = AcceptOrderResultValidationError{}
 
-// Validate checks the field values on IssueOrderResponse with the rules
+// Validate checks the field values on ReturnOrderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *IssueOrderResponse) Validate() error {
+func (m *ReturnOrderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on IssueOrderResponse with the rules
+// ValidateAll checks the field values on ReturnOrderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// IssueOrderResponseMultiError, or nil if none found.
-func (m *IssueOrderResponse) ValidateAll() error {
+// ReturnOrderRequestMultiError, or nil if none found.
+func (m *ReturnOrderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *IssueOrderResponse) validate(all bool) error {
+func (m *ReturnOrderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	if m.GetOrderId() <= 0 {
+		err := ReturnOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for OrderId
+	if m.GetCourierId() <= 0 {
+		err := ReturnOrderRequestValidationError{
+			field:  "CourierId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return IssueOrderResponseMultiError(errors)
+		return ReturnOrderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// IssueOrderResponseMultiError is an error wrapping multiple validation errors
-// returned by IssueOrderResponse.ValidateAll() if the designated constraints
+// ReturnOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by ReturnOrderRequest.ValidateAll() if the designated constraints
 // aren't met.
-type IssueOrderResponseMultiError []error
+type ReturnOrderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m IssueOrderResponseMultiError) Error() string {
+func (m ReturnOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnOrderRequestMultiError) AllErrors() []error { return m }
+
+// ReturnOrderRequestValidationError is the validation error returned by
+// ReturnOrderRequest.Validate if the designated constraints aren't met.
+type ReturnOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnOrderRequestValidationError) ErrorName() string {
+	return "ReturnOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnOrderRequestValidationError{}
+
+// Validate checks the field values on ReturnOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnOrderResponseMultiError, or nil if none found.
+func (m *ReturnOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return ReturnOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by ReturnOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReturnOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnOrderResponseMultiError) AllErrors() []error { return m }
+
+// ReturnOrderResponseValidationError is the validation error returned by
+// ReturnOrderResponse.Validate if the designated constraints aren't met.
+type ReturnOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnOrderResponseValidationError) ErrorName() string {
+	return "ReturnOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnOrderResponseValidationError{}
+
+// Validate checks the field values on IssueOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IssueOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueOrderRequestMultiError, or nil if none found.
+func (m *IssueOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOrderIds()) < 1 {
+		err := IssueOrderRequestValidationError{
+			field:  "OrderIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_IssueOrderRequest_OrderIds_Unique := make(map[int64]struct{}, len(m.GetOrderIds()))
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if _, exists := _IssueOrderRequest_OrderIds_Unique[item]; exists {
+			err := IssueOrderRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_IssueOrderRequest_OrderIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for OrderIds[idx]
+	}
+
+	if m.GetRecipientId() < 0 {
+		err := IssueOrderRequestValidationError{
+			field:  "RecipientId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Atomic
+
+	{
+		sorted_keys := make([]int64, len(m.GetPickupCodes()))
+		i := 0
+		for key := range m.GetPickupCodes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPickupCodes()[key]
+			_ = val
+
+			// no validation rules for PickupCodes[key]
+
+			if !_IssueOrderRequest_PickupCodes_Pattern.MatchString(val) {
+				err := IssueOrderRequestValidationError{
+					field:  fmt.Sprintf("PickupCodes[%v]", key),
+					reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return IssueOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by IssueOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IssueOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueOrderRequestMultiError) AllErrors() []error { return m }
+
+// IssueOrderRequestValidationError is the validation error returned by
+// IssueOrderRequest.Validate if the designated constraints aren't met.
+type IssueOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueOrderRequestValidationError) ErrorName() string {
+	return "IssueOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueOrderRequestValidationError{}
+
+var _IssueOrderRequest_PickupCodes_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on IssueOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IssueOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueOrderResponseMultiError, or nil if none found.
+func (m *IssueOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for OrderId
+
+	for idx, item := range m.GetStorageFees() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("StorageFees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("StorageFees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssueOrderResponseValidationError{
+					field:  fmt.Sprintf("StorageFees[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalStorageFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueOrderResponseValidationError{
+					field:  "TotalStorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueOrderResponseValidationError{
+					field:  "TotalStorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalStorageFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueOrderResponseValidationError{
+				field:  "TotalStorageFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssueOrderResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssueOrderResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IssueOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by IssueOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IssueOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueOrderResponseMultiError) AllErrors() []error { return m }
+
+// IssueOrderResponseValidationError is the validation error returned by
+// IssueOrderResponse.Validate if the designated constraints aren't met.
+type IssueOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueOrderResponseValidationError) ErrorName() string {
+	return "IssueOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssueOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueOrderResponseValidationError{}
+
+// Validate checks the field values on IssueOrderResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IssueOrderResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueOrderResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IssueOrderResultMultiError, or nil if none found.
+func (m *IssueOrderResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueOrderResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStorageFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueOrderResultValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueOrderResultValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueOrderResultValidationError{
+				field:  "StorageFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IssueOrderResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueOrderResultMultiError is an error wrapping multiple validation errors
+// returned by IssueOrderResult.ValidateAll() if the designated constraints
+// aren't met.
+type IssueOrderResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueOrderResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueOrderResultMultiError) AllErrors() []error { return m }
+
+// IssueOrderResultValidationError is the validation error returned by
+// IssueOrderResult.Validate if the designated constraints aren't met.
+type IssueOrderResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueOrderResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueOrderResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueOrderResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueOrderResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueOrderResultValidationError) ErrorName() string { return "IssueOrderResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueOrderResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueOrderResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueOrderResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueOrderResultValidationError{}
+
+// Validate checks the field values on StorageFeeEntity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StorageFeeEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageFeeEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageFeeEntityMultiError, or nil if none found.
+func (m *StorageFeeEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageFeeEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for OverdueDays
+
+	// no validation rules for FreeDays
+
+	// no validation rules for ChargeableDays
+
+	if all {
+		switch v := interface{}(m.GetDailyRate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageFeeEntityValidationError{
+					field:  "DailyRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageFeeEntityValidationError{
+					field:  "DailyRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDailyRate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageFeeEntityValidationError{
+				field:  "DailyRate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageFeeEntityValidationError{
+					field:  "Cap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageFeeEntityValidationError{
+					field:  "Cap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageFeeEntityValidationError{
+				field:  "Cap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Capped
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageFeeEntityValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageFeeEntityValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageFeeEntityValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageFeeEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageFeeEntityMultiError is an error wrapping multiple validation errors
+// returned by StorageFeeEntity.ValidateAll() if the designated constraints
+// aren't met.
+type StorageFeeEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageFeeEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageFeeEntityMultiError) AllErrors() []error { return m }
+
+// StorageFeeEntityValidationError is the validation error returned by
+// StorageFeeEntity.Validate if the designated constraints aren't met.
+type StorageFeeEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageFeeEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageFeeEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageFeeEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageFeeEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageFeeEntityValidationError) ErrorName() string { return "StorageFeeEntityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageFeeEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageFeeEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageFeeEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageFeeEntityValidationError{}
+
+// Validate checks the field values on ListOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersRequestMultiError, or nil if none found.
+func (m *ListOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofRecipientPresent := false
+	switch v := m.Recipient.(type) {
+	case *ListOrdersRequest_RecipientId:
+		if v == nil {
+			err := ListOrdersRequestValidationError{
+				field:  "Recipient",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRecipientPresent = true
+
+		if m.GetRecipientId() <= 0 {
+			err := ListOrdersRequestValidationError{
+				field:  "RecipientId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ListOrdersRequest_Phone:
+		if v == nil {
+			err := ListOrdersRequestValidationError{
+				field:  "Recipient",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofRecipientPresent = true
+
+		if l := utf8.RuneCountInString(m.GetPhone()); l < 1 || l > 32 {
+			err := ListOrdersRequestValidationError{
+				field:  "Phone",
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofRecipientPresent {
+		err := ListOrdersRequestValidationError{
+			field:  "Recipient",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if len(errors) > 0 {
+		return ListOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ListOrdersRequestValidationError is the validation error returned by
+// ListOrdersRequest.Validate if the designated constraints aren't met.
+type ListOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersRequestValidationError) ErrorName() string {
+	return "ListOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersRequestValidationError{}
+
+// Validate checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrdersResponseMultiError, or nil if none found.
+func (m *ListOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ListOrdersResponseValidationError is the validation error returned by
+// ListOrdersResponse.Validate if the designated constraints aren't met.
+type ListOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrdersResponseValidationError) ErrorName() string {
+	return "ListOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrdersResponseValidationError{}
+
+// Validate checks the field values on AcceptReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptReturnRequestMultiError, or nil if none found.
+func (m *AcceptReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := AcceptReturnRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRecipientId() <= 0 {
+		err := AcceptReturnRequestValidationError{
+			field:  "RecipientId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) < 1 {
+		err := AcceptReturnRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AcceptReturnRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := AcceptReturnRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [intact damaged opened]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 1000 {
+		err := AcceptReturnRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptReturnRequestValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptReturnRequestValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptReturnRequestValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptReturnRequestMultiError) AllErrors() []error { return m }
+
+// AcceptReturnRequestValidationError is the validation error returned by
+// AcceptReturnRequest.Validate if the designated constraints aren't met.
+type AcceptReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptReturnRequestValidationError) ErrorName() string {
+	return "AcceptReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptReturnRequestValidationError{}
+
+var _AcceptReturnRequest_Condition_InLookup = map[string]struct{}{
+	"intact":  {},
+	"damaged": {},
+	"opened":  {},
+}
+
+// Validate checks the field values on AcceptReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptReturnResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptReturnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptReturnResponseMultiError, or nil if none found.
+func (m *AcceptReturnResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptReturnResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return AcceptReturnResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptReturnResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptReturnResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptReturnResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptReturnResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptReturnResponseMultiError) AllErrors() []error { return m }
+
+// AcceptReturnResponseValidationError is the validation error returned by
+// AcceptReturnResponse.Validate if the designated constraints aren't met.
+type AcceptReturnResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptReturnResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptReturnResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptReturnResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptReturnResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptReturnResponseValidationError) ErrorName() string {
+	return "AcceptReturnResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptReturnResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptReturnResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptReturnResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptReturnResponseValidationError{}
+
+// Validate checks the field values on ReturnListRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReturnListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnListRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnListRequestMultiError, or nil if none found.
+func (m *ReturnListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() <= 0 {
+		err := ReturnListRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReturnListRequest_Condition_InLookup[m.GetCondition()]; !ok {
+		err := ReturnListRequestValidationError{
+			field:  "Condition",
+			reason: "value must be in list [ intact damaged opened]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+		// no validation rules for Limit
+	}
+
+	if len(errors) > 0 {
+		return ReturnListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnListRequestMultiError is an error wrapping multiple validation errors
+// returned by ReturnListRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnListRequestMultiError) AllErrors() []error { return m }
+
+// ReturnListRequestValidationError is the validation error returned by
+// ReturnListRequest.Validate if the designated constraints aren't met.
+type ReturnListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnListRequestValidationError) ErrorName() string {
+	return "ReturnListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnListRequestValidationError{}
+
+var _ReturnListRequest_Condition_InLookup = map[string]struct{}{
+	"":        {},
+	"intact":  {},
+	"damaged": {},
+	"opened":  {},
+}
+
+// Validate checks the field values on ReturnListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnListResponseMultiError, or nil if none found.
+func (m *ReturnListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnListResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnListResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnListResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReturnListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnListResponseMultiError is an error wrapping multiple validation errors
+// returned by ReturnListResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnListResponseMultiError) AllErrors() []error { return m }
+
+// ReturnListResponseValidationError is the validation error returned by
+// ReturnListResponse.Validate if the designated constraints aren't met.
+type ReturnListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnListResponseValidationError) ErrorName() string {
+	return "ReturnListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnListResponseValidationError{}
+
+// Validate checks the field values on OrderDetailsEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrderDetailsEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderDetailsEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderDetailsEntityMultiError, or nil if none found.
+func (m *OrderDetailsEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderDetailsEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for PickupPointId
+
+	// no validation rules for CourierId
+
+	// no validation rules for CellId
+
+	// no validation rules for Status
+
+	// no validation rules for Weight
+
+	// no validation rules for Length
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for PackageType
+
+	// no validation rules for Category
+
+	if all {
+		switch v := interface{}(m.GetOrderCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "OrderCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrderCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "OrderCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackageCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "PackageCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "PackageCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "PackageCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "TotalCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "StorageFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "StorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "StorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "StorageUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOriginalStorageUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "OriginalStorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "OriginalStorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOriginalStorageUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "OriginalStorageUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExtensionCount
+
+	// no validation rules for PickupCodeAttempts
+
+	if all {
+		switch v := interface{}(m.GetIssuedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "IssuedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetIssuedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "IssuedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "ReturnedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnedToCourierAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnedToCourierAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnedToCourierAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnedToCourierAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "ReturnedToCourierAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReturnReason
+
+	// no validation rules for ReturnCondition
+
+	// no validation rules for ReturnNote
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "ReturnWindow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReturnDeadline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDetailsEntityValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnDeadline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDetailsEntityValidationError{
+				field:  "ReturnDeadline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Hash
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return OrderDetailsEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderDetailsEntityMultiError is an error wrapping multiple validation errors
+// returned by OrderDetailsEntity.ValidateAll() if the designated constraints
+// aren't met.
+type OrderDetailsEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderDetailsEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderDetailsEntityMultiError) AllErrors() []error { return m }
+
+// OrderDetailsEntityValidationError is the validation error returned by
+// OrderDetailsEntity.Validate if the designated constraints aren't met.
+type OrderDetailsEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderDetailsEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderDetailsEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderDetailsEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderDetailsEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderDetailsEntityValidationError) ErrorName() string {
+	return "OrderDetailsEntityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderDetailsEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderDetailsEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderDetailsEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderDetailsEntityValidationError{}
+
+// Validate checks the field values on GetOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderRequestMultiError, or nil if none found.
+func (m *GetOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := GetOrderRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderRequestValidationError is the validation error returned by
+// GetOrderRequest.Validate if the designated constraints aren't met.
+type GetOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderRequestValidationError) ErrorName() string { return "GetOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderRequestValidationError{}
+
+// Validate checks the field values on GetOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderResponseMultiError, or nil if none found.
+func (m *GetOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetOrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetOrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetOrderResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderResponseValidationError is the validation error returned by
+// GetOrderResponse.Validate if the designated constraints aren't met.
+type GetOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderResponseValidationError) ErrorName() string { return "GetOrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderResponseValidationError{}
+
+// Validate checks the field values on OrderEventEntity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderEventEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderEventEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderEventEntityMultiError, or nil if none found.
+func (m *OrderEventEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderEventEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OrderId
+
+	// no validation rules for OldStatus
+
+	// no validation rules for NewStatus
+
+	// no validation rules for Operation
+
+	// no validation rules for Payload
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEventEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEventEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEventEntityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderEventEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderEventEntityMultiError is an error wrapping multiple validation errors
+// returned by OrderEventEntity.ValidateAll() if the designated constraints
+// aren't met.
+type OrderEventEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderEventEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderEventEntityMultiError) AllErrors() []error { return m }
+
+// OrderEventEntityValidationError is the validation error returned by
+// OrderEventEntity.Validate if the designated constraints aren't met.
+type OrderEventEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderEventEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderEventEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderEventEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderEventEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderEventEntityValidationError) ErrorName() string { return "OrderEventEntityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderEventEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderEventEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderEventEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderEventEntityValidationError{}
+
+// Validate checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := GetOrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryRequestValidationError{}
+
+// Validate checks the field values on GetOrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryResponseValidationError{}
+
+// Validate checks the field values on ListCourierReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCourierReturnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCourierReturnsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCourierReturnsRequestMultiError, or nil if none found.
+func (m *ListCourierReturnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCourierReturnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFrom() == nil {
+		err := ListCourierReturnsRequestValidationError{
+			field:  "From",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTo() == nil {
+		err := ListCourierReturnsRequestValidationError{
+			field:  "To",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCourierReturnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCourierReturnsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCourierReturnsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListCourierReturnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCourierReturnsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCourierReturnsRequestMultiError) AllErrors() []error { return m }
+
+// ListCourierReturnsRequestValidationError is the validation error returned by
+// ListCourierReturnsRequest.Validate if the designated constraints aren't met.
+type ListCourierReturnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCourierReturnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCourierReturnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCourierReturnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCourierReturnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCourierReturnsRequestValidationError) ErrorName() string {
+	return "ListCourierReturnsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCourierReturnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCourierReturnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCourierReturnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCourierReturnsRequestValidationError{}
+
+// Validate checks the field values on ListCourierReturnsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCourierReturnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCourierReturnsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCourierReturnsResponseMultiError, or nil if none found.
+func (m *ListCourierReturnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCourierReturnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCourierReturnsResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCourierReturnsResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCourierReturnsResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCourierReturnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCourierReturnsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCourierReturnsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCourierReturnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCourierReturnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCourierReturnsResponseMultiError) AllErrors() []error { return m }
+
+// ListCourierReturnsResponseValidationError is the validation error returned
+// by ListCourierReturnsResponse.Validate if the designated constraints aren't met.
+type ListCourierReturnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCourierReturnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCourierReturnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCourierReturnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCourierReturnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCourierReturnsResponseValidationError) ErrorName() string {
+	return "ListCourierReturnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCourierReturnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCourierReturnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCourierReturnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCourierReturnsResponseValidationError{}
+
+// Validate checks the field values on GetReturnManifestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnManifestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnManifestRequestMultiError, or nil if none found.
+func (m *GetReturnManifestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnManifestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCourierId() <= 0 {
+		err := GetReturnManifestRequestValidationError{
+			field:  "CourierId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReturnManifestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnManifestRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReturnManifestRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReturnManifestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnManifestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnManifestRequestMultiError) AllErrors() []error { return m }
+
+// GetReturnManifestRequestValidationError is the validation error returned by
+// GetReturnManifestRequest.Validate if the designated constraints aren't met.
+type GetReturnManifestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnManifestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnManifestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnManifestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnManifestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnManifestRequestValidationError) ErrorName() string {
+	return "GetReturnManifestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnManifestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnManifestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnManifestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnManifestRequestValidationError{}
+
+// Validate checks the field values on GetReturnManifestResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnManifestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnManifestResponseMultiError, or nil if none found.
+func (m *GetReturnManifestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnManifestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CourierId
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReturnManifestResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReturnManifestResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReturnManifestResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReturnManifestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnManifestResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReturnManifestResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetReturnManifestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnManifestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnManifestResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnManifestResponseValidationError is the validation error returned by
+// GetReturnManifestResponse.Validate if the designated constraints aren't met.
+type GetReturnManifestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnManifestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnManifestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnManifestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnManifestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnManifestResponseValidationError) ErrorName() string {
+	return "GetReturnManifestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnManifestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnManifestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnManifestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnManifestResponseValidationError{}
+
+// Validate checks the field values on ConfirmReturnManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmReturnManifestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmReturnManifestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmReturnManifestRequestMultiError, or nil if none found.
+func (m *ConfirmReturnManifestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmReturnManifestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCourierId() <= 0 {
+		err := ConfirmReturnManifestRequestValidationError{
+			field:  "CourierId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOrderIds()) < 1 {
+		err := ConfirmReturnManifestRequestValidationError{
+			field:  "OrderIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ConfirmReturnManifestRequest_OrderIds_Unique := make(map[int64]struct{}, len(m.GetOrderIds()))
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if _, exists := _ConfirmReturnManifestRequest_OrderIds_Unique[item]; exists {
+			err := ConfirmReturnManifestRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ConfirmReturnManifestRequest_OrderIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for OrderIds[idx]
+	}
+
+	if len(errors) > 0 {
+		return ConfirmReturnManifestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmReturnManifestRequestMultiError is an error wrapping multiple
+// validation errors returned by ConfirmReturnManifestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ConfirmReturnManifestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmReturnManifestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmReturnManifestRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmReturnManifestRequestValidationError is the validation error returned
+// by ConfirmReturnManifestRequest.Validate if the designated constraints
+// aren't met.
+type ConfirmReturnManifestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmReturnManifestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmReturnManifestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmReturnManifestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmReturnManifestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmReturnManifestRequestValidationError) ErrorName() string {
+	return "ConfirmReturnManifestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmReturnManifestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmReturnManifestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmReturnManifestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmReturnManifestRequestValidationError{}
+
+// Validate checks the field values on ConfirmReturnManifestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmReturnManifestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmReturnManifestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ConfirmReturnManifestResponseMultiError, or nil if none found.
+func (m *ConfirmReturnManifestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmReturnManifestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ConfirmReturnManifestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmReturnManifestResponseMultiError is an error wrapping multiple
+// validation errors returned by ConfirmReturnManifestResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ConfirmReturnManifestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmReturnManifestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmReturnManifestResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmReturnManifestResponseValidationError is the validation error
+// returned by ConfirmReturnManifestResponse.Validate if the designated
+// constraints aren't met.
+type ConfirmReturnManifestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmReturnManifestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmReturnManifestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmReturnManifestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmReturnManifestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmReturnManifestResponseValidationError) ErrorName() string {
+	return "ConfirmReturnManifestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmReturnManifestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmReturnManifestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmReturnManifestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmReturnManifestResponseValidationError{}
+
+// Validate checks the field values on PackageTypeEntity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PackageTypeEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackageTypeEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PackageTypeEntityMultiError, or nil if none found.
+func (m *PackageTypeEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackageTypeEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for MaxWeight
+
+	// no validation rules for Active
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageTypeEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageTypeEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageTypeEntityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxLength
+
+	// no validation rules for MaxWidth
+
+	// no validation rules for MaxHeight
+
+	// no validation rules for VolumetricDivisor
+
+	if all {
+		switch v := interface{}(m.GetCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageTypeEntityValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageTypeEntityValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageTypeEntityValidationError{
+				field:  "Cost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PackageTypeEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackageTypeEntityMultiError is an error wrapping multiple validation errors
+// returned by PackageTypeEntity.ValidateAll() if the designated constraints
+// aren't met.
+type PackageTypeEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackageTypeEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackageTypeEntityMultiError) AllErrors() []error { return m }
+
+// PackageTypeEntityValidationError is the validation error returned by
+// PackageTypeEntity.Validate if the designated constraints aren't met.
+type PackageTypeEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackageTypeEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackageTypeEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackageTypeEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackageTypeEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackageTypeEntityValidationError) ErrorName() string {
+	return "PackageTypeEntityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PackageTypeEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackageTypeEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackageTypeEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackageTypeEntityValidationError{}
+
+// Validate checks the field values on ListPackageTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageTypesRequestMultiError, or nil if none found.
+func (m *ListPackageTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListPackageTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPackageTypesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPackageTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageTypesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageTypesRequestMultiError) AllErrors() []error { return m }
+
+// ListPackageTypesRequestValidationError is the validation error returned by
+// ListPackageTypesRequest.Validate if the designated constraints aren't met.
+type ListPackageTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageTypesRequestValidationError) ErrorName() string {
+	return "ListPackageTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageTypesRequestValidationError{}
+
+// Validate checks the field values on ListPackageTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageTypesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageTypesResponseMultiError, or nil if none found.
+func (m *ListPackageTypesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageTypesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPackageTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPackageTypesResponseValidationError{
+						field:  fmt.Sprintf("PackageTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPackageTypesResponseValidationError{
+						field:  fmt.Sprintf("PackageTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPackageTypesResponseValidationError{
+					field:  fmt.Sprintf("PackageTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPackageTypesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageTypesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPackageTypesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPackageTypesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageTypesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageTypesResponseMultiError) AllErrors() []error { return m }
+
+// ListPackageTypesResponseValidationError is the validation error returned by
+// ListPackageTypesResponse.Validate if the designated constraints aren't met.
+type ListPackageTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageTypesResponseValidationError) ErrorName() string {
+	return "ListPackageTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageTypesResponseValidationError{}
+
+// Validate checks the field values on CreatePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePackageTypeRequestMultiError, or nil if none found.
+func (m *CreatePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreatePackageTypeRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWeight() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "MaxWeight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxLength() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "MaxLength",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWidth() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "MaxWidth",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxHeight() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "MaxHeight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVolumetricDivisor() < 0 {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "VolumetricDivisor",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePackageTypeRequestValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePackageTypeRequestValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePackageTypeRequestValidationError{
+				field:  "Cost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePackageTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// CreatePackageTypeRequestValidationError is the validation error returned by
+// CreatePackageTypeRequest.Validate if the designated constraints aren't met.
+type CreatePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePackageTypeRequestValidationError) ErrorName() string {
+	return "CreatePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePackageTypeRequestValidationError{}
+
+var _CreatePackageTypeRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+// Validate checks the field values on CreatePackageTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePackageTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePackageTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePackageTypeResponseMultiError, or nil if none found.
+func (m *CreatePackageTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePackageTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetPackageType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePackageTypeResponseValidationError{
+				field:  "PackageType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePackageTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePackageTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePackageTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreatePackageTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePackageTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePackageTypeResponseMultiError) AllErrors() []error { return m }
+
+// CreatePackageTypeResponseValidationError is the validation error returned by
+// CreatePackageTypeResponse.Validate if the designated constraints aren't met.
+type CreatePackageTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePackageTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePackageTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePackageTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePackageTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePackageTypeResponseValidationError) ErrorName() string {
+	return "CreatePackageTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePackageTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePackageTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePackageTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePackageTypeResponseValidationError{}
+
+// Validate checks the field values on DeactivatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeactivatePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeactivatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeactivatePackageTypeRequestMultiError, or nil if none found.
+func (m *DeactivatePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeactivatePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := DeactivatePackageTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeactivatePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeactivatePackageTypeRequestMultiError is an error wrapping multiple
+// validation errors returned by DeactivatePackageTypeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeactivatePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeactivatePackageTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeactivatePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// DeactivatePackageTypeRequestValidationError is the validation error returned
+// by DeactivatePackageTypeRequest.Validate if the designated constraints
+// aren't met.
+type DeactivatePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeactivatePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeactivatePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeactivatePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeactivatePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeactivatePackageTypeRequestValidationError) ErrorName() string {
+	return "DeactivatePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeactivatePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeactivatePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeactivatePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeactivatePackageTypeRequestValidationError{}
+
+// Validate checks the field values on DeactivatePackageTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeactivatePackageTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeactivatePackageTypeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeactivatePackageTypeResponseMultiError, or nil if none found.
+func (m *DeactivatePackageTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeactivatePackageTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return DeactivatePackageTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeactivatePackageTypeResponseMultiError is an error wrapping multiple
+// validation errors returned by DeactivatePackageTypeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeactivatePackageTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeactivatePackageTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeactivatePackageTypeResponseMultiError) AllErrors() []error { return m }
+
+// DeactivatePackageTypeResponseValidationError is the validation error
+// returned by DeactivatePackageTypeResponse.Validate if the designated
+// constraints aren't met.
+type DeactivatePackageTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeactivatePackageTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeactivatePackageTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeactivatePackageTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeactivatePackageTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeactivatePackageTypeResponseValidationError) ErrorName() string {
+	return "DeactivatePackageTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeactivatePackageTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeactivatePackageTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeactivatePackageTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeactivatePackageTypeResponseValidationError{}
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAmount() < 0 {
+		err := MoneyValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCurrency() != "" {
+
+		if !_Money_Currency_Pattern.MatchString(m.GetCurrency()) {
+			err := MoneyValidationError{
+				field:  "Currency",
+				reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
+var _Money_Currency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// Validate checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageRequestMultiError, or nil if none found.
+func (m *ExtendStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := ExtendStorageRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDays() <= 0 {
+		err := ExtendStorageRequestValidationError{
+			field:  "Days",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExtendStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageRequestMultiError) AllErrors() []error { return m }
+
+// ExtendStorageRequestValidationError is the validation error returned by
+// ExtendStorageRequest.Validate if the designated constraints aren't met.
+type ExtendStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageRequestValidationError) ErrorName() string {
+	return "ExtendStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageRequestValidationError{}
+
+// Validate checks the field values on ExtendStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageResponseMultiError, or nil if none found.
+func (m *ExtendStorageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendStorageResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendStorageResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendStorageResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExtendStorageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageResponseMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageResponseMultiError) AllErrors() []error { return m }
+
+// ExtendStorageResponseValidationError is the validation error returned by
+// ExtendStorageResponse.Validate if the designated constraints aren't met.
+type ExtendStorageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageResponseValidationError) ErrorName() string {
+	return "ExtendStorageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageResponseValidationError{}
+
+// Validate checks the field values on RegeneratePickupCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegeneratePickupCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegeneratePickupCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegeneratePickupCodeRequestMultiError, or nil if none found.
+func (m *RegeneratePickupCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegeneratePickupCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := RegeneratePickupCodeRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RegeneratePickupCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegeneratePickupCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by RegeneratePickupCodeRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RegeneratePickupCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegeneratePickupCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegeneratePickupCodeRequestMultiError) AllErrors() []error { return m }
+
+// RegeneratePickupCodeRequestValidationError is the validation error returned
+// by RegeneratePickupCodeRequest.Validate if the designated constraints
+// aren't met.
+type RegeneratePickupCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegeneratePickupCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegeneratePickupCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegeneratePickupCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegeneratePickupCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegeneratePickupCodeRequestValidationError) ErrorName() string {
+	return "RegeneratePickupCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegeneratePickupCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegeneratePickupCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegeneratePickupCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegeneratePickupCodeRequestValidationError{}
+
+// Validate checks the field values on RegeneratePickupCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegeneratePickupCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegeneratePickupCodeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegeneratePickupCodeResponseMultiError, or nil if none found.
+func (m *RegeneratePickupCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegeneratePickupCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for OrderId
+
+	if len(errors) > 0 {
+		return RegeneratePickupCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegeneratePickupCodeResponseMultiError is an error wrapping multiple
+// validation errors returned by RegeneratePickupCodeResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RegeneratePickupCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegeneratePickupCodeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegeneratePickupCodeResponseMultiError) AllErrors() []error { return m }
+
+// RegeneratePickupCodeResponseValidationError is the validation error returned
+// by RegeneratePickupCodeResponse.Validate if the designated constraints
+// aren't met.
+type RegeneratePickupCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegeneratePickupCodeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegeneratePickupCodeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegeneratePickupCodeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegeneratePickupCodeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegeneratePickupCodeResponseValidationError) ErrorName() string {
+	return "RegeneratePickupCodeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegeneratePickupCodeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegeneratePickupCodeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegeneratePickupCodeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegeneratePickupCodeResponseValidationError{}
+
+// Validate checks the field values on VerifyOrderIntegrityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyOrderIntegrityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyOrderIntegrityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyOrderIntegrityRequestMultiError, or nil if none found.
+func (m *VerifyOrderIntegrityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyOrderIntegrityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	_VerifyOrderIntegrityRequest_OrderIds_Unique := make(map[int64]struct{}, len(m.GetOrderIds()))
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if _, exists := _VerifyOrderIntegrityRequest_OrderIds_Unique[item]; exists {
+			err := VerifyOrderIntegrityRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_VerifyOrderIntegrityRequest_OrderIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := VerifyOrderIntegrityRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VerifyOrderIntegrityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyOrderIntegrityRequestMultiError is an error wrapping multiple
+// validation errors returned by VerifyOrderIntegrityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type VerifyOrderIntegrityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyOrderIntegrityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyOrderIntegrityRequestMultiError) AllErrors() []error { return m }
+
+// VerifyOrderIntegrityRequestValidationError is the validation error returned
+// by VerifyOrderIntegrityRequest.Validate if the designated constraints
+// aren't met.
+type VerifyOrderIntegrityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyOrderIntegrityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyOrderIntegrityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyOrderIntegrityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyOrderIntegrityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyOrderIntegrityRequestValidationError) ErrorName() string {
+	return "VerifyOrderIntegrityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyOrderIntegrityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyOrderIntegrityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyOrderIntegrityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyOrderIntegrityRequestValidationError{}
+
+// Validate checks the field values on VerifyOrderIntegrityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyOrderIntegrityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyOrderIntegrityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyOrderIntegrityResponseMultiError, or nil if none found.
+func (m *VerifyOrderIntegrityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyOrderIntegrityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Checked
+
+	for idx, item := range m.GetViolations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyOrderIntegrityResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyOrderIntegrityResponseValidationError{
+						field:  fmt.Sprintf("Violations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyOrderIntegrityResponseValidationError{
+					field:  fmt.Sprintf("Violations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VerifyOrderIntegrityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyOrderIntegrityResponseMultiError is an error wrapping multiple
+// validation errors returned by VerifyOrderIntegrityResponse.ValidateAll() if
+// the designated constraints aren't met.
+type VerifyOrderIntegrityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyOrderIntegrityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyOrderIntegrityResponseMultiError) AllErrors() []error { return m }
+
+// VerifyOrderIntegrityResponseValidationError is the validation error returned
+// by VerifyOrderIntegrityResponse.Validate if the designated constraints
+// aren't met.
+type VerifyOrderIntegrityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyOrderIntegrityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyOrderIntegrityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyOrderIntegrityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyOrderIntegrityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyOrderIntegrityResponseValidationError) ErrorName() string {
+	return "VerifyOrderIntegrityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyOrderIntegrityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyOrderIntegrityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyOrderIntegrityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyOrderIntegrityResponseValidationError{}
+
+// Validate checks the field values on IntegrityViolationEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IntegrityViolationEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntegrityViolationEntity with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntegrityViolationEntityMultiError, or nil if none found.
+func (m *IntegrityViolationEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntegrityViolationEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return IntegrityViolationEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntegrityViolationEntityMultiError is an error wrapping multiple validation
+// errors returned by IntegrityViolationEntity.ValidateAll() if the designated
+// constraints aren't met.
+type IntegrityViolationEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntegrityViolationEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntegrityViolationEntityMultiError) AllErrors() []error { return m }
+
+// IntegrityViolationEntityValidationError is the validation error returned by
+// IntegrityViolationEntity.Validate if the designated constraints aren't met.
+type IntegrityViolationEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntegrityViolationEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntegrityViolationEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntegrityViolationEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntegrityViolationEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntegrityViolationEntityValidationError) ErrorName() string {
+	return "IntegrityViolationEntityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntegrityViolationEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntegrityViolationEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntegrityViolationEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntegrityViolationEntityValidationError{}
+
+// Validate checks the field values on RecipientEntity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RecipientEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecipientEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecipientEntityMultiError, or nil if none found.
+func (m *RecipientEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecipientEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Phone
+
+	// no validation rules for Email
+
+	// no validation rules for PreferredChannel
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecipientEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecipientEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecipientEntityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RecipientEntityValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RecipientEntityValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RecipientEntityValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RecipientEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecipientEntityMultiError is an error wrapping multiple validation errors
+// returned by RecipientEntity.ValidateAll() if the designated constraints
+// aren't met.
+type RecipientEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecipientEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecipientEntityMultiError) AllErrors() []error { return m }
+
+// RecipientEntityValidationError is the validation error returned by
+// RecipientEntity.Validate if the designated constraints aren't met.
+type RecipientEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecipientEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecipientEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecipientEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecipientEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecipientEntityValidationError) ErrorName() string { return "RecipientEntityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RecipientEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecipientEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecipientEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecipientEntityValidationError{}
+
+// Validate checks the field values on CreateRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRecipientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRecipientRequestMultiError, or nil if none found.
+func (m *CreateRecipientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRecipientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := CreateRecipientRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := CreateRecipientRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPhone()) > 32 {
+		err := CreateRecipientRequestValidationError{
+			field:  "Phone",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = CreateRecipientRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _CreateRecipientRequest_PreferredChannel_InLookup[m.GetPreferredChannel()]; !ok {
+		err := CreateRecipientRequestValidationError{
+			field:  "PreferredChannel",
+			reason: "value must be in list [ sms email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRecipientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CreateRecipientRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateRecipientRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreateRecipientRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRecipientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRecipientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRecipientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRecipientRequestMultiError) AllErrors() []error { return m }
+
+// CreateRecipientRequestValidationError is the validation error returned by
+// CreateRecipientRequest.Validate if the designated constraints aren't met.
+type CreateRecipientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRecipientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRecipientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRecipientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRecipientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRecipientRequestValidationError) ErrorName() string {
+	return "CreateRecipientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRecipientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRecipientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRecipientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRecipientRequestValidationError{}
+
+var _CreateRecipientRequest_PreferredChannel_InLookup = map[string]struct{}{
+	"":      {},
+	"sms":   {},
+	"email": {},
+}
+
+// Validate checks the field values on CreateRecipientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRecipientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRecipientResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRecipientResponseMultiError, or nil if none found.
+func (m *CreateRecipientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRecipientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetRecipient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRecipientResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRecipientResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecipient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRecipientResponseValidationError{
+				field:  "Recipient",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRecipientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRecipientResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateRecipientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRecipientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRecipientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRecipientResponseMultiError) AllErrors() []error { return m }
+
+// CreateRecipientResponseValidationError is the validation error returned by
+// CreateRecipientResponse.Validate if the designated constraints aren't met.
+type CreateRecipientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRecipientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRecipientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRecipientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRecipientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRecipientResponseValidationError) ErrorName() string {
+	return "CreateRecipientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRecipientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRecipientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRecipientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRecipientResponseValidationError{}
+
+// Validate checks the field values on GetRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecipientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecipientRequestMultiError, or nil if none found.
+func (m *GetRecipientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecipientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := GetRecipientRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRecipientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecipientRequestMultiError is an error wrapping multiple validation
+// errors returned by GetRecipientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetRecipientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecipientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecipientRequestMultiError) AllErrors() []error { return m }
+
+// GetRecipientRequestValidationError is the validation error returned by
+// GetRecipientRequest.Validate if the designated constraints aren't met.
+type GetRecipientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecipientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecipientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecipientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecipientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecipientRequestValidationError) ErrorName() string {
+	return "GetRecipientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecipientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecipientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecipientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecipientRequestValidationError{}
+
+// Validate checks the field values on GetRecipientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRecipientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRecipientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRecipientResponseMultiError, or nil if none found.
+func (m *GetRecipientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRecipientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRecipient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRecipientResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRecipientResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecipient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRecipientResponseValidationError{
+				field:  "Recipient",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRecipientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRecipientResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRecipientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRecipientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRecipientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRecipientResponseMultiError) AllErrors() []error { return m }
+
+// GetRecipientResponseValidationError is the validation error returned by
+// GetRecipientResponse.Validate if the designated constraints aren't met.
+type GetRecipientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRecipientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRecipientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRecipientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRecipientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRecipientResponseValidationError) ErrorName() string {
+	return "GetRecipientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRecipientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRecipientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRecipientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRecipientResponseValidationError{}
+
+// Validate checks the field values on ListRecipientsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecipientsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecipientsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRecipientsRequestMultiError, or nil if none found.
+func (m *ListRecipientsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecipientsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() <= 0 {
+		err := ListRecipientsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Limit != nil {
+
+		if m.GetLimit() <= 0 {
+			err := ListRecipientsRequestValidationError{
+				field:  "Limit",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRecipientsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecipientsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRecipientsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListRecipientsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecipientsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecipientsRequestMultiError) AllErrors() []error { return m }
+
+// ListRecipientsRequestValidationError is the validation error returned by
+// ListRecipientsRequest.Validate if the designated constraints aren't met.
+type ListRecipientsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecipientsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecipientsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecipientsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecipientsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecipientsRequestValidationError) ErrorName() string {
+	return "ListRecipientsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecipientsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecipientsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecipientsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecipientsRequestValidationError{}
+
+// Validate checks the field values on ListRecipientsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRecipientsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRecipientsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRecipientsResponseMultiError, or nil if none found.
+func (m *ListRecipientsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRecipientsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecipients() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRecipientsResponseValidationError{
+						field:  fmt.Sprintf("Recipients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRecipientsResponseValidationError{
+						field:  fmt.Sprintf("Recipients[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRecipientsResponseValidationError{
+					field:  fmt.Sprintf("Recipients[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRecipientsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRecipientsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListRecipientsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListRecipientsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRecipientsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRecipientsResponseMultiError) AllErrors() []error { return m }
+
+// ListRecipientsResponseValidationError is the validation error returned by
+// ListRecipientsResponse.Validate if the designated constraints aren't met.
+type ListRecipientsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRecipientsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRecipientsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRecipientsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRecipientsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRecipientsResponseValidationError) ErrorName() string {
+	return "ListRecipientsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRecipientsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRecipientsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRecipientsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRecipientsResponseValidationError{}
+
+// Validate checks the field values on UpdateRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRecipientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRecipientRequestMultiError, or nil if none found.
+func (m *UpdateRecipientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRecipientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UpdateRecipientRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 255 {
+		err := UpdateRecipientRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPhone()) > 32 {
+		err := UpdateRecipientRequestValidationError{
+			field:  "Phone",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEmail() != "" {
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UpdateRecipientRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _UpdateRecipientRequest_PreferredChannel_InLookup[m.GetPreferredChannel()]; !ok {
+		err := UpdateRecipientRequestValidationError{
+			field:  "PreferredChannel",
+			reason: "value must be in list [ sms email]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateRecipientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateRecipientRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateRecipientRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// UpdateRecipientRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRecipientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRecipientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRecipientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRecipientRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRecipientRequestValidationError is the validation error returned by
+// UpdateRecipientRequest.Validate if the designated constraints aren't met.
+type UpdateRecipientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRecipientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRecipientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRecipientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRecipientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRecipientRequestValidationError) ErrorName() string {
+	return "UpdateRecipientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRecipientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRecipientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRecipientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRecipientRequestValidationError{}
+
+var _UpdateRecipientRequest_PreferredChannel_InLookup = map[string]struct{}{
+	"":      {},
+	"sms":   {},
+	"email": {},
+}
+
+// Validate checks the field values on UpdateRecipientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRecipientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRecipientResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRecipientResponseMultiError, or nil if none found.
+func (m *UpdateRecipientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRecipientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetRecipient()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRecipientResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRecipientResponseValidationError{
+					field:  "Recipient",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRecipient()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRecipientResponseValidationError{
+				field:  "Recipient",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRecipientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRecipientResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateRecipientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRecipientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRecipientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRecipientResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRecipientResponseValidationError is the validation error returned by
+// UpdateRecipientResponse.Validate if the designated constraints aren't met.
+type UpdateRecipientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRecipientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRecipientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRecipientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRecipientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRecipientResponseValidationError) ErrorName() string {
+	return "UpdateRecipientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRecipientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRecipientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRecipientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRecipientResponseValidationError{}
+
+// Validate checks the field values on DeleteRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRecipientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRecipientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRecipientRequestMultiError, or nil if none found.
+func (m *DeleteRecipientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRecipientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := DeleteRecipientRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteRecipientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRecipientRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteRecipientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRecipientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRecipientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRecipientRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRecipientRequestValidationError is the validation error returned by
+// DeleteRecipientRequest.Validate if the designated constraints aren't met.
+type DeleteRecipientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRecipientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRecipientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRecipientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRecipientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRecipientRequestValidationError) ErrorName() string {
+	return "DeleteRecipientRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRecipientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRecipientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRecipientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRecipientRequestValidationError{}
+
+// Validate checks the field values on DeleteRecipientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRecipientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRecipientResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRecipientResponseMultiError, or nil if none found.
+func (m *DeleteRecipientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRecipientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRecipientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRecipientResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteRecipientResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteRecipientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRecipientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRecipientResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRecipientResponseValidationError is the validation error returned by
+// DeleteRecipientResponse.Validate if the designated constraints aren't met.
+type DeleteRecipientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRecipientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRecipientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRecipientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRecipientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRecipientResponseValidationError) ErrorName() string {
+	return "DeleteRecipientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRecipientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRecipientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRecipientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRecipientResponseValidationError{}
+
+// Validate checks the field values on CellEntity with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CellEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CellEntity with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CellEntityMultiError, or
+// nil if none found.
+func (m *CellEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CellEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Zone
+
+	// no validation rules for Rack
+
+	// no validation rules for Shelf
+
+	// no validation rules for Code
+
+	// no validation rules for MaxOrders
+
+	// no validation rules for MaxWeight
+
+	// no validation rules for OrderCount
+
+	// no validation rules for Weight
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CellEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CellEntityValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CellEntityValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CellEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// CellEntityMultiError is an error wrapping multiple validation errors
+// returned by CellEntity.ValidateAll() if the designated constraints aren't met.
+type CellEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CellEntityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CellEntityMultiError) AllErrors() []error { return m }
+
+// CellEntityValidationError is the validation error returned by
+// CellEntity.Validate if the designated constraints aren't met.
+type CellEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CellEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CellEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CellEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CellEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CellEntityValidationError) ErrorName() string { return "CellEntityValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CellEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCellEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CellEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CellEntityValidationError{}
+
+// Validate checks the field values on CreateCellRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateCellRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCellRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCellRequestMultiError, or nil if none found.
+func (m *CreateCellRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCellRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetZone()); l < 1 || l > 32 {
+		err := CreateCellRequestValidationError{
+			field:  "Zone",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetRack()); l < 1 || l > 32 {
+		err := CreateCellRequestValidationError{
+			field:  "Rack",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetShelf()); l < 1 || l > 32 {
+		err := CreateCellRequestValidationError{
+			field:  "Shelf",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxOrders() <= 0 {
+		err := CreateCellRequestValidationError{
+			field:  "MaxOrders",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWeight() <= 0 {
+		err := CreateCellRequestValidationError{
+			field:  "MaxWeight",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateCellRequest_PackageTypes_Unique := make(map[string]struct{}, len(m.GetPackageTypes()))
+
+	for idx, item := range m.GetPackageTypes() {
+		_, _ = idx, item
+
+		if _, exists := _CreateCellRequest_PackageTypes_Unique[item]; exists {
+			err := CreateCellRequestValidationError{
+				field:  fmt.Sprintf("PackageTypes[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateCellRequest_PackageTypes_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CreateCellRequestValidationError{
+				field:  fmt.Sprintf("PackageTypes[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CreateCellRequest_PackageTypes_Pattern.MatchString(item) {
+			err := CreateCellRequestValidationError{
+				field:  fmt.Sprintf("PackageTypes[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9_ -]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateCellRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCellRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateCellRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCellRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCellRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCellRequestMultiError) AllErrors() []error { return m }
+
+// CreateCellRequestValidationError is the validation error returned by
+// CreateCellRequest.Validate if the designated constraints aren't met.
+type CreateCellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCellRequestValidationError) ErrorName() string {
+	return "CreateCellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCellRequestValidationError{}
+
+var _CreateCellRequest_PackageTypes_Pattern = regexp.MustCompile("^[a-zA-Z0-9_ -]+$")
+
+// Validate checks the field values on CreateCellResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateCellResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateCellResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateCellResponseMultiError, or nil if none found.
+func (m *CreateCellResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateCellResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCell()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateCellResponseValidationError{
+					field:  "Cell",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateCellResponseValidationError{
+					field:  "Cell",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCell()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateCellResponseValidationError{
+				field:  "Cell",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateCellResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateCellResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateCellResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateCellResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateCellResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateCellResponseMultiError) AllErrors() []error { return m }
+
+// CreateCellResponseValidationError is the validation error returned by
+// CreateCellResponse.Validate if the designated constraints aren't met.
+type CreateCellResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateCellResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateCellResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateCellResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateCellResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateCellResponseValidationError) ErrorName() string {
+	return "CreateCellResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateCellResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateCellResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateCellResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateCellResponseValidationError{}
+
+// Validate checks the field values on ListCellsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCellsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCellsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCellsRequestMultiError, or nil if none found.
+func (m *ListCellsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCellsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListCellsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCellsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCellsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCellsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCellsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCellsRequestMultiError) AllErrors() []error { return m }
+
+// ListCellsRequestValidationError is the validation error returned by
+// ListCellsRequest.Validate if the designated constraints aren't met.
+type ListCellsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCellsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCellsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCellsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCellsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCellsRequestValidationError) ErrorName() string { return "ListCellsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCellsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCellsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCellsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCellsRequestValidationError{}
+
+// Validate checks the field values on ListCellsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCellsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCellsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCellsResponseMultiError, or nil if none found.
+func (m *ListCellsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCellsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCells() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCellsResponseValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCellsResponseValidationError{
+						field:  fmt.Sprintf("Cells[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCellsResponseValidationError{
+					field:  fmt.Sprintf("Cells[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCellsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCellsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListCellsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListCellsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCellsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -837,11 +9239,11 @@ func (m IssueOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m IssueOrderResponseMultiError) AllErrors() []error { return m }
+func (m ListCellsResponseMultiError) AllErrors() []error { return m }
 
-// IssueOrderResponseValidationError is the validation error returned by
-// IssueOrderResponse.Validate if the designated constraints aren't met.
-type IssueOrderResponseValidationError struct {
+// ListCellsResponseValidationError is the validation error returned by
+// ListCellsResponse.Validate if the designated constraints aren't met.
+type ListCellsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -849,24 +9251,24 @@ type IssueOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e IssueOrderResponseValidationError) Field() string { return e.field }
+func (e ListCellsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e IssueOrderResponseValidationError) Reason() string { return e.reason }
+func (e ListCellsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e IssueOrderResponseValidationError) Cause() error { return e.cause }
+func (e ListCellsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e IssueOrderResponseValidationError) Key() bool { return e.key }
+func (e ListCellsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e IssueOrderResponseValidationError) ErrorName() string {
-	return "IssueOrderResponseValidationError"
+func (e ListCellsResponseValidationError) ErrorName() string {
+	return "ListCellsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e IssueOrderResponseValidationError) Error() string {
+func (e ListCellsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -878,14 +9280,14 @@ func (e IssueOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sIssueOrderResponse.%s: %s%s",
+		"invalid %sListCellsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = IssueOrderResponseValidationError{}
+var _ error = ListCellsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -893,33 +9295,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = IssueOrderResponseValidationError{}
+} = ListCellsResponseValidationError{}
 
-// Validate checks the field values on ListOrdersRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListOrdersRequest) Validate() error {
+// Validate checks the field values on ListCellOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCellOrdersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrdersRequest with the rules
+// ValidateAll checks the field values on ListCellOrdersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListOrdersRequestMultiError, or nil if none found.
-func (m *ListOrdersRequest) ValidateAll() error {
+// ListCellOrdersRequestMultiError, or nil if none found.
+func (m *ListCellOrdersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrdersRequest) validate(all bool) error {
+func (m *ListCellOrdersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetRecipientId() <= 0 {
-		err := ListOrdersRequestValidationError{
-			field:  "RecipientId",
+	if m.GetCellId() <= 0 {
+		err := ListCellOrdersRequestValidationError{
+			field:  "CellId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -928,24 +9330,20 @@ func (m *ListOrdersRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.Limit != nil {
-		// no validation rules for Limit
-	}
-
 	if len(errors) > 0 {
-		return ListOrdersRequestMultiError(errors)
+		return ListCellOrdersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrdersRequestMultiError is an error wrapping multiple validation errors
-// returned by ListOrdersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListOrdersRequestMultiError []error
+// ListCellOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCellOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCellOrdersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrdersRequestMultiError) Error() string {
+func (m ListCellOrdersRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -954,11 +9352,11 @@ func (m ListOrdersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrdersRequestMultiError) AllErrors() []error { return m }
+func (m ListCellOrdersRequestMultiError) AllErrors() []error { return m }
 
-// ListOrdersRequestValidationError is the validation error returned by
-// ListOrdersRequest.Validate if the designated constraints aren't met.
-type ListOrdersRequestValidationError struct {
+// ListCellOrdersRequestValidationError is the validation error returned by
+// ListCellOrdersRequest.Validate if the designated constraints aren't met.
+type ListCellOrdersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -966,24 +9364,24 @@ type ListOrdersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrdersRequestValidationError) Field() string { return e.field }
+func (e ListCellOrdersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrdersRequestValidationError) Reason() string { return e.reason }
+func (e ListCellOrdersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrdersRequestValidationError) Cause() error { return e.cause }
+func (e ListCellOrdersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrdersRequestValidationError) Key() bool { return e.key }
+func (e ListCellOrdersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrdersRequestValidationError) ErrorName() string {
-	return "ListOrdersRequestValidationError"
+func (e ListCellOrdersRequestValidationError) ErrorName() string {
+	return "ListCellOrdersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrdersRequestValidationError) Error() string {
+func (e ListCellOrdersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -995,14 +9393,14 @@ func (e ListOrdersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrdersRequest.%s: %s%s",
+		"invalid %sListCellOrdersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrdersRequestValidationError{}
+var _ error = ListCellOrdersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1010,24 +9408,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrdersRequestValidationError{}
+} = ListCellOrdersRequestValidationError{}
 
-// Validate checks the field values on ListOrdersResponse with the rules
+// Validate checks the field values on ListCellOrdersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListOrdersResponse) Validate() error {
+func (m *ListCellOrdersResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrdersResponse with the rules
+// ValidateAll checks the field values on ListCellOrdersResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListOrdersResponseMultiError, or nil if none found.
-func (m *ListOrdersResponse) ValidateAll() error {
+// ListCellOrdersResponseMultiError, or nil if none found.
+func (m *ListCellOrdersResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrdersResponse) validate(all bool) error {
+func (m *ListCellOrdersResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1041,7 +9439,7 @@ func (m *ListOrdersResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrdersResponseValidationError{
+					errors = append(errors, ListCellOrdersResponseValidationError{
 						field:  fmt.Sprintf("Orders[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1049,7 +9447,7 @@ func (m *ListOrdersResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrdersResponseValidationError{
+					errors = append(errors, ListCellOrdersResponseValidationError{
 						field:  fmt.Sprintf("Orders[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1058,7 +9456,7 @@ func (m *ListOrdersResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListOrdersResponseValidationError{
+				return ListCellOrdersResponseValidationError{
 					field:  fmt.Sprintf("Orders[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1069,19 +9467,19 @@ func (m *ListOrdersResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListOrdersResponseMultiError(errors)
+		return ListCellOrdersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrdersResponseMultiError is an error wrapping multiple validation errors
-// returned by ListOrdersResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListOrdersResponseMultiError []error
+// ListCellOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCellOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCellOrdersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrdersResponseMultiError) Error() string {
+func (m ListCellOrdersResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1090,11 +9488,11 @@ func (m ListOrdersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrdersResponseMultiError) AllErrors() []error { return m }
+func (m ListCellOrdersResponseMultiError) AllErrors() []error { return m }
 
-// ListOrdersResponseValidationError is the validation error returned by
-// ListOrdersResponse.Validate if the designated constraints aren't met.
-type ListOrdersResponseValidationError struct {
+// ListCellOrdersResponseValidationError is the validation error returned by
+// ListCellOrdersResponse.Validate if the designated constraints aren't met.
+type ListCellOrdersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1102,24 +9500,24 @@ type ListOrdersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrdersResponseValidationError) Field() string { return e.field }
+func (e ListCellOrdersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrdersResponseValidationError) Reason() string { return e.reason }
+func (e ListCellOrdersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrdersResponseValidationError) Cause() error { return e.cause }
+func (e ListCellOrdersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrdersResponseValidationError) Key() bool { return e.key }
+func (e ListCellOrdersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrdersResponseValidationError) ErrorName() string {
-	return "ListOrdersResponseValidationError"
+func (e ListCellOrdersResponseValidationError) ErrorName() string {
+	return "ListCellOrdersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrdersResponseValidationError) Error() string {
+func (e ListCellOrdersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1131,14 +9529,14 @@ func (e ListOrdersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrdersResponse.%s: %s%s",
+		"invalid %sListCellOrdersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrdersResponseValidationError{}
+var _ error = ListCellOrdersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1146,24 +9544,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrdersResponseValidationError{}
+} = ListCellOrdersResponseValidationError{}
 
-// Validate checks the field values on AcceptReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptReturnRequest) Validate() error {
+// Validate checks the field values on MoveOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveOrderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptReturnRequest with the rules
+// ValidateAll checks the field values on MoveOrderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptReturnRequestMultiError, or nil if none found.
-func (m *AcceptReturnRequest) ValidateAll() error {
+// MoveOrderRequestMultiError, or nil if none found.
+func (m *MoveOrderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptReturnRequest) validate(all bool) error {
+func (m *MoveOrderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1171,7 +9569,7 @@ func (m *AcceptReturnRequest) validate(all bool) error {
 	var errors []error
 
 	if m.GetOrderId() <= 0 {
-		err := AcceptReturnRequestValidationError{
+		err := MoveOrderRequestValidationError{
 			field:  "OrderId",
 			reason: "value must be greater than 0",
 		}
@@ -1181,9 +9579,9 @@ func (m *AcceptReturnRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetRecipientId() <= 0 {
-		err := AcceptReturnRequestValidationError{
-			field:  "RecipientId",
+	if m.GetCellId() <= 0 {
+		err := MoveOrderRequestValidationError{
+			field:  "CellId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1193,19 +9591,19 @@ func (m *AcceptReturnRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AcceptReturnRequestMultiError(errors)
+		return MoveOrderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by AcceptReturnRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AcceptReturnRequestMultiError []error
+// MoveOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveOrderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptReturnRequestMultiError) Error() string {
+func (m MoveOrderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1214,11 +9612,11 @@ func (m AcceptReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptReturnRequestMultiError) AllErrors() []error { return m }
+func (m MoveOrderRequestMultiError) AllErrors() []error { return m }
 
-// AcceptReturnRequestValidationError is the validation error returned by
-// AcceptReturnRequest.Validate if the designated constraints aren't met.
-type AcceptReturnRequestValidationError struct {
+// MoveOrderRequestValidationError is the validation error returned by
+// MoveOrderRequest.Validate if the designated constraints aren't met.
+type MoveOrderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1226,24 +9624,22 @@ type AcceptReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptReturnRequestValidationError) Field() string { return e.field }
+func (e MoveOrderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptReturnRequestValidationError) Reason() string { return e.reason }
+func (e MoveOrderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptReturnRequestValidationError) Cause() error { return e.cause }
+func (e MoveOrderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptReturnRequestValidationError) Key() bool { return e.key }
+func (e MoveOrderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptReturnRequestValidationError) ErrorName() string {
-	return "AcceptReturnRequestValidationError"
-}
+func (e MoveOrderRequestValidationError) ErrorName() string { return "MoveOrderRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AcceptReturnRequestValidationError) Error() string {
+func (e MoveOrderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1255,14 +9651,14 @@ func (e AcceptReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptReturnRequest.%s: %s%s",
+		"invalid %sMoveOrderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptReturnRequestValidationError{}
+var _ error = MoveOrderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1270,24 +9666,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptReturnRequestValidationError{}
+} = MoveOrderRequestValidationError{}
 
-// Validate checks the field values on AcceptReturnResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptReturnResponse) Validate() error {
+// Validate checks the field values on MoveOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveOrderResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptReturnResponse with the rules
+// ValidateAll checks the field values on MoveOrderResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptReturnResponseMultiError, or nil if none found.
-func (m *AcceptReturnResponse) ValidateAll() error {
+// MoveOrderResponseMultiError, or nil if none found.
+func (m *MoveOrderResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptReturnResponse) validate(all bool) error {
+func (m *MoveOrderResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1298,20 +9694,24 @@ func (m *AcceptReturnResponse) validate(all bool) error {
 
 	// no validation rules for OrderId
 
+	// no validation rules for CellId
+
+	// no validation rules for CellCode
+
 	if len(errors) > 0 {
-		return AcceptReturnResponseMultiError(errors)
+		return MoveOrderResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptReturnResponseMultiError is an error wrapping multiple validation
-// errors returned by AcceptReturnResponse.ValidateAll() if the designated
-// constraints aren't met.
-type AcceptReturnResponseMultiError []error
+// MoveOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveOrderResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptReturnResponseMultiError) Error() string {
+func (m MoveOrderResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1320,11 +9720,11 @@ func (m AcceptReturnResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptReturnResponseMultiError) AllErrors() []error { return m }
+func (m MoveOrderResponseMultiError) AllErrors() []error { return m }
 
-// AcceptReturnResponseValidationError is the validation error returned by
-// AcceptReturnResponse.Validate if the designated constraints aren't met.
-type AcceptReturnResponseValidationError struct {
+// MoveOrderResponseValidationError is the validation error returned by
+// MoveOrderResponse.Validate if the designated constraints aren't met.
+type MoveOrderResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1332,24 +9732,24 @@ type AcceptReturnResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptReturnResponseValidationError) Field() string { return e.field }
+func (e MoveOrderResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptReturnResponseValidationError) Reason() string { return e.reason }
+func (e MoveOrderResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptReturnResponseValidationError) Cause() error { return e.cause }
+func (e MoveOrderResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptReturnResponseValidationError) Key() bool { return e.key }
+func (e MoveOrderResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptReturnResponseValidationError) ErrorName() string {
-	return "AcceptReturnResponseValidationError"
+func (e MoveOrderResponseValidationError) ErrorName() string {
+	return "MoveOrderResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AcceptReturnResponseValidationError) Error() string {
+func (e MoveOrderResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1361,14 +9761,14 @@ func (e AcceptReturnResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptReturnResponse.%s: %s%s",
+		"invalid %sMoveOrderResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptReturnResponseValidationError{}
+var _ error = MoveOrderResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1376,59 +9776,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptReturnResponseValidationError{}
+} = MoveOrderResponseValidationError{}
 
-// Validate checks the field values on ReturnListRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ReturnListRequest) Validate() error {
+// Validate checks the field values on GetCapacityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCapacityRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnListRequest with the rules
+// ValidateAll checks the field values on GetCapacityRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReturnListRequestMultiError, or nil if none found.
-func (m *ReturnListRequest) ValidateAll() error {
+// GetCapacityRequestMultiError, or nil if none found.
+func (m *GetCapacityRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnListRequest) validate(all bool) error {
+func (m *GetCapacityRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetPage() <= 0 {
-		err := ReturnListRequestValidationError{
-			field:  "Page",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.Limit != nil {
-		// no validation rules for Limit
-	}
-
 	if len(errors) > 0 {
-		return ReturnListRequestMultiError(errors)
+		return GetCapacityRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnListRequestMultiError is an error wrapping multiple validation errors
-// returned by ReturnListRequest.ValidateAll() if the designated constraints
+// GetCapacityRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCapacityRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ReturnListRequestMultiError []error
+type GetCapacityRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnListRequestMultiError) Error() string {
+func (m GetCapacityRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1437,11 +9822,11 @@ func (m ReturnListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnListRequestMultiError) AllErrors() []error { return m }
+func (m GetCapacityRequestMultiError) AllErrors() []error { return m }
 
-// ReturnListRequestValidationError is the validation error returned by
-// ReturnListRequest.Validate if the designated constraints aren't met.
-type ReturnListRequestValidationError struct {
+// GetCapacityRequestValidationError is the validation error returned by
+// GetCapacityRequest.Validate if the designated constraints aren't met.
+type GetCapacityRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1449,24 +9834,24 @@ type ReturnListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnListRequestValidationError) Field() string { return e.field }
+func (e GetCapacityRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnListRequestValidationError) Reason() string { return e.reason }
+func (e GetCapacityRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnListRequestValidationError) Cause() error { return e.cause }
+func (e GetCapacityRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnListRequestValidationError) Key() bool { return e.key }
+func (e GetCapacityRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnListRequestValidationError) ErrorName() string {
-	return "ReturnListRequestValidationError"
+func (e GetCapacityRequestValidationError) ErrorName() string {
+	return "GetCapacityRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReturnListRequestValidationError) Error() string {
+func (e GetCapacityRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1478,14 +9863,14 @@ func (e ReturnListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnListRequest.%s: %s%s",
+		"invalid %sGetCapacityRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnListRequestValidationError{}
+var _ error = GetCapacityRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1493,78 +9878,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnListRequestValidationError{}
+} = GetCapacityRequestValidationError{}
 
-// Validate checks the field values on ReturnListResponse with the rules
+// Validate checks the field values on GetCapacityResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReturnListResponse) Validate() error {
+func (m *GetCapacityResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnListResponse with the rules
+// ValidateAll checks the field values on GetCapacityResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReturnListResponseMultiError, or nil if none found.
-func (m *ReturnListResponse) ValidateAll() error {
+// GetCapacityResponseMultiError, or nil if none found.
+func (m *GetCapacityResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnListResponse) validate(all bool) error {
+func (m *GetCapacityResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOrders() {
-		_, _ = idx, item
+	// no validation rules for PvzId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ReturnListResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ReturnListResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ReturnListResponseValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for OrderCount
 
-	}
+	// no validation rules for Weight
+
+	// no validation rules for MaxOrders
+
+	// no validation rules for MaxWeight
 
 	if len(errors) > 0 {
-		return ReturnListResponseMultiError(errors)
+		return GetCapacityResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnListResponseMultiError is an error wrapping multiple validation errors
-// returned by ReturnListResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ReturnListResponseMultiError []error
+// GetCapacityResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCapacityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCapacityResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnListResponseMultiError) Error() string {
+func (m GetCapacityResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1573,11 +9934,11 @@ func (m ReturnListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnListResponseMultiError) AllErrors() []error { return m }
+func (m GetCapacityResponseMultiError) AllErrors() []error { return m }
 
-// ReturnListResponseValidationError is the validation error returned by
-// ReturnListResponse.Validate if the designated constraints aren't met.
-type ReturnListResponseValidationError struct {
+// GetCapacityResponseValidationError is the validation error returned by
+// GetCapacityResponse.Validate if the designated constraints aren't met.
+type GetCapacityResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1585,24 +9946,24 @@ type ReturnListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnListResponseValidationError) Field() string { return e.field }
+func (e GetCapacityResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnListResponseValidationError) Reason() string { return e.reason }
+func (e GetCapacityResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnListResponseValidationError) Cause() error { return e.cause }
+func (e GetCapacityResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnListResponseValidationError) Key() bool { return e.key }
+func (e GetCapacityResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnListResponseValidationError) ErrorName() string {
-	return "ReturnListResponseValidationError"
+func (e GetCapacityResponseValidationError) ErrorName() string {
+	return "GetCapacityResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReturnListResponseValidationError) Error() string {
+func (e GetCapacityResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1614,14 +9975,14 @@ func (e ReturnListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnListResponse.%s: %s%s",
+		"invalid %sGetCapacityResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnListResponseValidationError{}
+var _ error = GetCapacityResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1629,4 +9990,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnListResponseValidationError{}
+} = GetCapacityResponseValidationError{}
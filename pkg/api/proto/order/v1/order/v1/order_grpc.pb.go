@@ -20,11 +20,33 @@ const _ = grpc.SupportPackageIsVersion8
 
 const (
 	Order_AcceptOrderFromCourier_FullMethodName = "/order.Order/AcceptOrderFromCourier"
+	Order_AcceptOrdersBatch_FullMethodName      = "/order.Order/AcceptOrdersBatch"
 	Order_ReturnOrderToCourier_FullMethodName   = "/order.Order/ReturnOrderToCourier"
 	Order_IssueOrderToClient_FullMethodName     = "/order.Order/IssueOrderToClient"
 	Order_ListOrders_FullMethodName             = "/order.Order/ListOrders"
 	Order_AcceptReturnFromClient_FullMethodName = "/order.Order/AcceptReturnFromClient"
 	Order_ReturnList_FullMethodName             = "/order.Order/ReturnList"
+	Order_GetOrder_FullMethodName               = "/order.Order/GetOrder"
+	Order_GetOrderHistory_FullMethodName        = "/order.Order/GetOrderHistory"
+	Order_ListCourierReturns_FullMethodName     = "/order.Order/ListCourierReturns"
+	Order_GetReturnManifest_FullMethodName      = "/order.Order/GetReturnManifest"
+	Order_ConfirmReturnManifest_FullMethodName  = "/order.Order/ConfirmReturnManifest"
+	Order_ListPackageTypes_FullMethodName       = "/order.Order/ListPackageTypes"
+	Order_CreatePackageType_FullMethodName      = "/order.Order/CreatePackageType"
+	Order_DeactivatePackageType_FullMethodName  = "/order.Order/DeactivatePackageType"
+	Order_ExtendStorage_FullMethodName          = "/order.Order/ExtendStorage"
+	Order_RegeneratePickupCode_FullMethodName   = "/order.Order/RegeneratePickupCode"
+	Order_VerifyOrderIntegrity_FullMethodName   = "/order.Order/VerifyOrderIntegrity"
+	Order_CreateRecipient_FullMethodName        = "/order.Order/CreateRecipient"
+	Order_GetRecipient_FullMethodName           = "/order.Order/GetRecipient"
+	Order_ListRecipients_FullMethodName         = "/order.Order/ListRecipients"
+	Order_UpdateRecipient_FullMethodName        = "/order.Order/UpdateRecipient"
+	Order_DeleteRecipient_FullMethodName        = "/order.Order/DeleteRecipient"
+	Order_CreateCell_FullMethodName             = "/order.Order/CreateCell"
+	Order_ListCells_FullMethodName              = "/order.Order/ListCells"
+	Order_ListCellOrders_FullMethodName         = "/order.Order/ListCellOrders"
+	Order_MoveOrder_FullMethodName              = "/order.Order/MoveOrder"
+	Order_GetCapacity_FullMethodName            = "/order.Order/GetCapacity"
 )
 
 // OrderClient is the client API for Order service.
@@ -32,11 +54,33 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderClient interface {
 	AcceptOrderFromCourier(ctx context.Context, in *AcceptOrderRequest, opts ...grpc.CallOption) (*AcceptOrderResponse, error)
+	AcceptOrdersBatch(ctx context.Context, in *AcceptOrdersBatchRequest, opts ...grpc.CallOption) (*AcceptOrdersBatchResponse, error)
 	ReturnOrderToCourier(ctx context.Context, in *ReturnOrderRequest, opts ...grpc.CallOption) (*ReturnOrderResponse, error)
 	IssueOrderToClient(ctx context.Context, in *IssueOrderRequest, opts ...grpc.CallOption) (*IssueOrderResponse, error)
 	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	AcceptReturnFromClient(ctx context.Context, in *AcceptReturnRequest, opts ...grpc.CallOption) (*AcceptReturnResponse, error)
 	ReturnList(ctx context.Context, in *ReturnListRequest, opts ...grpc.CallOption) (*ReturnListResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	ListCourierReturns(ctx context.Context, in *ListCourierReturnsRequest, opts ...grpc.CallOption) (*ListCourierReturnsResponse, error)
+	GetReturnManifest(ctx context.Context, in *GetReturnManifestRequest, opts ...grpc.CallOption) (*GetReturnManifestResponse, error)
+	ConfirmReturnManifest(ctx context.Context, in *ConfirmReturnManifestRequest, opts ...grpc.CallOption) (*ConfirmReturnManifestResponse, error)
+	ListPackageTypes(ctx context.Context, in *ListPackageTypesRequest, opts ...grpc.CallOption) (*ListPackageTypesResponse, error)
+	CreatePackageType(ctx context.Context, in *CreatePackageTypeRequest, opts ...grpc.CallOption) (*CreatePackageTypeResponse, error)
+	DeactivatePackageType(ctx context.Context, in *DeactivatePackageTypeRequest, opts ...grpc.CallOption) (*DeactivatePackageTypeResponse, error)
+	ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error)
+	RegeneratePickupCode(ctx context.Context, in *RegeneratePickupCodeRequest, opts ...grpc.CallOption) (*RegeneratePickupCodeResponse, error)
+	VerifyOrderIntegrity(ctx context.Context, in *VerifyOrderIntegrityRequest, opts ...grpc.CallOption) (*VerifyOrderIntegrityResponse, error)
+	CreateRecipient(ctx context.Context, in *CreateRecipientRequest, opts ...grpc.CallOption) (*CreateRecipientResponse, error)
+	GetRecipient(ctx context.Context, in *GetRecipientRequest, opts ...grpc.CallOption) (*GetRecipientResponse, error)
+	ListRecipients(ctx context.Context, in *ListRecipientsRequest, opts ...grpc.CallOption) (*ListRecipientsResponse, error)
+	UpdateRecipient(ctx context.Context, in *UpdateRecipientRequest, opts ...grpc.CallOption) (*UpdateRecipientResponse, error)
+	DeleteRecipient(ctx context.Context, in *DeleteRecipientRequest, opts ...grpc.CallOption) (*DeleteRecipientResponse, error)
+	CreateCell(ctx context.Context, in *CreateCellRequest, opts ...grpc.CallOption) (*CreateCellResponse, error)
+	ListCells(ctx context.Context, in *ListCellsRequest, opts ...grpc.CallOption) (*ListCellsResponse, error)
+	ListCellOrders(ctx context.Context, in *ListCellOrdersRequest, opts ...grpc.CallOption) (*ListCellOrdersResponse, error)
+	MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*MoveOrderResponse, error)
+	GetCapacity(ctx context.Context, in *GetCapacityRequest, opts ...grpc.CallOption) (*GetCapacityResponse, error)
 }
 
 type orderClient struct {
@@ -57,6 +101,16 @@ func (c *orderClient) AcceptOrderFromCourier(ctx context.Context, in *AcceptOrde
 	return out, nil
 }
 
+func (c *orderClient) AcceptOrdersBatch(ctx context.Context, in *AcceptOrdersBatchRequest, opts ...grpc.CallOption) (*AcceptOrdersBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptOrdersBatchResponse)
+	err := c.cc.Invoke(ctx, Order_AcceptOrdersBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) ReturnOrderToCourier(ctx context.Context, in *ReturnOrderRequest, opts ...grpc.CallOption) (*ReturnOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReturnOrderResponse)
@@ -107,16 +161,248 @@ func (c *orderClient) ReturnList(ctx context.Context, in *ReturnListRequest, opt
 	return out, nil
 }
 
+func (c *orderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderResponse)
+	err := c.cc.Invoke(ctx, Order_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, Order_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListCourierReturns(ctx context.Context, in *ListCourierReturnsRequest, opts ...grpc.CallOption) (*ListCourierReturnsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCourierReturnsResponse)
+	err := c.cc.Invoke(ctx, Order_ListCourierReturns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetReturnManifest(ctx context.Context, in *GetReturnManifestRequest, opts ...grpc.CallOption) (*GetReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReturnManifestResponse)
+	err := c.cc.Invoke(ctx, Order_GetReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ConfirmReturnManifest(ctx context.Context, in *ConfirmReturnManifestRequest, opts ...grpc.CallOption) (*ConfirmReturnManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmReturnManifestResponse)
+	err := c.cc.Invoke(ctx, Order_ConfirmReturnManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListPackageTypes(ctx context.Context, in *ListPackageTypesRequest, opts ...grpc.CallOption) (*ListPackageTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPackageTypesResponse)
+	err := c.cc.Invoke(ctx, Order_ListPackageTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreatePackageType(ctx context.Context, in *CreatePackageTypeRequest, opts ...grpc.CallOption) (*CreatePackageTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePackageTypeResponse)
+	err := c.cc.Invoke(ctx, Order_CreatePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) DeactivatePackageType(ctx context.Context, in *DeactivatePackageTypeRequest, opts ...grpc.CallOption) (*DeactivatePackageTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivatePackageTypeResponse)
+	err := c.cc.Invoke(ctx, Order_DeactivatePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*ExtendStorageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendStorageResponse)
+	err := c.cc.Invoke(ctx, Order_ExtendStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) RegeneratePickupCode(ctx context.Context, in *RegeneratePickupCodeRequest, opts ...grpc.CallOption) (*RegeneratePickupCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegeneratePickupCodeResponse)
+	err := c.cc.Invoke(ctx, Order_RegeneratePickupCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) VerifyOrderIntegrity(ctx context.Context, in *VerifyOrderIntegrityRequest, opts ...grpc.CallOption) (*VerifyOrderIntegrityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyOrderIntegrityResponse)
+	err := c.cc.Invoke(ctx, Order_VerifyOrderIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateRecipient(ctx context.Context, in *CreateRecipientRequest, opts ...grpc.CallOption) (*CreateRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRecipientResponse)
+	err := c.cc.Invoke(ctx, Order_CreateRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetRecipient(ctx context.Context, in *GetRecipientRequest, opts ...grpc.CallOption) (*GetRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecipientResponse)
+	err := c.cc.Invoke(ctx, Order_GetRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListRecipients(ctx context.Context, in *ListRecipientsRequest, opts ...grpc.CallOption) (*ListRecipientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipientsResponse)
+	err := c.cc.Invoke(ctx, Order_ListRecipients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) UpdateRecipient(ctx context.Context, in *UpdateRecipientRequest, opts ...grpc.CallOption) (*UpdateRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateRecipientResponse)
+	err := c.cc.Invoke(ctx, Order_UpdateRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) DeleteRecipient(ctx context.Context, in *DeleteRecipientRequest, opts ...grpc.CallOption) (*DeleteRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRecipientResponse)
+	err := c.cc.Invoke(ctx, Order_DeleteRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) CreateCell(ctx context.Context, in *CreateCellRequest, opts ...grpc.CallOption) (*CreateCellResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCellResponse)
+	err := c.cc.Invoke(ctx, Order_CreateCell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListCells(ctx context.Context, in *ListCellsRequest, opts ...grpc.CallOption) (*ListCellsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCellsResponse)
+	err := c.cc.Invoke(ctx, Order_ListCells_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) ListCellOrders(ctx context.Context, in *ListCellOrdersRequest, opts ...grpc.CallOption) (*ListCellOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCellOrdersResponse)
+	err := c.cc.Invoke(ctx, Order_ListCellOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*MoveOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveOrderResponse)
+	err := c.cc.Invoke(ctx, Order_MoveOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderClient) GetCapacity(ctx context.Context, in *GetCapacityRequest, opts ...grpc.CallOption) (*GetCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapacityResponse)
+	err := c.cc.Invoke(ctx, Order_GetCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility
 type OrderServer interface {
 	AcceptOrderFromCourier(context.Context, *AcceptOrderRequest) (*AcceptOrderResponse, error)
+	AcceptOrdersBatch(context.Context, *AcceptOrdersBatchRequest) (*AcceptOrdersBatchResponse, error)
 	ReturnOrderToCourier(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error)
 	IssueOrderToClient(context.Context, *IssueOrderRequest) (*IssueOrderResponse, error)
 	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	AcceptReturnFromClient(context.Context, *AcceptReturnRequest) (*AcceptReturnResponse, error)
 	ReturnList(context.Context, *ReturnListRequest) (*ReturnListResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	ListCourierReturns(context.Context, *ListCourierReturnsRequest) (*ListCourierReturnsResponse, error)
+	GetReturnManifest(context.Context, *GetReturnManifestRequest) (*GetReturnManifestResponse, error)
+	ConfirmReturnManifest(context.Context, *ConfirmReturnManifestRequest) (*ConfirmReturnManifestResponse, error)
+	ListPackageTypes(context.Context, *ListPackageTypesRequest) (*ListPackageTypesResponse, error)
+	CreatePackageType(context.Context, *CreatePackageTypeRequest) (*CreatePackageTypeResponse, error)
+	DeactivatePackageType(context.Context, *DeactivatePackageTypeRequest) (*DeactivatePackageTypeResponse, error)
+	ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error)
+	RegeneratePickupCode(context.Context, *RegeneratePickupCodeRequest) (*RegeneratePickupCodeResponse, error)
+	VerifyOrderIntegrity(context.Context, *VerifyOrderIntegrityRequest) (*VerifyOrderIntegrityResponse, error)
+	CreateRecipient(context.Context, *CreateRecipientRequest) (*CreateRecipientResponse, error)
+	GetRecipient(context.Context, *GetRecipientRequest) (*GetRecipientResponse, error)
+	ListRecipients(context.Context, *ListRecipientsRequest) (*ListRecipientsResponse, error)
+	UpdateRecipient(context.Context, *UpdateRecipientRequest) (*UpdateRecipientResponse, error)
+	DeleteRecipient(context.Context, *DeleteRecipientRequest) (*DeleteRecipientResponse, error)
+	CreateCell(context.Context, *CreateCellRequest) (*CreateCellResponse, error)
+	ListCells(context.Context, *ListCellsRequest) (*ListCellsResponse, error)
+	ListCellOrders(context.Context, *ListCellOrdersRequest) (*ListCellOrdersResponse, error)
+	MoveOrder(context.Context, *MoveOrderRequest) (*MoveOrderResponse, error)
+	GetCapacity(context.Context, *GetCapacityRequest) (*GetCapacityResponse, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -127,6 +413,9 @@ type UnimplementedOrderServer struct {
 func (UnimplementedOrderServer) AcceptOrderFromCourier(context.Context, *AcceptOrderRequest) (*AcceptOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrderFromCourier not implemented")
 }
+func (UnimplementedOrderServer) AcceptOrdersBatch(context.Context, *AcceptOrdersBatchRequest) (*AcceptOrdersBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrdersBatch not implemented")
+}
 func (UnimplementedOrderServer) ReturnOrderToCourier(context.Context, *ReturnOrderRequest) (*ReturnOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrderToCourier not implemented")
 }
@@ -142,6 +431,69 @@ func (UnimplementedOrderServer) AcceptReturnFromClient(context.Context, *AcceptR
 func (UnimplementedOrderServer) ReturnList(context.Context, *ReturnListRequest) (*ReturnListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnList not implemented")
 }
+func (UnimplementedOrderServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedOrderServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrderServer) ListCourierReturns(context.Context, *ListCourierReturnsRequest) (*ListCourierReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourierReturns not implemented")
+}
+func (UnimplementedOrderServer) GetReturnManifest(context.Context, *GetReturnManifestRequest) (*GetReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnManifest not implemented")
+}
+func (UnimplementedOrderServer) ConfirmReturnManifest(context.Context, *ConfirmReturnManifestRequest) (*ConfirmReturnManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReturnManifest not implemented")
+}
+func (UnimplementedOrderServer) ListPackageTypes(context.Context, *ListPackageTypesRequest) (*ListPackageTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPackageTypes not implemented")
+}
+func (UnimplementedOrderServer) CreatePackageType(context.Context, *CreatePackageTypeRequest) (*CreatePackageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePackageType not implemented")
+}
+func (UnimplementedOrderServer) DeactivatePackageType(context.Context, *DeactivatePackageTypeRequest) (*DeactivatePackageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePackageType not implemented")
+}
+func (UnimplementedOrderServer) ExtendStorage(context.Context, *ExtendStorageRequest) (*ExtendStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendStorage not implemented")
+}
+func (UnimplementedOrderServer) RegeneratePickupCode(context.Context, *RegeneratePickupCodeRequest) (*RegeneratePickupCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegeneratePickupCode not implemented")
+}
+func (UnimplementedOrderServer) VerifyOrderIntegrity(context.Context, *VerifyOrderIntegrityRequest) (*VerifyOrderIntegrityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyOrderIntegrity not implemented")
+}
+func (UnimplementedOrderServer) CreateRecipient(context.Context, *CreateRecipientRequest) (*CreateRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipient not implemented")
+}
+func (UnimplementedOrderServer) GetRecipient(context.Context, *GetRecipientRequest) (*GetRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipient not implemented")
+}
+func (UnimplementedOrderServer) ListRecipients(context.Context, *ListRecipientsRequest) (*ListRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecipients not implemented")
+}
+func (UnimplementedOrderServer) UpdateRecipient(context.Context, *UpdateRecipientRequest) (*UpdateRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipient not implemented")
+}
+func (UnimplementedOrderServer) DeleteRecipient(context.Context, *DeleteRecipientRequest) (*DeleteRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipient not implemented")
+}
+func (UnimplementedOrderServer) CreateCell(context.Context, *CreateCellRequest) (*CreateCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCell not implemented")
+}
+func (UnimplementedOrderServer) ListCells(context.Context, *ListCellsRequest) (*ListCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCells not implemented")
+}
+func (UnimplementedOrderServer) ListCellOrders(context.Context, *ListCellOrdersRequest) (*ListCellOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCellOrders not implemented")
+}
+func (UnimplementedOrderServer) MoveOrder(context.Context, *MoveOrderRequest) (*MoveOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrder not implemented")
+}
+func (UnimplementedOrderServer) GetCapacity(context.Context, *GetCapacityRequest) (*GetCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacity not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 
 // UnsafeOrderServer may be embedded to opt out of forward compatibility for this service.
@@ -173,6 +525,24 @@ func _Order_AcceptOrderFromCourier_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_AcceptOrdersBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrdersBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).AcceptOrdersBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_AcceptOrdersBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).AcceptOrdersBatch(ctx, req.(*AcceptOrdersBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_ReturnOrderToCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReturnOrderRequest)
 	if err := dec(in); err != nil {
@@ -263,6 +633,384 @@ func _Order_ReturnList_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListCourierReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCourierReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListCourierReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListCourierReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListCourierReturns(ctx, req.(*ListCourierReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetReturnManifest(ctx, req.(*GetReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ConfirmReturnManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReturnManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ConfirmReturnManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ConfirmReturnManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ConfirmReturnManifest(ctx, req.(*ConfirmReturnManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListPackageTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackageTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListPackageTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListPackageTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListPackageTypes(ctx, req.(*ListPackageTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreatePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreatePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreatePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreatePackageType(ctx, req.(*CreatePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_DeactivatePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeactivatePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_DeactivatePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeactivatePackageType(ctx, req.(*DeactivatePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ExtendStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ExtendStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ExtendStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ExtendStorage(ctx, req.(*ExtendStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_RegeneratePickupCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegeneratePickupCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).RegeneratePickupCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_RegeneratePickupCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).RegeneratePickupCode(ctx, req.(*RegeneratePickupCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_VerifyOrderIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOrderIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).VerifyOrderIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_VerifyOrderIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).VerifyOrderIntegrity(ctx, req.(*VerifyOrderIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateRecipient(ctx, req.(*CreateRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetRecipient(ctx, req.(*GetRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListRecipients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListRecipients(ctx, req.(*ListRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_UpdateRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).UpdateRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_UpdateRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).UpdateRecipient(ctx, req.(*UpdateRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_DeleteRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DeleteRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_DeleteRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DeleteRecipient(ctx, req.(*DeleteRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_CreateCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).CreateCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_CreateCell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).CreateCell(ctx, req.(*CreateCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListCells_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListCells(ctx, req.(*ListCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_ListCellOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCellOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListCellOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListCellOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListCellOrders(ctx, req.(*ListCellOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_MoveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).MoveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_MoveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).MoveOrder(ctx, req.(*MoveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Order_GetCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).GetCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_GetCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).GetCapacity(ctx, req.(*GetCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -274,6 +1022,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOrderFromCourier",
 			Handler:    _Order_AcceptOrderFromCourier_Handler,
 		},
+		{
+			MethodName: "AcceptOrdersBatch",
+			Handler:    _Order_AcceptOrdersBatch_Handler,
+		},
 		{
 			MethodName: "ReturnOrderToCourier",
 			Handler:    _Order_ReturnOrderToCourier_Handler,
@@ -294,6 +1046,90 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReturnList",
 			Handler:    _Order_ReturnList_Handler,
 		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Order_GetOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _Order_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "ListCourierReturns",
+			Handler:    _Order_ListCourierReturns_Handler,
+		},
+		{
+			MethodName: "GetReturnManifest",
+			Handler:    _Order_GetReturnManifest_Handler,
+		},
+		{
+			MethodName: "ConfirmReturnManifest",
+			Handler:    _Order_ConfirmReturnManifest_Handler,
+		},
+		{
+			MethodName: "ListPackageTypes",
+			Handler:    _Order_ListPackageTypes_Handler,
+		},
+		{
+			MethodName: "CreatePackageType",
+			Handler:    _Order_CreatePackageType_Handler,
+		},
+		{
+			MethodName: "DeactivatePackageType",
+			Handler:    _Order_DeactivatePackageType_Handler,
+		},
+		{
+			MethodName: "ExtendStorage",
+			Handler:    _Order_ExtendStorage_Handler,
+		},
+		{
+			MethodName: "RegeneratePickupCode",
+			Handler:    _Order_RegeneratePickupCode_Handler,
+		},
+		{
+			MethodName: "VerifyOrderIntegrity",
+			Handler:    _Order_VerifyOrderIntegrity_Handler,
+		},
+		{
+			MethodName: "CreateRecipient",
+			Handler:    _Order_CreateRecipient_Handler,
+		},
+		{
+			MethodName: "GetRecipient",
+			Handler:    _Order_GetRecipient_Handler,
+		},
+		{
+			MethodName: "ListRecipients",
+			Handler:    _Order_ListRecipients_Handler,
+		},
+		{
+			MethodName: "UpdateRecipient",
+			Handler:    _Order_UpdateRecipient_Handler,
+		},
+		{
+			MethodName: "DeleteRecipient",
+			Handler:    _Order_DeleteRecipient_Handler,
+		},
+		{
+			MethodName: "CreateCell",
+			Handler:    _Order_CreateCell_Handler,
+		},
+		{
+			MethodName: "ListCells",
+			Handler:    _Order_ListCells_Handler,
+		},
+		{
+			MethodName: "ListCellOrders",
+			Handler:    _Order_ListCellOrders_Handler,
+		},
+		{
+			MethodName: "MoveOrder",
+			Handler:    _Order_MoveOrder_Handler,
+		},
+		{
+			MethodName: "GetCapacity",
+			Handler:    _Order_GetCapacity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order/v1/order.proto",
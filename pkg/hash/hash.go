@@ -1,15 +1,41 @@
 package hash
 
 import (
-	"time"
-
-	"github.com/google/uuid"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
 )
 
-// GenerateHash возвращает случайный "хэш"
-func GenerateHash() string {
-	time.Sleep(time.Second * 5) // имитируем долгую работу
-	id := uuid.New()
+// DigestLength длина дайджеста в шестнадцатеричном виде
+const DigestLength = sha256.Size * 2
+
+// Digest возвращает HMAC-SHA256 от полей с ключом key в шестнадцатеричном виде.
+// Перед каждым полем пишется его длина, поэтому разные наборы полей не дают одинаковый вход.
+func Digest(key []byte, fields ...string) string {
+	mac := hmac.New(sha256.New, key)
+
+	for _, field := range fields {
+		mac.Write([]byte(strconv.Itoa(len(field))))
+		mac.Write([]byte{':'})
+		mac.Write([]byte(field))
+	}
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Equal сравнивает дайджесты за постоянное время
+func Equal(a, b string) bool {
+	return hmac.Equal([]byte(a), []byte(b))
+}
+
+// IsDigest сообщает, что строка похожа на дайджест, полученный через Digest
+func IsDigest(s string) bool {
+	if len(s) != DigestLength {
+		return false
+	}
+
+	_, err := hex.DecodeString(s)
 
-	return id.String()
+	return err == nil
 }
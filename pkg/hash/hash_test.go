@@ -0,0 +1,39 @@
+package hash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigest(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("secret")
+
+	t.Run("deterministic", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, Digest(key, "1", "accepted"), Digest(key, "1", "accepted"))
+		assert.True(t, IsDigest(Digest(key, "1", "accepted")))
+	})
+
+	t.Run("depends on key", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotEqual(t, Digest(key, "1"), Digest([]byte("other"), "1"))
+	})
+
+	t.Run("field boundaries matter", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NotEqual(t, Digest(key, "ab", "c"), Digest(key, "a", "bc"))
+	})
+}
+
+func TestIsDigest(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, IsDigest("3f2b8c1e-7a4d-4c2e-9b1a-5d6e7f8a9b0c"))
+	assert.False(t, IsDigest(""))
+}
@@ -0,0 +1,20 @@
+package money
+
+import "math"
+
+const (
+	// DefaultCurrency валюта по умолчанию, код ISO 4217
+	DefaultCurrency = "RUB"
+	// MinorUnitsPerMajor количество минимальных единиц в основной единице валюты (копеек в рубле)
+	MinorUnitsPerMajor = 100
+)
+
+// FromMajor переводит сумму в основных единицах в минимальные, округляя до ближайшей
+func FromMajor(amount float64) int64 {
+	return int64(math.Round(amount * MinorUnitsPerMajor))
+}
+
+// ToMajor переводит сумму в минимальных единицах в основные; используется только для отображения
+func ToMajor(amount int64) float64 {
+	return float64(amount) / MinorUnitsPerMajor
+}
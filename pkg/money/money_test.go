@@ -0,0 +1,35 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromMajor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		amount float64
+		want   int64
+	}{
+		{name: "whole amount", amount: 120, want: 12000},
+		{name: "amount with kopecks", amount: 100.75, want: 10075},
+		{name: "binary float error is rounded", amount: 0.29, want: 29},
+		{name: "half is rounded away from zero", amount: 0.005, want: 1},
+		{name: "zero", amount: 0, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FromMajor(tt.amount))
+		})
+	}
+}
+
+func TestToMajor(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 100.75, ToMajor(10075))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/config"
+	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/domain"
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/tests/postgres"
 )
 
@@ -32,3 +33,13 @@ func init() {
 
 	db = postgres.NewFromEnv(cfg.DB)
 }
+
+// mustPackageType возвращает тип упаковки из реестра по умолчанию
+func mustPackageType(name string) *domain.OrderPackageType {
+	packageType, err := domain.NewPackageType(name)
+	if err != nil {
+		log.Fatalf("unknown package type %q: %s", name, err)
+	}
+
+	return packageType
+}
@@ -60,21 +60,23 @@ func (s *OrderTestSuite) TearDownTest() {
 func (s *OrderTestSuite) TestCreateOrder() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
 	id := nextID()
 	order := &domain.Order{
-		ID:           id,
-		RecipientID:  2,
-		Weight:       10.32,
-		Cost:         123,
-		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-		StorageUntil: time.Now().Add(12 * time.Hour),
-		IssuedAt:     sql.NullTime{},
-		ReturnedAt:   sql.NullTime{},
-		Hash:         uuid.New().String(),
+		ID:            id,
+		RecipientID:   2,
+		PickupPointID: testPickupPointID,
+		Status:        domain.OrderStatusAccepted,
+		Weight:        10.32,
+		Cost:          123,
+		PackageCost:   20,
+		PackageType:   mustPackageType("box"),
+		StorageUntil:  time.Now().Add(12 * time.Hour),
+		IssuedAt:      sql.NullTime{},
+		ReturnedAt:    sql.NullTime{},
+		Hash:          uuid.New().String(),
 	}
 
 	defer deleteOrders(s.ctx, id)
@@ -91,47 +93,13 @@ func (s *OrderTestSuite) TestCreateOrder() {
 	assert.ObjectsAreEqual(order, savedOrder)
 }
 
-func (s *OrderTestSuite) TestDeleteOrder() {
-	s.T().Parallel()
-
-	s.ctx = context.Background()
-
-	// arrange
-	id := nextID()
-	order := &domain.Order{
-		ID:           id,
-		RecipientID:  2,
-		Weight:       10.32,
-		Cost:         123,
-		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-		StorageUntil: time.Now().Add(12 * time.Hour),
-		IssuedAt:     sql.NullTime{},
-		ReturnedAt:   sql.NullTime{},
-		Hash:         uuid.New().String(),
-	}
-
-	fillDb(s.ctx, order)
-	defer deleteOrders(s.ctx, id)
-
-	// act
-	err := db.Storage.DeleteOrder(s.ctx, id)
-
-	// assert
-	require.NoError(s.T(), err)
-
-	deletedOrder, err := db.Storage.FindOrderByID(s.ctx, id)
-	assert.ErrorIs(s.T(), err, storage.ErrOrderNotFound)
-	assert.Nil(s.T(), deletedOrder)
-}
-
 func (s *OrderTestSuite) TestFindOrdersByRecipientID() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
-	recipientID := 123
+	recipientID := int64(123)
 
 	id1 := nextID()
 	id2 := nextID()
@@ -143,7 +111,7 @@ func (s *OrderTestSuite) TestFindOrdersByRecipientID() {
 			Weight:       10.32,
 			Cost:         123,
 			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(12 * time.Hour),
 			IssuedAt:     sql.NullTime{},
 			ReturnedAt:   sql.NullTime{},
@@ -155,7 +123,7 @@ func (s *OrderTestSuite) TestFindOrdersByRecipientID() {
 			Weight:       5.5,
 			Cost:         50,
 			PackageCost:  10,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Film{}},
+			PackageType:  mustPackageType("film"),
 			StorageUntil: time.Now().Add(24 * time.Hour),
 			IssuedAt:     sql.NullTime{},
 			ReturnedAt:   sql.NullTime{},
@@ -180,10 +148,10 @@ func (s *OrderTestSuite) TestFindOrdersByRecipientID() {
 func (s *OrderTestSuite) TestFindReturnedOrdersWithPagination() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
-	ids := []int{nextID(), nextID(), nextID(), nextID()}
+	ids := []int64{nextID(), nextID(), nextID(), nextID()}
 
 	orders := []*domain.Order{
 		{
@@ -192,8 +160,9 @@ func (s *OrderTestSuite) TestFindReturnedOrdersWithPagination() {
 			Weight:       10.32,
 			Cost:         123,
 			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.StandardPackage{}},
+			PackageType:  mustPackageType("package"),
 			StorageUntil: time.Now().Add(12 * time.Hour),
+			Status:       domain.OrderStatusIssued,
 			IssuedAt:     sql.NullTime{Time: time.Now(), Valid: true},
 			ReturnedAt:   sql.NullTime{},
 			Hash:         uuid.New().String(),
@@ -204,9 +173,10 @@ func (s *OrderTestSuite) TestFindReturnedOrdersWithPagination() {
 			Weight:       5.5,
 			Cost:         50,
 			PackageCost:  10,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Film{}},
+			PackageType:  mustPackageType("film"),
 			StorageUntil: time.Now().Add(24 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -216,9 +186,10 @@ func (s *OrderTestSuite) TestFindReturnedOrdersWithPagination() {
 			Weight:       3.2,
 			Cost:         30,
 			PackageCost:  5,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(36 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -228,9 +199,10 @@ func (s *OrderTestSuite) TestFindReturnedOrdersWithPagination() {
 			Weight:       3.2,
 			Cost:         30,
 			PackageCost:  5,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(36 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -243,22 +215,21 @@ func (s *OrderTestSuite) TestFindReturnedOrdersWithPagination() {
 	defer deleteOrders(s.ctx, ids...)
 
 	// act
-	limit := 3
-	offset := 0
-	foundOrders, err := db.Storage.FindReturnedOrdersWithPagination(s.ctx, limit, offset)
+	var limit, offset int32 = 3, 0
+	foundOrders, err := db.Storage.FindReturnedOrdersWithPagination(s.ctx, limit, offset, "")
 
 	// assert
 	require.NoError(s.T(), err)
-	require.Len(s.T(), foundOrders, limit)
+	require.Len(s.T(), foundOrders, int(limit))
 }
 
 func (s *OrderTestSuite) TestFindOrderByIDs() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
-	ids := []int{nextID(), nextID(), nextID()}
+	ids := []int64{nextID(), nextID(), nextID()}
 
 	orders := []*domain.Order{
 		{
@@ -267,9 +238,10 @@ func (s *OrderTestSuite) TestFindOrderByIDs() {
 			Weight:       10.32,
 			Cost:         123,
 			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.StandardPackage{}},
+			PackageType:  mustPackageType("package"),
 			StorageUntil: time.Now().Add(12 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -279,8 +251,9 @@ func (s *OrderTestSuite) TestFindOrderByIDs() {
 			Weight:       5.5,
 			Cost:         50,
 			PackageCost:  10,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Film{}},
+			PackageType:  mustPackageType("film"),
 			StorageUntil: time.Now().Add(24 * time.Hour),
+			Status:       domain.OrderStatusIssued,
 			IssuedAt:     sql.NullTime{Time: time.Now(), Valid: true},
 			ReturnedAt:   sql.NullTime{},
 			Hash:         uuid.New().String(),
@@ -291,8 +264,9 @@ func (s *OrderTestSuite) TestFindOrderByIDs() {
 			Weight:       3.2,
 			Cost:         30,
 			PackageCost:  5,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(36 * time.Hour),
+			Status:       domain.OrderStatusIssued,
 			IssuedAt:     sql.NullTime{Time: time.Now(), Valid: true},
 			ReturnedAt:   sql.NullTime{},
 			Hash:         uuid.New().String(),
@@ -306,7 +280,7 @@ func (s *OrderTestSuite) TestFindOrderByIDs() {
 	defer deleteOrders(s.ctx, ids...)
 
 	// act
-	resultIDs := []int{ids[0], ids[2]}
+	resultIDs := []int64{ids[0], ids[2]}
 	foundOrders, err := db.Storage.FindOrderByIDs(s.ctx, resultIDs)
 
 	// assert
@@ -317,7 +291,7 @@ func (s *OrderTestSuite) TestFindOrderByIDs() {
 func (s *OrderTestSuite) TestFindOrderByID_ReturnOrder() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
 	id := nextID()
@@ -327,7 +301,7 @@ func (s *OrderTestSuite) TestFindOrderByID_ReturnOrder() {
 		Weight:       10.32,
 		Cost:         123,
 		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+		PackageType:  mustPackageType("box"),
 		StorageUntil: time.Now().Add(12 * time.Hour),
 		IssuedAt:     sql.NullTime{},
 		ReturnedAt:   sql.NullTime{},
@@ -357,7 +331,7 @@ func (s *OrderTestSuite) TestFindOrderByID_ReturnOrder() {
 func (s *OrderTestSuite) TestFindOrderByID_ReturnErrorIfOrderNotFound() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
 	nonExistentOrderID := nextID()
@@ -372,7 +346,7 @@ func (s *OrderTestSuite) TestFindOrderByID_ReturnErrorIfOrderNotFound() {
 func (s *OrderTestSuite) TestUpdateOrder_Success() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
 	id := nextID()
@@ -382,7 +356,7 @@ func (s *OrderTestSuite) TestUpdateOrder_Success() {
 		Weight:       10.32,
 		Cost:         123,
 		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+		PackageType:  mustPackageType("box"),
 		StorageUntil: time.Now().Add(12 * time.Hour),
 		IssuedAt:     sql.NullTime{},
 		ReturnedAt:   sql.NullTime{},
@@ -395,10 +369,12 @@ func (s *OrderTestSuite) TestUpdateOrder_Success() {
 	updatedOrder := &domain.Order{
 		ID:           id,
 		RecipientID:  2,
+		Status:       domain.OrderStatusAccepted,
+		Version:      1,
 		Weight:       15.50,
 		Cost:         150,
 		PackageCost:  30,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+		PackageType:  mustPackageType("box"),
 		StorageUntil: time.Now().Add(24 * time.Hour),
 		IssuedAt:     sql.NullTime{},
 		ReturnedAt:   sql.NullTime{},
@@ -421,7 +397,7 @@ func (s *OrderTestSuite) TestUpdateOrder_Success() {
 func (s *OrderTestSuite) TestUpdateOrder_ReturnErrorIfOrderNotFound() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
 	nonExistentOrder := &domain.Order{
@@ -439,47 +415,50 @@ func (s *OrderTestSuite) TestUpdateOrder_ReturnErrorIfOrderNotFound() {
 func (s *OrderTestSuite) TestDeleteRecipientOrders() {
 	s.T().Parallel()
 
-	s.ctx = context.Background()
+	s.ctx = pickupPointContext()
 
 	// arrange
-	ids := []int{nextID(), nextID(), nextID()}
+	ids := []int64{nextID(), nextID(), nextID()}
 
 	orders := []*domain.Order{
 		{
-			ID:           ids[0],
-			RecipientID:  1,
-			Weight:       10.32,
-			Cost:         123,
-			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-			StorageUntil: time.Now().Add(12 * time.Hour),
-			IssuedAt:     sql.NullTime{},
-			ReturnedAt:   sql.NullTime{Time: time.Now().Add(-1 * time.Hour), Valid: true},
-			Hash:         uuid.New().String(),
+			ID:                  ids[0],
+			RecipientID:         1,
+			Weight:              10.32,
+			Cost:                123,
+			PackageCost:         20,
+			PackageType:         mustPackageType("box"),
+			StorageUntil:        time.Now().Add(12 * time.Hour),
+			IssuedAt:            sql.NullTime{},
+			Status:              domain.OrderStatusReturnedToCourier,
+			ReturnedToCourierAt: sql.NullTime{Time: time.Now().Add(-1 * time.Hour), Valid: true},
+			Hash:                uuid.New().String(),
 		},
 		{
-			ID:           ids[1],
-			RecipientID:  2,
-			Weight:       15.50,
-			Cost:         150,
-			PackageCost:  30,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-			StorageUntil: time.Now().Add(24 * time.Hour),
-			IssuedAt:     sql.NullTime{},
-			ReturnedAt:   sql.NullTime{Time: time.Now().Add(-2 * 24 * time.Hour), Valid: true},
-			Hash:         uuid.New().String(),
+			ID:                  ids[1],
+			RecipientID:         2,
+			Weight:              15.50,
+			Cost:                150,
+			PackageCost:         30,
+			PackageType:         mustPackageType("box"),
+			StorageUntil:        time.Now().Add(24 * time.Hour),
+			IssuedAt:            sql.NullTime{},
+			Status:              domain.OrderStatusReturnedToCourier,
+			ReturnedToCourierAt: sql.NullTime{Time: time.Now().Add(-2 * 24 * time.Hour), Valid: true},
+			Hash:                uuid.New().String(),
 		},
 		{
-			ID:           ids[2],
-			RecipientID:  1,
-			Weight:       20.75,
-			Cost:         200,
-			PackageCost:  25,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-			StorageUntil: time.Now().Add(48 * time.Hour),
-			IssuedAt:     sql.NullTime{},
-			ReturnedAt:   sql.NullTime{Time: time.Now().Add(-3 * 24 * time.Hour), Valid: true},
-			Hash:         uuid.New().String(),
+			ID:                  ids[2],
+			RecipientID:         1,
+			Weight:              20.75,
+			Cost:                200,
+			PackageCost:         25,
+			PackageType:         mustPackageType("box"),
+			StorageUntil:        time.Now().Add(48 * time.Hour),
+			IssuedAt:            sql.NullTime{},
+			Status:              domain.OrderStatusReturnedToCourier,
+			ReturnedToCourierAt: sql.NullTime{Time: time.Now().Add(-3 * 24 * time.Hour), Valid: true},
+			Hash:                uuid.New().String(),
 		},
 	}
 
@@ -490,7 +469,7 @@ func (s *OrderTestSuite) TestDeleteRecipientOrders() {
 	defer deleteOrders(s.ctx, ids...)
 
 	// act
-	rowsDeleted, err := db.Storage.DeleteRecipientOrders(s.ctx)
+	rowsDeleted, err := db.Storage.DeleteRecipientOrders(s.ctx, time.Now().Add(-60*time.Hour))
 
 	// assert
 	require.NoError(s.T(), err)
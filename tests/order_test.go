@@ -20,6 +20,9 @@ import (
 	"gitlab.ozon.dev/a_zhuravlev_9785/homework/internal/storage"
 )
 
+// testPickupPointID ПВЗ по умолчанию, который создается миграцией реестра ПВЗ
+const testPickupPointID int64 = 1
+
 var (
 	counter int64
 )
@@ -28,22 +31,24 @@ func TestCreateOrder(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id  = nextID()
 	)
 
 	// arrange
 	order := &domain.Order{
-		ID:           id,
-		RecipientID:  2,
-		Weight:       10.32,
-		Cost:         123,
-		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-		StorageUntil: time.Now().Add(12 * time.Hour),
-		IssuedAt:     sql.NullTime{},
-		ReturnedAt:   sql.NullTime{},
-		Hash:         uuid.New().String(),
+		ID:            id,
+		RecipientID:   2,
+		PickupPointID: testPickupPointID,
+		Status:        domain.OrderStatusAccepted,
+		Weight:        10.32,
+		Cost:          123,
+		PackageCost:   20,
+		PackageType:   mustPackageType("box"),
+		StorageUntil:  time.Now().Add(12 * time.Hour),
+		IssuedAt:      sql.NullTime{},
+		ReturnedAt:    sql.NullTime{},
+		Hash:          uuid.New().String(),
 	}
 
 	defer deleteOrders(ctx, id)
@@ -59,50 +64,14 @@ func TestCreateOrder(t *testing.T) {
 	assert.ObjectsAreEqual(order, savedOrder)
 }
 
-func TestDeleteOrder(t *testing.T) {
-	t.Parallel()
-
-	var (
-		ctx = context.Background()
-		id  = nextID()
-	)
-
-	// arrange
-	order := &domain.Order{
-		ID:           id,
-		RecipientID:  2,
-		Weight:       10.32,
-		Cost:         123,
-		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-		StorageUntil: time.Now().Add(12 * time.Hour),
-		IssuedAt:     sql.NullTime{},
-		ReturnedAt:   sql.NullTime{},
-		Hash:         uuid.New().String(),
-	}
-	fillDb(ctx, order)
-
-	defer deleteOrders(ctx, id)
-
-	// act
-	err := db.Storage.DeleteOrder(ctx, id)
-
-	// assert
-	require.NoError(t, err)
-
-	deletedOrder, err := db.Storage.FindOrderByID(ctx, id)
-	assert.ErrorIs(t, err, storage.ErrOrderNotFound)
-	assert.Nil(t, deletedOrder)
-}
-
 func TestFindOrdersByRecipientID(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx         = context.Background()
-		id1         = nextID()
-		id2         = nextID()
-		recipientID = 123
+		ctx               = pickupPointContext()
+		id1               = nextID()
+		id2               = nextID()
+		recipientID int64 = 123
 	)
 
 	defer deleteOrders(ctx, id1, id2)
@@ -115,7 +84,7 @@ func TestFindOrdersByRecipientID(t *testing.T) {
 			Weight:       10.32,
 			Cost:         123,
 			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(12 * time.Hour),
 			IssuedAt:     sql.NullTime{},
 			ReturnedAt:   sql.NullTime{},
@@ -127,7 +96,7 @@ func TestFindOrdersByRecipientID(t *testing.T) {
 			Weight:       5.5,
 			Cost:         50,
 			PackageCost:  10,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Film{}},
+			PackageType:  mustPackageType("film"),
 			StorageUntil: time.Now().Add(24 * time.Hour),
 			IssuedAt:     sql.NullTime{},
 			ReturnedAt:   sql.NullTime{},
@@ -152,7 +121,7 @@ func TestFindReturnedOrdersWithPagination(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id1 = nextID()
 		id2 = nextID()
 		id3 = nextID()
@@ -167,8 +136,9 @@ func TestFindReturnedOrdersWithPagination(t *testing.T) {
 			Weight:       10.32,
 			Cost:         123,
 			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.StandardPackage{}},
+			PackageType:  mustPackageType("package"),
 			StorageUntil: time.Now().Add(12 * time.Hour),
+			Status:       domain.OrderStatusIssued,
 			IssuedAt:     sql.NullTime{Time: time.Now(), Valid: true},
 			ReturnedAt:   sql.NullTime{},
 			Hash:         uuid.New().String(),
@@ -179,9 +149,10 @@ func TestFindReturnedOrdersWithPagination(t *testing.T) {
 			Weight:       5.5,
 			Cost:         50,
 			PackageCost:  10,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Film{}},
+			PackageType:  mustPackageType("film"),
 			StorageUntil: time.Now().Add(24 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -191,9 +162,10 @@ func TestFindReturnedOrdersWithPagination(t *testing.T) {
 			Weight:       3.2,
 			Cost:         30,
 			PackageCost:  5,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(36 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -203,9 +175,10 @@ func TestFindReturnedOrdersWithPagination(t *testing.T) {
 			Weight:       3.2,
 			Cost:         30,
 			PackageCost:  5,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(36 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -218,20 +191,19 @@ func TestFindReturnedOrdersWithPagination(t *testing.T) {
 	defer deleteOrders(ctx, id1, id2, id3, id4)
 
 	// act
-	limit := 3
-	offset := 0
-	foundOrders, err := db.Storage.FindReturnedOrdersWithPagination(ctx, limit, offset)
+	var limit, offset int32 = 3, 0
+	foundOrders, err := db.Storage.FindReturnedOrdersWithPagination(ctx, limit, offset, "")
 
 	// assert
 	require.NoError(t, err)
-	require.Len(t, foundOrders, limit)
+	require.Len(t, foundOrders, int(limit))
 }
 
 func TestFindOrderByIDs(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id1 = nextID()
 		id2 = nextID()
 		id3 = nextID()
@@ -245,9 +217,10 @@ func TestFindOrderByIDs(t *testing.T) {
 			Weight:       10.32,
 			Cost:         123,
 			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.StandardPackage{}},
+			PackageType:  mustPackageType("package"),
 			StorageUntil: time.Now().Add(12 * time.Hour),
 			IssuedAt:     sql.NullTime{},
+			Status:       domain.OrderStatusReturnedByClient,
 			ReturnedAt:   sql.NullTime{Time: time.Now(), Valid: true},
 			Hash:         uuid.New().String(),
 		},
@@ -257,8 +230,9 @@ func TestFindOrderByIDs(t *testing.T) {
 			Weight:       5.5,
 			Cost:         50,
 			PackageCost:  10,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Film{}},
+			PackageType:  mustPackageType("film"),
 			StorageUntil: time.Now().Add(24 * time.Hour),
+			Status:       domain.OrderStatusIssued,
 			IssuedAt:     sql.NullTime{Time: time.Now(), Valid: true},
 			ReturnedAt:   sql.NullTime{},
 			Hash:         uuid.New().String(),
@@ -269,8 +243,9 @@ func TestFindOrderByIDs(t *testing.T) {
 			Weight:       3.2,
 			Cost:         30,
 			PackageCost:  5,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+			PackageType:  mustPackageType("box"),
 			StorageUntil: time.Now().Add(36 * time.Hour),
+			Status:       domain.OrderStatusIssued,
 			IssuedAt:     sql.NullTime{Time: time.Now(), Valid: true},
 			ReturnedAt:   sql.NullTime{},
 			Hash:         uuid.New().String(),
@@ -284,7 +259,7 @@ func TestFindOrderByIDs(t *testing.T) {
 	defer deleteOrders(ctx, id1, id2, id3)
 
 	// act
-	ids := []int{id1, id3}
+	ids := []int64{id1, id3}
 	foundOrders, err := db.Storage.FindOrderByIDs(ctx, ids)
 
 	// assert
@@ -296,7 +271,7 @@ func TestFindOrderByID_ReturnOrder(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id  = nextID()
 	)
 
@@ -307,7 +282,7 @@ func TestFindOrderByID_ReturnOrder(t *testing.T) {
 		Weight:       10.32,
 		Cost:         123,
 		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+		PackageType:  mustPackageType("box"),
 		StorageUntil: time.Now().Add(12 * time.Hour),
 		IssuedAt:     sql.NullTime{},
 		ReturnedAt:   sql.NullTime{},
@@ -330,7 +305,7 @@ func TestFindOrderByID_ReturnErrorIfOrderNotFound(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id  = nextID()
 	)
 
@@ -349,7 +324,7 @@ func TestUpdateOrder_Success(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id  = nextID()
 	)
 
@@ -360,7 +335,7 @@ func TestUpdateOrder_Success(t *testing.T) {
 		Weight:       10.32,
 		Cost:         123,
 		PackageCost:  20,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+		PackageType:  mustPackageType("box"),
 		StorageUntil: time.Now().Add(12 * time.Hour),
 		IssuedAt:     sql.NullTime{},
 		ReturnedAt:   sql.NullTime{},
@@ -373,10 +348,12 @@ func TestUpdateOrder_Success(t *testing.T) {
 	updatedOrder := &domain.Order{
 		ID:           id,
 		RecipientID:  2,
+		Status:       domain.OrderStatusAccepted,
+		Version:      1,
 		Weight:       15.50,
 		Cost:         150,
 		PackageCost:  30,
-		PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
+		PackageType:  mustPackageType("box"),
 		StorageUntil: time.Now().Add(24 * time.Hour),
 		IssuedAt:     sql.NullTime{},
 		ReturnedAt:   sql.NullTime{},
@@ -399,7 +376,7 @@ func TestUpdateOrder_ReturnErrorIfOrderNotFound(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx = context.Background()
+		ctx = pickupPointContext()
 		id  = nextID()
 	)
 
@@ -421,7 +398,7 @@ func TestDeleteRecipientOrders(t *testing.T) {
 	t.Parallel()
 
 	var (
-		ctx                      = context.Background()
+		ctx                      = pickupPointContext()
 		id1                      = nextID()
 		id2                      = nextID()
 		id3                      = nextID()
@@ -431,40 +408,43 @@ func TestDeleteRecipientOrders(t *testing.T) {
 	// arrange
 	orders := []*domain.Order{
 		{
-			ID:           id1,
-			RecipientID:  1,
-			Weight:       10.32,
-			Cost:         123,
-			PackageCost:  20,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-			StorageUntil: time.Now().Add(12 * time.Hour),
-			IssuedAt:     sql.NullTime{},
-			ReturnedAt:   sql.NullTime{Time: time.Now().Add(-1 * time.Hour), Valid: true},
-			Hash:         uuid.New().String(),
+			ID:                  id1,
+			RecipientID:         1,
+			Weight:              10.32,
+			Cost:                123,
+			PackageCost:         20,
+			PackageType:         mustPackageType("box"),
+			StorageUntil:        time.Now().Add(12 * time.Hour),
+			IssuedAt:            sql.NullTime{},
+			Status:              domain.OrderStatusReturnedToCourier,
+			ReturnedToCourierAt: sql.NullTime{Time: time.Now().Add(-1 * time.Hour), Valid: true},
+			Hash:                uuid.New().String(),
 		},
 		{
-			ID:           id2,
-			RecipientID:  2,
-			Weight:       15.50,
-			Cost:         150,
-			PackageCost:  30,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-			StorageUntil: time.Now().Add(24 * time.Hour),
-			IssuedAt:     sql.NullTime{},
-			ReturnedAt:   sql.NullTime{Time: time.Now().Add(-2 * 24 * time.Hour), Valid: true},
-			Hash:         uuid.New().String(),
+			ID:                  id2,
+			RecipientID:         2,
+			Weight:              15.50,
+			Cost:                150,
+			PackageCost:         30,
+			PackageType:         mustPackageType("box"),
+			StorageUntil:        time.Now().Add(24 * time.Hour),
+			IssuedAt:            sql.NullTime{},
+			Status:              domain.OrderStatusReturnedToCourier,
+			ReturnedToCourierAt: sql.NullTime{Time: time.Now().Add(-2 * 24 * time.Hour), Valid: true},
+			Hash:                uuid.New().String(),
 		},
 		{
-			ID:           id3,
-			RecipientID:  1,
-			Weight:       20.75,
-			Cost:         200,
-			PackageCost:  25,
-			PackageType:  &domain.OrderPackageType{OrderPackager: &domain.Box{}},
-			StorageUntil: time.Now().Add(48 * time.Hour),
-			IssuedAt:     sql.NullTime{},
-			ReturnedAt:   sql.NullTime{Time: time.Now().Add(-3 * 24 * time.Hour), Valid: true},
-			Hash:         uuid.New().String(),
+			ID:                  id3,
+			RecipientID:         1,
+			Weight:              20.75,
+			Cost:                200,
+			PackageCost:         25,
+			PackageType:         mustPackageType("box"),
+			StorageUntil:        time.Now().Add(48 * time.Hour),
+			IssuedAt:            sql.NullTime{},
+			Status:              domain.OrderStatusReturnedToCourier,
+			ReturnedToCourierAt: sql.NullTime{Time: time.Now().Add(-3 * 24 * time.Hour), Valid: true},
+			Hash:                uuid.New().String(),
 		},
 	}
 
@@ -475,17 +455,32 @@ func TestDeleteRecipientOrders(t *testing.T) {
 	defer deleteOrders(ctx, id1, id2, id3)
 
 	// act
-	rowsDeleted, err := db.Storage.DeleteRecipientOrders(ctx)
+	rowsDeleted, err := db.Storage.DeleteRecipientOrders(ctx, time.Now().Add(-60*time.Hour))
 
 	// assert
 	require.NoError(t, err)
 	assert.Equal(t, countDeletedOrders, rowsDeleted)
 }
 
+// pickupPointContext возвращает контекст ПВЗ, к которому относятся заказы тестов
+func pickupPointContext() context.Context {
+	return domain.WithPickupPointID(context.Background(), testPickupPointID)
+}
+
 func fillDb(ctx context.Context, order *domain.Order) {
+	pickupPointID, _ := domain.PickupPointIDFromContext(ctx)
+
+	status := order.Status
+	if status == "" {
+		status = domain.OrderStatusAccepted
+	}
+
 	query := sq.Insert("orders").
-		Columns("id", "recipient_id", "storage_until", "issued_at", "returned_at", "hash", "weight", "order_cost", "package_cost", "package_type").
-		Values(order.ID, order.RecipientID, order.StorageUntil, order.IssuedAt, order.ReturnedAt, order.Hash, order.Weight, order.Cost, order.PackageCost, order.PackageType.Type()).
+		Columns("id", "recipient_id", "pvz_id", "status", "storage_until", "original_storage_until", "issued_at",
+			"returned_at", "returned_to_courier_at", "hash", "weight", "order_cost", "package_cost", "package_type").
+		Values(order.ID, order.RecipientID, pickupPointID, status, order.StorageUntil, order.StorageUntil, order.IssuedAt,
+			order.ReturnedAt, order.ReturnedToCourierAt, order.Hash, order.Weight, order.Cost, order.PackageCost,
+			order.PackageType.Type()).
 		PlaceholderFormat(sq.Dollar)
 
 	rowQuery, args, err := query.ToSql()
@@ -503,7 +498,7 @@ func fillDb(ctx context.Context, order *domain.Order) {
 	}
 }
 
-func deleteOrders(ctx context.Context, ids ...int) {
+func deleteOrders(ctx context.Context, ids ...int64) {
 	provider := db.Storage.GetQueryEngine(ctx)
 
 	if len(ids) == 0 {
@@ -524,6 +519,6 @@ func deleteOrders(ctx context.Context, ids ...int) {
 	}
 }
 
-func nextID() int {
-	return int(atomic.AddInt64(&counter, 1))
+func nextID() int64 {
+	return atomic.AddInt64(&counter, 1)
 }